@@ -0,0 +1,124 @@
+// Copyright 2019 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package main
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/pkg/errors"
+
+	"github.com/deepmap/oapi-codegen/pkg/codegen"
+)
+
+// This generates each of several specs into a package of its own. When there's
+// a shared package, the schemas which are identical in more than one of the
+// specs are generated into it, and the packages of the specs refer to them.
+func generateSpecs(config configuration, specs []specification, opts codegen.Options) error {
+	if config.PackageName != "" || config.OutputFile != "" {
+		return errors.New("the package and output of each of several specs must be given in the specs of the config file")
+	}
+	if config.TypesPackage != "" {
+		return errors.New("can not use a types package with several specs")
+	}
+
+	swaggers := make([]*openapi3.Swagger, len(specs))
+	for i, spec := range specs {
+		if spec.Spec == "" {
+			return fmt.Errorf("spec %d has no path", i+1)
+		}
+		swagger, err := loadSwagger(spec.Spec, config)
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("error loading swagger spec %s", spec.Spec))
+		}
+		swaggers[i] = swagger
+	}
+
+	sharedSchemas := make([][]string, len(specs))
+	if config.SharedPackage != "" {
+		var shared *openapi3.Swagger
+		var err error
+		shared, sharedSchemas, err = codegen.FindSharedSchemas(swaggers)
+		if err != nil {
+			return errors.Wrap(err, "error finding shared schemas")
+		}
+		if len(shared.Components.Schemas) != 0 {
+			err = generateSharedPackage(config, shared, opts)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for i, spec := range specs {
+		packageName := spec.PackageName
+		if packageName == "" {
+			packageName = defaultPackageName(spec.Spec)
+		}
+		if packageName == "" {
+			return fmt.Errorf("spec %s needs a package", spec.Spec)
+		}
+		outputFile, outputDir := spec.OutputFile, spec.OutputDir
+		if outputFile == "" && outputDir == "" {
+			if config.OutputDir == "" {
+				return fmt.Errorf("spec %s needs an output, or an output-dir to be generated into", spec.Spec)
+			}
+			outputDir = filepath.Join(config.OutputDir, packageName)
+		}
+
+		specOpts := opts
+		if len(sharedSchemas[i]) != 0 {
+			specOpts.SharedPackage = config.SharedPackage
+			specOpts.SharedSchemas = sharedSchemas[i]
+		}
+		err := generateOutput(swaggers[i], packageName, specOpts, outputFile, outputDir)
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("error generating spec %s", spec.Spec))
+		}
+	}
+	return nil
+}
+
+// This generates the shared schemas into their package, with the same type
+// options as the packages which refer to them.
+func generateSharedPackage(config configuration, shared *openapi3.Swagger, opts codegen.Options) error {
+	packageName := path.Base(config.SharedPackage)
+	outputFile, outputDir := config.SharedOutput, ""
+	if outputFile == "" {
+		if config.OutputDir == "" {
+			return errors.New("the shared package needs a shared-output, or an output-dir to be generated into")
+		}
+		outputDir = filepath.Join(config.OutputDir, packageName)
+	}
+
+	opts.GenerateTypes = true
+	opts.GenerateClient = false
+	opts.GenerateEchoServer = false
+	opts.GenerateChiServer = false
+	opts.GenerateGinServer = false
+	opts.GenerateGorillaServer = false
+	opts.GenerateStdHTTPServer = false
+	opts.GenerateStrictServer = false
+	opts.EmbedSpec = false
+	opts.SkipPrune = true
+	opts.IncludeTags = nil
+	opts.ExcludeTags = nil
+	err := generateOutput(shared, packageName, opts, outputFile, outputDir)
+	if err != nil {
+		return errors.Wrap(err, "error generating shared package")
+	}
+	return nil
+}
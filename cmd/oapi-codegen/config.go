@@ -0,0 +1,323 @@
+// Copyright 2019 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+
+	"github.com/deepmap/oapi-codegen/pkg/codegen"
+	"github.com/deepmap/oapi-codegen/pkg/util"
+)
+
+// This is the version of the configuration file format which we understand.
+const configVersion = 1
+
+// The code we generate when it's not configured.
+const defaultGenerate = "types,client,server,spec"
+
+// configuration holds the settings of a run of oapi-codegen. They're read
+// from the file given with -config, and then from the command line flags,
+// which take precedence. Each key of the file has the name of its flag, if
+// it has one.
+type configuration struct {
+	Version       int               `yaml:"version" json:"version"`
+	PackageName   string            `yaml:"package" json:"package"`
+	Generate      []string          `yaml:"generate" json:"generate"`
+	OutputFile    string            `yaml:"output" json:"output"`
+	OutputDir     string            `yaml:"output-dir" json:"output-dir"`
+	IncludeTags   []string          `yaml:"include-tags" json:"include-tags"`
+	ExcludeTags   []string          `yaml:"exclude-tags" json:"exclude-tags"`
+	TemplatesDir  string            `yaml:"templates" json:"templates"`
+	TypeMapping   map[string]string `yaml:"type-mapping" json:"type-mapping"`
+	ImportMapping map[string]string `yaml:"import-mapping" json:"import-mapping"`
+	TypesPackage  string            `yaml:"types-package" json:"types-package"`
+	SharedPackage string            `yaml:"shared-package" json:"shared-package"`
+	SharedOutput  string            `yaml:"shared-output" json:"shared-output"`
+	Specs         []specification   `yaml:"specs" json:"specs"`
+	Headers       map[string]string `yaml:"headers" json:"headers"`
+	HeaderHosts   []string          `yaml:"header-hosts" json:"header-hosts"`
+}
+
+// specification is one of several specs, which are generated into packages
+// of their own in a single run. When neither of its outputs is given, it's
+// generated into a directory named after its package, in the output-dir.
+type specification struct {
+	Spec        string `yaml:"spec" json:"spec"`
+	PackageName string `yaml:"package" json:"package"`
+	OutputFile  string `yaml:"output" json:"output"`
+	OutputDir   string `yaml:"output-dir" json:"output-dir"`
+}
+
+// This reads a configuration file, which is JSON when its name ends with
+// ".json", and YAML otherwise. Unknown keys are an error, rather than being
+// ignored, since they're most likely typos.
+func loadConfiguration(fileName string) (configuration, error) {
+	var config configuration
+	data, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return config, errors.Wrap(err, "error reading config file")
+	}
+
+	unmarshal := yaml.Unmarshal
+	if strings.EqualFold(filepath.Ext(fileName), ".json") {
+		unmarshal = json.Unmarshal
+	}
+
+	var keys map[string]interface{}
+	err = unmarshal(data, &keys)
+	if err != nil {
+		return config, errors.Wrap(err, fmt.Sprintf("error parsing config file %s", fileName))
+	}
+	unknown := findUnknownKeys(keys, reflect.TypeOf(config), "")
+	if len(unknown) != 0 {
+		sort.Strings(unknown)
+		return config, fmt.Errorf("unknown keys in config file %s: %s", fileName, strings.Join(unknown, ", "))
+	}
+
+	err = unmarshal(data, &config)
+	if err != nil {
+		return config, errors.Wrap(err, fmt.Sprintf("error parsing config file %s", fileName))
+	}
+	if config.Version != configVersion {
+		return config, fmt.Errorf("config file %s has version %d, but only version %d is supported", fileName, config.Version, configVersion)
+	}
+	// Headers usually hold credentials, which are better kept in the
+	// environment than in the file.
+	for name, value := range config.Headers {
+		config.Headers[name] = os.ExpandEnv(value)
+	}
+
+	// Paths in the file are relative to its directory, so that it works the
+	// same wherever it's run from.
+	dir := filepath.Dir(fileName)
+	config.OutputFile = resolvePath(dir, config.OutputFile)
+	config.OutputDir = resolvePath(dir, config.OutputDir)
+	config.TemplatesDir = resolvePath(dir, config.TemplatesDir)
+	config.SharedOutput = resolvePath(dir, config.SharedOutput)
+	for i := range config.Specs {
+		spec := &config.Specs[i]
+		spec.Spec = resolvePath(dir, spec.Spec)
+		spec.OutputFile = resolvePath(dir, spec.OutputFile)
+		spec.OutputDir = resolvePath(dir, spec.OutputDir)
+	}
+	return config, nil
+}
+
+// This joins a path from a config file to the directory of the file, unless
+// it's empty, absolute, a URL, or the standard input.
+func resolvePath(dir string, path string) string {
+	if path == "" || path == util.StdinLocation || filepath.IsAbs(path) {
+		return path
+	}
+	if u, err := url.Parse(path); err == nil && (u.Scheme == "http" || u.Scheme == "https") {
+		return path
+	}
+	return filepath.Join(dir, path)
+}
+
+// This returns the keys of a decoded object which aren't named by the yaml
+// tag of any field of the struct type t, looking inside lists of structs as
+// well. Keys are prefixed with the path to the object, eg, "specs[1].".
+func findUnknownKeys(object interface{}, t reflect.Type, prefix string) []string {
+	fields := make(map[string]reflect.StructField)
+	for i := 0; i < t.NumField(); i++ {
+		fields[t.Field(i).Tag.Get("yaml")] = t.Field(i)
+	}
+
+	// YAML objects are decoded with keys of any type, and JSON ones with
+	// string keys.
+	values := make(map[string]interface{})
+	switch o := object.(type) {
+	case map[string]interface{}:
+		values = o
+	case map[interface{}]interface{}:
+		for key, value := range o {
+			values[fmt.Sprint(key)] = value
+		}
+	}
+
+	var unknown []string
+	for key, value := range values {
+		field, found := fields[key]
+		if !found {
+			unknown = append(unknown, prefix+key)
+			continue
+		}
+		list, isList := value.([]interface{})
+		if field.Type.Kind() == reflect.Slice && field.Type.Elem().Kind() == reflect.Struct && isList {
+			for i, item := range list {
+				unknown = append(unknown, findUnknownKeys(item, field.Type.Elem(), fmt.Sprintf("%s%s[%d].", prefix, key, i))...)
+			}
+		}
+	}
+	return unknown
+}
+
+// This overrides the configured value of a command line flag, which was
+// given on the command line.
+func (c *configuration) setFlag(name string, value string) error {
+	var err error
+	switch name {
+	case "package":
+		c.PackageName = value
+	case "generate":
+		c.Generate = splitCSVArg(value)
+	case "o":
+		// A flag for one output replaces the other one of the file
+		c.OutputFile = value
+		c.OutputDir = ""
+	case "output-dir":
+		c.OutputDir = value
+		c.OutputFile = ""
+	case "include-tags":
+		c.IncludeTags = splitCSVArg(value)
+	case "exclude-tags":
+		c.ExcludeTags = splitCSVArg(value)
+	case "templates":
+		c.TemplatesDir = value
+	case "type-mapping":
+		c.TypeMapping, err = parseMappingArg(value, "type/format=GoType")
+		if err != nil {
+			return errors.Wrap(err, "error parsing type mapping")
+		}
+	case "import-mapping":
+		c.ImportMapping, err = parseMappingArg(value, "document=import/path")
+		if err != nil {
+			return errors.Wrap(err, "error parsing import mapping")
+		}
+	case "types-package":
+		c.TypesPackage = value
+	case "shared-package":
+		c.SharedPackage = value
+	case "shared-output":
+		c.SharedOutput = value
+	case "header-hosts":
+		c.HeaderHosts = splitCSVArg(value)
+	case "header":
+		headers, err := parseHeaders(value)
+		if err != nil {
+			return err
+		}
+		if c.Headers == nil {
+			c.Headers = make(map[string]string)
+		}
+		for name, value := range headers {
+			c.Headers[name] = value
+		}
+	}
+	return nil
+}
+
+// This converts the configuration into the options for the code generator.
+// User templates are loaded separately.
+func (c configuration) options() (codegen.Options, error) {
+	opts := codegen.Options{}
+	generate := c.Generate
+	if len(generate) == 0 {
+		generate = splitCSVArg(defaultGenerate)
+	}
+	for _, g := range generate {
+		switch g {
+		case "client":
+			opts.GenerateClient = true
+		case "chi-server":
+			opts.GenerateChiServer = true
+		case "server":
+			opts.GenerateEchoServer = true
+		case "gin-server":
+			opts.GenerateGinServer = true
+		case "gorilla-server":
+			opts.GenerateGorillaServer = true
+		case "std-http":
+			opts.GenerateStdHTTPServer = true
+		case "strict-server":
+			opts.GenerateStrictServer = true
+		case "types":
+			opts.GenerateTypes = true
+		case "spec":
+			opts.EmbedSpec = true
+		case "skip-fmt":
+			opts.SkipFmt = true
+		case "skip-prune":
+			opts.SkipPrune = true
+		case "nullable-types":
+			opts.NullableTypes = true
+		case "read-write-variants":
+			opts.ReadWriteVariants = true
+		case "plain-string-formats":
+			opts.PlainStringFormats = true
+		case "validation":
+			opts.GenerateValidation = true
+		default:
+			return opts, fmt.Errorf("unknown generate option %s", g)
+		}
+	}
+
+	servers := 0
+	for _, server := range []bool{opts.GenerateEchoServer, opts.GenerateChiServer, opts.GenerateGinServer, opts.GenerateGorillaServer, opts.GenerateStdHTTPServer} {
+		if server {
+			servers++
+		}
+	}
+	if servers > 1 {
+		return opts, errors.New("can not specify more than one of the server, chi-server, gin-server, gorilla-server and std-http targets simultaneously")
+	}
+	if c.OutputFile != "" && c.OutputDir != "" {
+		return opts, errors.New("can not specify both an output file and an output directory")
+	}
+
+	opts.IncludeTags = c.IncludeTags
+	opts.ExcludeTags = c.ExcludeTags
+	opts.TypeMapping = c.TypeMapping
+	opts.ImportMapping = c.ImportMapping
+	opts.TypesPackage = c.TypesPackage
+	return opts, nil
+}
+
+// headerFlags collects the values of the -header flag, which may be given
+// more than once. They're joined by newlines, which can't be in a header.
+type headerFlags []string
+
+func (h *headerFlags) String() string {
+	return strings.Join(*h, "\n")
+}
+
+func (h *headerFlags) Set(value string) error {
+	*h = append(*h, value)
+	return nil
+}
+
+// This parses headers of the form "Name: value", one per line.
+func parseHeaders(input string) (map[string]string, error) {
+	headers := make(map[string]string)
+	for _, line := range strings.Split(input, "\n") {
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return nil, fmt.Errorf("header '%s' must be of the form \"Name: value\"", line)
+		}
+		headers[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+	}
+	return headers, nil
+}
@@ -0,0 +1,200 @@
+// Copyright 2019 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deepmap/oapi-codegen/pkg/codegen"
+)
+
+func writeConfigFile(t *testing.T, dir string, name string, contents string) string {
+	fileName := filepath.Join(dir, name)
+	require.NoError(t, ioutil.WriteFile(fileName, []byte(contents), 0644))
+	return fileName
+}
+
+func TestLoadConfiguration(t *testing.T) {
+	dir, err := ioutil.TempDir("", "oapi-codegen")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	yamlFile := writeConfigFile(t, dir, "oapi.yaml", `
+version: 1
+package: api
+generate: [types, client, validation]
+output: api.gen.go
+exclude-tags: [admin]
+type-mapping:
+  string/decimal: github.com/shopspring/decimal.Decimal
+import-mapping:
+  common.yaml: github.com/me/api/common
+`)
+	config, err := loadConfiguration(yamlFile)
+	require.NoError(t, err)
+	assert.Equal(t, configuration{
+		Version:       1,
+		PackageName:   "api",
+		Generate:      []string{"types", "client", "validation"},
+		OutputFile:    filepath.Join(dir, "api.gen.go"),
+		ExcludeTags:   []string{"admin"},
+		TypeMapping:   map[string]string{"string/decimal": "github.com/shopspring/decimal.Decimal"},
+		ImportMapping: map[string]string{"common.yaml": "github.com/me/api/common"},
+	}, config)
+
+	jsonFile := writeConfigFile(t, dir, "oapi.json", `{
+	"version": 1,
+	"package": "api",
+	"generate": ["types", "client", "validation"],
+	"output": "api.gen.go",
+	"exclude-tags": ["admin"],
+	"type-mapping": {"string/decimal": "github.com/shopspring/decimal.Decimal"},
+	"import-mapping": {"common.yaml": "github.com/me/api/common"}
+}`)
+	jsonConfig, err := loadConfiguration(jsonFile)
+	require.NoError(t, err)
+	assert.Equal(t, config, jsonConfig)
+
+	// Flags override the file
+	require.NoError(t, config.setFlag("package", "other"))
+	require.NoError(t, config.setFlag("generate", "types,server"))
+	require.NoError(t, config.setFlag("type-mapping", "integer=int64"))
+	assert.Equal(t, "other", config.PackageName)
+	assert.Equal(t, []string{"types", "server"}, config.Generate)
+	assert.Equal(t, map[string]string{"integer": "int64"}, config.TypeMapping)
+	assert.Equal(t, []string{"admin"}, config.ExcludeTags)
+
+	opts, err := config.options()
+	require.NoError(t, err)
+	assert.Equal(t, codegen.Options{
+		GenerateTypes:      true,
+		GenerateEchoServer: true,
+		ExcludeTags:        []string{"admin"},
+		TypeMapping:        map[string]string{"integer": "int64"},
+		ImportMapping:      map[string]string{"common.yaml": "github.com/me/api/common"},
+	}, opts)
+}
+
+func TestLoadConfigurationErrors(t *testing.T) {
+	dir, err := ioutil.TempDir("", "oapi-codegen")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	_, err = loadConfiguration(writeConfigFile(t, dir, "oapi.yaml", "version: 1\npackage: api\npackge: api\noutput_dir: gen\n"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown keys in config file")
+	assert.Contains(t, err.Error(), "output_dir, packge")
+
+	_, err = loadConfiguration(writeConfigFile(t, dir, "oapi.yaml", "version: 1\nspecs:\n  - spec: a.yaml\n  - spec: b.yaml\n    pakage: b\n"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown keys in config file")
+	assert.Contains(t, err.Error(), "specs[1].pakage")
+
+	_, err = loadConfiguration(writeConfigFile(t, dir, "oapi.json", `{"version": 1, "specs": [{"spec": "a.yaml", "out": "a.go"}]}`))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "specs[0].out")
+
+	_, err = loadConfiguration(writeConfigFile(t, dir, "oapi.yaml", "package: api\n"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "has version 0")
+
+	_, err = loadConfiguration(writeConfigFile(t, dir, "oapi.json", `{"version": 1, "generate": "types"}`))
+	assert.Error(t, err, "Expected an error on a generate option which isn't a list")
+
+	config := configuration{Version: 1, Generate: []string{"types", "servre"}}
+	_, err = config.options()
+	assert.EqualError(t, err, "unknown generate option servre")
+
+	config = configuration{Version: 1, OutputFile: "api.gen.go", OutputDir: "api"}
+	_, err = config.options()
+	assert.Error(t, err)
+}
+
+func TestConfigurationPaths(t *testing.T) {
+	dir, err := ioutil.TempDir("", "oapi-codegen")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// Paths are relative to the directory of the file, rather than to the
+	// working directory.
+	absolute := filepath.Join(dir, "abs", "shared.go")
+	config, err := loadConfiguration(writeConfigFile(t, dir, "oapi.yaml", `
+version: 1
+output-dir: gen
+templates: ../templates
+shared-output: `+absolute+`
+specs:
+  - spec: specs/pets.yaml
+    output: pets/pets.gen.go
+  - spec: https://example.com/specs/users.yaml
+  - spec: "-"
+    package: stdin
+`))
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "gen"), config.OutputDir)
+	assert.Equal(t, filepath.Join(filepath.Dir(dir), "templates"), config.TemplatesDir)
+	assert.Equal(t, absolute, config.SharedOutput)
+	assert.Equal(t, []specification{
+		{Spec: filepath.Join(dir, "specs", "pets.yaml"), OutputFile: filepath.Join(dir, "pets", "pets.gen.go")},
+		{Spec: "https://example.com/specs/users.yaml"},
+		{Spec: "-", PackageName: "stdin"},
+	}, config.Specs)
+
+	// A flag for one output replaces the other output of the file, rather
+	// than being in conflict with it.
+	require.NoError(t, config.setFlag("o", "api.gen.go"))
+	assert.Equal(t, "api.gen.go", config.OutputFile)
+	assert.Equal(t, "", config.OutputDir)
+	_, err = config.options()
+	assert.NoError(t, err)
+
+	require.NoError(t, config.setFlag("output-dir", "out"))
+	assert.Equal(t, "out", config.OutputDir)
+	assert.Equal(t, "", config.OutputFile)
+}
+
+func TestConfigurationHeaders(t *testing.T) {
+	dir, err := ioutil.TempDir("", "oapi-codegen")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	require.NoError(t, os.Setenv("OAPI_CODEGEN_TEST_TOKEN", "secret"))
+	defer os.Unsetenv("OAPI_CODEGEN_TEST_TOKEN")
+	config, err := loadConfiguration(writeConfigFile(t, dir, "oapi.yaml", `
+version: 1
+headers:
+  Authorization: Bearer ${OAPI_CODEGEN_TEST_TOKEN}
+  X-Team: api
+`))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"Authorization": "Bearer secret", "X-Team": "api"}, config.Headers)
+
+	// Each -header flag adds a header, or overrides one from the file
+	headers := headerFlags{}
+	require.NoError(t, headers.Set("X-Team: web"))
+	require.NoError(t, headers.Set("X-Trace:on"))
+	require.NoError(t, config.setFlag("header", headers.String()))
+	assert.Equal(t, map[string]string{"Authorization": "Bearer secret", "X-Team": "web", "X-Trace": "on"}, config.Headers)
+
+	assert.Error(t, config.setFlag("header", "Authorization"))
+
+	require.NoError(t, config.setFlag("header-hosts", "schemas.example.com, example.org:8080"))
+	assert.Equal(t, []string{"schemas.example.com", "example.org:8080"}, config.HeaderHosts)
+}
@@ -17,11 +17,15 @@ import (
 	"flag"
 	"fmt"
 	"io/ioutil"
+	"net/url"
 	"os"
 	"path"
 	"path/filepath"
 	"strings"
 
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/pkg/errors"
+
 	"github.com/deepmap/oapi-codegen/pkg/codegen"
 	"github.com/deepmap/oapi-codegen/pkg/util"
 )
@@ -32,93 +36,198 @@ func errExit(format string, args ...interface{}) {
 }
 
 func main() {
-	var (
-		packageName  string
-		generate     string
-		outputFile   string
-		includeTags  string
-		excludeTags  string
-		templatesDir string
-	)
-	flag.StringVar(&packageName, "package", "", "The package name for generated code")
-	flag.StringVar(&generate, "generate", "types,client,server,spec",
-		`Comma-separated list of code to generate; valid options: "types", "client", "chi-server", "server", "spec", "skip-fmt", "skip-prune"`)
-	flag.StringVar(&outputFile, "o", "", "Where to output generated code, stdout is default")
-	flag.StringVar(&includeTags, "include-tags", "", "Only include operations with the given tags. Comma-separated list of tags.")
-	flag.StringVar(&excludeTags, "exclude-tags", "", "Exclude operations that are tagged with the given tags. Comma-separated list of tags.")
-	flag.StringVar(&templatesDir, "templates", "", "Path to directory containing user templates")
+	// The flags which are given override the configuration file, so rather
+	// than reading them into variables, we apply them with flag.Visit.
+	var configFile string
+	flag.StringVar(&configFile, "config", "", "YAML or JSON file with the configuration, whose keys are named after these flags, except for -o, which is \"output\"; flags take precedence over it")
+	flag.String("package", "", "The package name for generated code")
+	flag.String("generate", defaultGenerate,
+		`Comma-separated list of code to generate; valid options: "types", "client", "chi-server", "gin-server", "gorilla-server", "server", "std-http", "strict-server", "spec", "skip-fmt", "skip-prune", "nullable-types", "read-write-variants", "plain-string-formats", "validation"`)
+	flag.String("o", "", "Where to output generated code, stdout is default")
+	flag.String("output-dir", "", "Directory to write the generated code to, split into types.gen.go, client.gen.go, server.gen.go and spec.gen.go")
+	flag.String("include-tags", "", "Only include operations with the given tags. Comma-separated list of tags.")
+	flag.String("exclude-tags", "", "Exclude operations that are tagged with the given tags. Comma-separated list of tags.")
+	flag.String("templates", "", "Path to directory containing user templates")
+	flag.String("types-package", "", "Import path of the package holding the generated types, when generating a client or server in another package")
+	flag.String("import-mapping", "", `Comma-separated list of Go import paths for the documents of external references, eg, "common.yaml=github.com/me/api/common"`)
+	flag.String("type-mapping", "", `Comma-separated list of Go types for OpenAPI types and formats, eg, "integer=int64,string/decimal=github.com/shopspring/decimal.Decimal"`)
+	flag.String("shared-package", "", "Import path of the package to generate the schemas which are identical in several specs into, when generating more than one spec")
+	flag.String("shared-output", "", "Where to output the code of the shared package, which is otherwise a directory named after it in the -output-dir")
+	flag.Var(&headerFlags{}, "header", `Header to send when fetching the spec from a URL, eg, "Authorization: Bearer $TOKEN"; may be given more than once`)
+	flag.String("header-hosts", "", "Comma-separated list of hosts, other than that of the spec, which the headers are sent to when fetching the documents the spec refers to")
 	flag.Parse()
 
-	if flag.NArg() < 1 {
-		fmt.Println("Please specify a path to a OpenAPI 3.0 spec file")
+	config := configuration{Version: configVersion}
+	if configFile != "" {
+		var err error
+		config, err = loadConfiguration(configFile)
+		if err != nil {
+			errExit("error loading configuration: %s\n", err)
+		}
+	}
+	var flagErr error
+	setFlags := make(map[string]bool)
+	flag.Visit(func(f *flag.Flag) {
+		setFlags[f.Name] = true
+		if flagErr == nil {
+			flagErr = config.setFlag(f.Name, f.Value.String())
+		}
+	})
+	if flagErr != nil {
+		errExit("%s\n", flagErr)
+	}
+	// Each of these flags replaces the other one of the config file, so
+	// they're only in conflict when both are given on the command line.
+	if setFlags["o"] && setFlags["output-dir"] {
+		errExit("-o and -output-dir can not both be given\n")
+	}
+
+	if flag.NArg() < 1 && len(config.Specs) == 0 {
+		fmt.Println("Please specify a path or URL of a OpenAPI 3.0 spec file, or - to read it from stdin")
 		os.Exit(1)
 	}
 
+	opts, err := config.options()
+	if err != nil {
+		fmt.Printf("%s\n", err)
+		flag.PrintDefaults()
+		os.Exit(1)
+	}
+
+	templates, err := loadTemplateOverrides(config.TemplatesDir)
+	if err != nil {
+		errExit("error loading template overrides: %s\n", err)
+	}
+	opts.UserTemplates = templates
+
+	// Several specs are generated into packages of their own
+	if flag.NArg() > 1 || len(config.Specs) != 0 {
+		specs := config.Specs
+		for _, arg := range flag.Args() {
+			specs = append(specs, specification{Spec: arg})
+		}
+		err = generateSpecs(config, specs, opts)
+		if err != nil {
+			errExit("%s\n", err)
+		}
+		return
+	}
+
 	// If the package name has not been specified, we will use the name of the
 	// swagger file.
+	packageName := config.PackageName
 	if packageName == "" {
-		path := flag.Arg(0)
-		baseName := filepath.Base(path)
-		// Split the base name on '.' to get the first part of the file.
-		nameParts := strings.Split(baseName, ".")
-		packageName = codegen.ToCamelCase(nameParts[0])
-	}
-
-	opts := codegen.Options{}
-	for _, g := range splitCSVArg(generate) {
-		switch g {
-		case "client":
-			opts.GenerateClient = true
-		case "chi-server":
-			opts.GenerateChiServer = true
-		case "server":
-			opts.GenerateEchoServer = true
-		case "types":
-			opts.GenerateTypes = true
-		case "spec":
-			opts.EmbedSpec = true
-		case "skip-fmt":
-			opts.SkipFmt = true
-		case "skip-prune":
-			opts.SkipPrune = true
-		default:
-			fmt.Printf("unknown generate option %s\n", g)
-			flag.PrintDefaults()
-			os.Exit(1)
-		}
+		packageName = defaultPackageName(flag.Arg(0))
 	}
-
-	opts.IncludeTags = splitCSVArg(includeTags)
-	opts.ExcludeTags = splitCSVArg(excludeTags)
-
-	if opts.GenerateEchoServer && opts.GenerateChiServer {
-		errExit("can not specify both server and chi-server targets simultaneously")
+	if packageName == "" {
+		errExit("please specify the package name of a spec read from stdin with -package\n")
 	}
 
-	swagger, err := util.LoadSwagger(flag.Arg(0))
+	swagger, err := loadSwagger(flag.Arg(0), config)
 	if err != nil {
 		errExit("error loading swagger spec\n: %s", err)
 	}
 
-	templates, err := loadTemplateOverrides(templatesDir)
+	err = generateOutput(swagger, packageName, opts, config.OutputFile, config.OutputDir)
 	if err != nil {
-		errExit("error loading template overrides: %s\n", err)
+		errExit("%s\n", err)
+	}
+}
+
+// This loads a spec from a file, a URL, or stdin, printing any warnings about
+// converting it to OpenAPI 3.
+func loadSwagger(specPath string, config configuration) (*openapi3.Swagger, error) {
+	swagger, warnings, err := util.LoadSwaggerWithOptions(specPath, util.LoadOptions{
+		Headers:     config.Headers,
+		HeaderHosts: config.HeaderHosts,
+	})
+	for _, warning := range warnings {
+		_, _ = fmt.Fprintf(os.Stderr, "warning: %s: %s\n", specPath, warning)
+	}
+	return swagger, err
+}
+
+// Returns the package name for a spec, which is the first part of the name
+// of its file, or of the last element of the path of its URL. There's none
+// for a spec read from stdin.
+func defaultPackageName(specPath string) string {
+	if specPath == util.StdinLocation {
+		return ""
+	}
+	baseName := filepath.Base(specPath)
+	if u, err := url.Parse(specPath); err == nil && (u.Scheme == "http" || u.Scheme == "https") {
+		baseName = path.Base(u.Path)
+	}
+	// Split the base name on '.' to get the first part of the file.
+	nameParts := strings.Split(baseName, ".")
+	return codegen.ToCamelCase(nameParts[0])
+}
+
+// This generates the code for a spec, and writes it to the output file, or
+// into the output directory, or prints it when there's neither.
+func generateOutput(swagger *openapi3.Swagger, packageName string, opts codegen.Options, outputFile string, outputDir string) error {
+	if outputDir != "" {
+		files, err := codegen.GenerateFiles(swagger, packageName, opts)
+		if err != nil {
+			return errors.Wrap(err, "error generating code")
+		}
+		err = writeOutputDir(outputDir, files)
+		if err != nil {
+			return errors.Wrap(err, "error writing generated code")
+		}
+		return nil
 	}
-	opts.UserTemplates = templates
 
 	code, err := codegen.Generate(swagger, packageName, opts)
 	if err != nil {
-		errExit("error generating code: %s\n", err)
+		return errors.Wrap(err, "error generating code")
 	}
 
 	if outputFile != "" {
 		err = ioutil.WriteFile(outputFile, []byte(code), 0644)
 		if err != nil {
-			errExit("error writing generated code to file: %s", err)
+			return errors.Wrap(err, "error writing generated code to file")
 		}
 	} else {
 		fmt.Println(code)
 	}
+	return nil
+}
+
+// This writes the generated files into the output directory, creating it if
+// need be. Files which we generated previously, but which have nothing in
+// them this time, are removed, so that they don't break the package.
+func writeOutputDir(outputDir string, files map[string]string) error {
+	err := os.MkdirAll(outputDir, 0755)
+	if err != nil {
+		return err
+	}
+	for _, name := range []string{codegen.TypesFile, codegen.ClientFile, codegen.ServerFile, codegen.SpecFile} {
+		fileName := filepath.Join(outputDir, name)
+		code, found := files[name]
+		if found {
+			err = ioutil.WriteFile(fileName, []byte(code), 0644)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		existing, err := ioutil.ReadFile(fileName)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return err
+		}
+		if strings.Contains(string(existing), "Code generated by github.com/deepmap/oapi-codegen DO NOT EDIT.") {
+			err = os.Remove(fileName)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 func splitCSVArg(input string) []string {
@@ -159,3 +268,21 @@ func loadTemplateOverrides(templatesDir string) (map[string]string, error) {
 
 	return templates, nil
 }
+
+// This parses a comma-separated list of mappings of the form "key=value",
+// where form describes them for error messages.
+func parseMappingArg(input string, form string) (map[string]string, error) {
+	args := splitCSVArg(input)
+	if len(args) == 0 {
+		return nil, nil
+	}
+	mapping := make(map[string]string, len(args))
+	for _, arg := range args {
+		parts := strings.SplitN(arg, "=", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" || strings.TrimSpace(parts[1]) == "" {
+			return nil, fmt.Errorf("mapping '%s' must be of the form %s", arg, form)
+		}
+		mapping[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+	}
+	return mapping, nil
+}
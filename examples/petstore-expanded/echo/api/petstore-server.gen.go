@@ -7,11 +7,13 @@ import (
 	"bytes"
 	"compress/gzip"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"github.com/deepmap/oapi-codegen/pkg/runtime"
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
 	"net/http"
+	"path"
 	"strings"
 )
 
@@ -118,50 +120,57 @@ type EchoRouter interface {
 }
 
 // RegisterHandlers adds each server route to the EchoRouter.
-func RegisterHandlers(router EchoRouter, si ServerInterface) {
+func RegisterHandlers(router EchoRouter, si ServerInterface, pathPrefix string) {
 
 	wrapper := ServerInterfaceWrapper{
 		Handler: si,
 	}
 
-	router.GET("/pets", wrapper.FindPets)
-	router.POST("/pets", wrapper.AddPet)
-	router.DELETE("/pets/:id", wrapper.DeletePet)
-	router.GET("/pets/:id", wrapper.FindPetById)
+	router.GET(path.Join(pathPrefix, "/pets"), wrapper.FindPets)
+	router.POST(path.Join(pathPrefix, "/pets"), wrapper.AddPet)
+	router.DELETE(path.Join(pathPrefix, "/pets/:id"), wrapper.DeletePet)
+	router.GET(path.Join(pathPrefix, "/pets/:id"), wrapper.FindPetById)
 
 }
 
+// DecodeAddPetJSONRequestBody decodes the application/json body of AddPet requests. It returns EOF when the request has no body.
+func DecodeAddPetJSONRequestBody(r *http.Request) (AddPetJSONRequestBody, error) {
+	var body AddPetJSONRequestBody
+	err := json.NewDecoder(r.Body).Decode(&body)
+	return body, err
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+RXW48budH9KwV+32OnNbEXedBTvB4vICBrT+LdvKznoYYsSbXgpYcsaiwM9N+DYrdu",
-	"I3kWiwRBgrzo0s1qnjrnVLH62dgUhhQpSjHzZ1PsmgK2nx9yTll/DDkNlIWpXbbJkX47KjbzIJyimY+L",
-	"od3rzDLlgGLmhqO8fWM6I9uBxr+0omx2nQlUCq6++aD97UNokcxxZXa7zmR6rJzJmfkvZtpwv/x+15mP",
-	"9HRHcok7Yriy3UcMBGkJsiYYSC437Izg6jLup+3wetwLoG13hTdhQ+8/Lc38l2fz/5mWZm7+b3YUYjap",
-	"MJty2XUvk2F3CennyI+VgN05rlMx/vTdFTFeIGVn7nf3O73McZlGyaOgbbgpIHszNziwEIY/lydcrSj3",
-	"nEw3UWw+j9fg3d0CfiIMpjM1a9BaZJjPZicxu+5FEu+gYBg8tWBZo0AtVAA1mSIpE2ABjEBfx2WSwFFI",
-	"sUhGIVgSSs1UgGOj4NNAUZ/0tr+BMpDlJVtsW3XGs6VY6OgN825AuyZ409+cQS7z2ezp6anHdrtPeTWb",
-	"YsvsL4v3Hz5+/vCHN/1Nv5bgm2Eoh/Jp+Znyhi1dy3vWlsxUDBZ/ytndlKbpzIZyGUn5Y3/T3+iT00AR",
-	"BzZz87Zd6syAsm6OmClB+mM1Guyc1r+R1BwLoPeNSVjmFBpDZVuEwki1/q+FMqyVZGupFJD0JX7EAIUc",
-	"2BQdB4pSA1CRHn5EshSxgFAYUoaCKxbhAgUHpthBJAt5naKtBQqFkwUsgIGkh3cUCSOgwCrjhh0C1lWl",
-	"DtACo62eW2gP72vGB5aaITlO4FOm0EHKETMBrUiAPE3oItkObM2lFi0IT1Zq6eG2coHAIDUPXDoYqt9w",
-	"xKx7UU6adAfC0bKrUWCDmWuBX2uR1MMiwhotrBUElkIweBRCcGylBqVjMZaU5oKOBy6W4wowimZzzN3z",
-	"qno8ZD6sMZNk3JOo6yEkT0WYgMNA2bEy9XfeYBgTQs+PFQM4RmUmY4FHzW1DngViiiApS8pKCS8pusPu",
-	"PdxlpEJRFCZFDkcANUeETfJVBhTYUKSICngkVz8C1qzPWMTjk5eUJ9aXaNlzOduk7aAf3VFfCyU59KTC",
-	"uk55tJRRNDH97uFzLQNFx8qyRzWPSz7lTh1YyIq6uWXZrKJZd7ChNdvqEbSxZVcDeH6gnHr4MeUHBqpc",
-	"QnKnMujtZmyPliNj/yV+iZ/JNSVqgSWp+Xx6SLkFUDo6JlfJNfSgtRGwPXAin4vvgOpZtYySg6/qQ3Vn",
-	"D3drLOT9WBgD5Sm80dzkJYElVssPdSQc9/voutP4DflJOt5Qztidb611Auy6QyFGflj38LPAQN5TFCp6",
-	"bgypVNJK2hdRD0oF7qtAi27P5f5J+7Qak10DcrBFrNGCZC7SjqUNC1IPP9RiCUhaN3CVD1WgnaJY8pS5",
-	"wRn9uw8I6paKzTy2hoIRAq40ZfKTWj38tY6hIXnVbVSP6uidI5Tu0HwAq9UiGVdO9hzTnswxNZlDNapZ",
-	"VGDg2B2hTIUbufAecFEMlqU6VqilIFTZ+2wSctzpjLS2Xw93p8I05iaMQybhGk4612ia2p34W1tv/0WP",
-	"OB0Z2nG3cGZufuDo9Hxpx0ZWAiiXNoOcHxaCK+37sGQvlOFha3QUMHPzWClvj+e8rjPdNDK2qUQotDPo",
-	"coYaL2DOuNX/Rbbt2NPhpI035wgCfuWgbbyGB8o6z2Qq1UuDldtZ9g1MngPLGajfHEZ39zoAlUFbS0P/",
-	"5uZmP/VQHKe1YfDT4DD7tSjE52tpvzbKjXPcCyJ2F/PPQAJ7MON0tMTq5XfheQ3GONRf2bhG+jpoa9Ue",
-	"PK7pTKkhYN5eGSAU25DKlVHjfSaUNrJFetK1+1mszTV6Bo/YdYmOc96nJ3IXZn3n1KtmnE2pyPfJbf9l",
-	"LOzn6ksa7kjUY+icfh1gm9MZWXKl3T/pmd+0yn+PNS4Eb/fbPDp7ZrcbLeJJrrx+jdc1tnBc+fbOAg+o",
-	"bTaNrlncQqma0xWP3Lbo0SavdrTFrfaQYdR2wjL1Dx2gj+2D3YXS3+ol19+lLnvJd5dZK5ARhftPEvL2",
-	"IEZTYQuLW4X3+gvFuWIHHRe33zp+vt8u3O/Sa0li1/82uf5ny/iFoqP6bQnlzV6ms/f4/St5f/Jiq2+n",
-	"u/vdPwIAAP//v4qmX1cSAAA=",
+	"H4sIAAAAAAAC/+RXW28bydH9K4X+vsfJULGNfeBTtJYXIJC1lWg3L2s9lHqKZC36pu5qyoTB/x5Uz/Am",
+	"ytosEgQJ8sLLTNf0qXNOVdd8NTb6FAMFKWb+1RS7Jo/t54ecY9YfKcdEWZjaZRsH0u+Bis2chGMw83Ex",
+	"tHudWcbsUczccJC3b0xnZJto/EsrymbXGU+l4OqbD9rfPoQWyRxWZrfrTKbHypkGM//FTBvul9/vOvOR",
+	"nm5JLnEH9C9s9xE9QVyCrAkSyeWGnRFcXcb9tE2vxz0D2nZXeBM2dO7T0sx/+Wr+P9PSzM3/zY5CzCYV",
+	"ZlMuu+55MjxcQvo58GMl4OEc16kY3717QYxnSHkw97v7nV7msIyj5EHQNtzkkZ2ZG0wshP5P5QlXK8o9",
+	"R9NNFJu78Rpc3y7gJ0JvOlOzBq1F0nw2O4nZdc+SuIaCPjlqwbJGgVqoAGoyRWImwAIYgL6MyyTCQD6G",
+	"IhmFYEkoNVMBDo2CT4mCPultfwUlkeUlW2xbdcaxpVDo6A1zndCuCd70V2eQy3w2e3p66rHd7mNezabY",
+	"Mvvz4v2Hj3cf/vCmv+rX4l0zDGVfPi3vKG/Y0kt5z9qSmYrB4k45u53SNJ3ZUC4jKX/sr/orfXJMFDCx",
+	"mZu37VJnEsq6OWKmBOmP1Wiwc1r/SlJzKIDONSZhmaNvDJVtEfIj1fq/FsqwVpKtpVJA4ufwET0UGsDG",
+	"MLCnINUDFenhRyRLAQsI+RQzFFyxCBcomJhCB4Es5HUMthYo5E8WsAB6kh6uKRAGQIFVxg0PCFhXlTpA",
+	"C4y2Om6hPbyvGR9YaoY4cAQXM/kOYg6YCWhFAuRoQhfIdmBrLrVoQTiyUksPN5ULeAapOXHpIFW34YBZ",
+	"96IcNekOhIPloQaBDWauBX6tRWIPiwBrtLBWEFgKQXIohDCwleqVjsVYUpoLDpy4WA4rwCCazTF3x6vq",
+	"8JB5WmMmybgnUdeDj46KMAH7RHlgZepvvEE/JoSOHyt6GBiVmYwFHjW3DTkWCDGAxCwxKyW8pDAcdu/h",
+	"NiMVCqIwKbA/Aqg5IGyiq5JQYEOBAirgkVz98FizPmMRjk9eUp5YX6Jlx+Vsk7aDfnRHfS2UOKAjFXbo",
+	"lEdLGUUT0+8e7mpJFAZWlh2qeYboYu7UgYWsqJtbls0qmnUHG1qzrQ6Bg1AeqgfHD5RjDz/G/MBAlYuP",
+	"w6kMersZ26HlwNh/Dp/DHQ1NiVpgSWo+Fx9ibgEUj47JVXL1PWhteBQ5ks/FdUD1rFpGycFV9aG6s4fb",
+	"NRZybiyMRHkKbzQ3eUlgidXyQx0Jx/0+uu40fkNuko43lDN251trnQAP3aEQAz+se/hZIJFzFISKnhsp",
+	"lkqZjkXUg1KB+yrQottzuX/SPq3GZNeAHGwRarAgmYu0Y2nDgtTDD7VYApLWDYbKhyrQTlEsOcrc4Iz+",
+	"3Qd4dUvFZh5bfcEAHleaMrlJrR7+UsdQH53jvXpUR+8coXSH5gNYrRbJuHKy55j2ZI6pyRyqUc2iAgOH",
+	"7ghlKtzAhfeAi2KwLHVghVoKQpW9zyYhx53OSGv79XB7KkxjbsKYMglXf9K5RtPU7sTf2nr7z3rExaT1",
+	"xDEsBjM3P3AY9Hxpx0ZWAiiXNoOcHxaCK+37sGQnlOFha3QUMHPzWClvj+e8rjPdNDK2qUTItzPocoYa",
+	"L2DOuNX/Rbbt2NPhpI035wg8fmGvbbz6B8o6z2Qq1UmDldtZ9g1Mjj3LGajfHEZ3953JVJK2lob+zdXV",
+	"fuqhME5rKblpcJj9WmI4Tspnab82yo1z3DMidhfzTyKBPZhxOlpidfK78LwGYxzqX9i4BvqSyAppDx7X",
+	"dKZU7zFvXxggFFuK5YVR430mlDayBXrStftZrM01egaP2HVJJn1gfKLhwqzXg3rVjLMpFfk+Dtt/GQv7",
+	"ufqShlsS9RgOg34dYJvTGVlypd0/6ZnftMp/jzUuBG/32zw6+8rDbrSII3nh9Wu8rrGFw8q1dxZ4QG2z",
+	"cXTN4gZK1Zxe8MhNix5t8mpHW9xoD0mjthOWqX/oAH1sHzxcKP2tXvLdu3+sl7y7zFqBjCiG/yQhbw5i",
+	"NBW2sLhReK+/UJwrdtBxcfOt4+f77WL4XXotSez63ybX/2wZP1N0VL8tobzZy3T2Hr9/Je9PXmwxsdnd",
+	"7/4+AL+Kpl9XEgAA",
 }
 
 // GetSwagger returns the Swagger specification corresponding to the generated code
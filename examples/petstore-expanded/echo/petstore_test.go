@@ -53,7 +53,7 @@ func TestPetStore(t *testing.T) {
 
 	// We register the autogenerated boilerplate and bind our PetStore to this
 	// echo router.
-	api.RegisterHandlers(e, store)
+	api.RegisterHandlers(e, store, "")
 
 	// At this point, we can start sending simulated Http requests, and record
 	// the HTTP responses to check for validity. This exercises every part of
@@ -59,7 +59,7 @@ type FindPetsParams struct {
 // AddPetJSONBody defines parameters for AddPet.
 type AddPetJSONBody NewPet
 
-// AddPetRequestBody defines body for AddPet for application/json ContentType.
+// AddPetJSONRequestBody defines body for AddPet for application/json ContentType.
 type AddPetJSONRequestBody AddPetJSONBody
 
 // RequestEditorFn  is the function signature for the RequestEditor callback function
@@ -85,6 +85,10 @@ type Client struct {
 	// A callback for modifying requests which are generated before sending over
 	// the network.
 	RequestEditor RequestEditorFn
+
+	// The decoders of response bodies, by media type. When nil,
+	// runtime.DefaultResponseDecoders is used.
+	ResponseDecoders *runtime.ResponseDecoders
 }
 
 // ClientOption allows setting custom parameters during construction
@@ -131,6 +135,19 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 	}
 }
 
+// WithResponseDecoder registers the decoder of the response bodies of a media
+// type, such as application/x-protobuf, for this client only. Registering the
+// decoder of application/cbor decodes the media types with a +cbor suffix too.
+func WithResponseDecoder(mediaType string, decoder runtime.ResponseDecoder) ClientOption {
+	return func(c *Client) error {
+		if c.ResponseDecoders == nil {
+			c.ResponseDecoders = runtime.DefaultResponseDecoders.Clone()
+		}
+		c.ResponseDecoders.Register(mediaType, decoder)
+		return nil
+	}
+}
+
 // The interface specification for the client above.
 type ClientInterface interface {
 	// FindPets request
@@ -157,6 +174,11 @@ func (c *Client) FindPets(ctx context.Context, params *FindPetsParams) (*http.Re
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -172,6 +194,11 @@ func (c *Client) AddPetWithBody(ctx context.Context, contentType string, body io
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -187,6 +214,11 @@ func (c *Client) AddPet(ctx context.Context, body AddPetJSONRequestBody) (*http.
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -202,6 +234,11 @@ func (c *Client) DeletePet(ctx context.Context, id int64) (*http.Response, error
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -217,6 +254,11 @@ func (c *Client) FindPetById(ctx context.Context, id int64) (*http.Response, err
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -227,7 +269,8 @@ func (c *Client) FindPetById(ctx context.Context, id int64) (*http.Response, err
 func NewFindPetsRequest(server string, params *FindPetsParams) (*http.Request, error) {
 	var err error
 
-	queryUrl, err := url.Parse(server)
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
@@ -244,11 +287,18 @@ func NewFindPetsRequest(server string, params *FindPetsParams) (*http.Request, e
 
 	queryValues := queryUrl.Query()
 
+	var queryFrag string
+	var parsed url.Values
+	var queryParamBuf []byte
+	_ = queryFrag
+	_ = parsed
+	_ = queryParamBuf
+
 	if params.Tags != nil {
 
-		if queryFrag, err := runtime.StyleParam("form", true, "tags", *params.Tags); err != nil {
+		if queryFrag, err = runtime.StyleParam("form", true, "tags", *params.Tags); err != nil {
 			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		} else if parsed, err = url.ParseQuery(queryFrag); err != nil {
 			return nil, err
 		} else {
 			for k, v := range parsed {
@@ -262,9 +312,9 @@ func NewFindPetsRequest(server string, params *FindPetsParams) (*http.Request, e
 
 	if params.Limit != nil {
 
-		if queryFrag, err := runtime.StyleParam("form", true, "limit", *params.Limit); err != nil {
+		if queryFrag, err = runtime.StyleParam("form", true, "limit", *params.Limit); err != nil {
 			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		} else if parsed, err = url.ParseQuery(queryFrag); err != nil {
 			return nil, err
 		} else {
 			for k, v := range parsed {
@@ -278,7 +328,8 @@ func NewFindPetsRequest(server string, params *FindPetsParams) (*http.Request, e
 
 	queryUrl.RawQuery = queryValues.Encode()
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	var req *http.Request
+	req, err = http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -301,7 +352,8 @@ func NewAddPetRequest(server string, body AddPetJSONRequestBody) (*http.Request,
 func NewAddPetRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	queryUrl, err := url.Parse(server)
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
@@ -316,7 +368,8 @@ func NewAddPetRequestWithBody(server string, contentType string, body io.Reader)
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryUrl.String(), body)
+	var req *http.Request
+	req, err = http.NewRequest("POST", queryUrl.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -336,7 +389,8 @@ func NewDeletePetRequest(server string, id int64) (*http.Request, error) {
 		return nil, err
 	}
 
-	queryUrl, err := url.Parse(server)
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
@@ -351,7 +405,8 @@ func NewDeletePetRequest(server string, id int64) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryUrl.String(), nil)
+	var req *http.Request
+	req, err = http.NewRequest("DELETE", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -370,7 +425,8 @@ func NewFindPetByIdRequest(server string, id int64) (*http.Request, error) {
 		return nil, err
 	}
 
-	queryUrl, err := url.Parse(server)
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
@@ -385,7 +441,8 @@ func NewFindPetByIdRequest(server string, id int64) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	var req *http.Request
+	req, err = http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -396,6 +453,10 @@ func NewFindPetByIdRequest(server string, id int64) (*http.Request, error) {
 // ClientWithResponses builds on ClientInterface to offer response payloads
 type ClientWithResponses struct {
 	ClientInterface
+
+	// The decoders of response bodies, from the ResponseDecoders of the
+	// Client.
+	decoders *runtime.ResponseDecoders
 }
 
 // NewClientWithResponses creates a new ClientWithResponses, which wraps
@@ -405,7 +466,7 @@ func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithRes
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+	return &ClientWithResponses{ClientInterface: client, decoders: client.ResponseDecoders}, nil
 }
 
 // WithBaseURL overrides the baseURL.
@@ -534,7 +595,7 @@ func (c *ClientWithResponses) FindPetsWithResponse(ctx context.Context, params *
 	if err != nil {
 		return nil, err
 	}
-	return ParseFindPetsResponse(rsp)
+	return ParseFindPetsResponseWithDecoders(rsp, c.decoders)
 }
 
 // AddPetWithBodyWithResponse request with arbitrary body returning *AddPetResponse
@@ -543,7 +604,7 @@ func (c *ClientWithResponses) AddPetWithBodyWithResponse(ctx context.Context, co
 	if err != nil {
 		return nil, err
 	}
-	return ParseAddPetResponse(rsp)
+	return ParseAddPetResponseWithDecoders(rsp, c.decoders)
 }
 
 func (c *ClientWithResponses) AddPetWithResponse(ctx context.Context, body AddPetJSONRequestBody) (*AddPetResponse, error) {
@@ -551,7 +612,7 @@ func (c *ClientWithResponses) AddPetWithResponse(ctx context.Context, body AddPe
 	if err != nil {
 		return nil, err
 	}
-	return ParseAddPetResponse(rsp)
+	return ParseAddPetResponseWithDecoders(rsp, c.decoders)
 }
 
 // DeletePetWithResponse request returning *DeletePetResponse
@@ -560,7 +621,7 @@ func (c *ClientWithResponses) DeletePetWithResponse(ctx context.Context, id int6
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeletePetResponse(rsp)
+	return ParseDeletePetResponseWithDecoders(rsp, c.decoders)
 }
 
 // FindPetByIdWithResponse request returning *FindPetByIdResponse
@@ -569,11 +630,20 @@ func (c *ClientWithResponses) FindPetByIdWithResponse(ctx context.Context, id in
 	if err != nil {
 		return nil, err
 	}
-	return ParseFindPetByIdResponse(rsp)
+	return ParseFindPetByIdResponseWithDecoders(rsp, c.decoders)
 }
 
 // ParseFindPetsResponse parses an HTTP response from a FindPetsWithResponse call
 func ParseFindPetsResponse(rsp *http.Response) (*FindPetsResponse, error) {
+	return ParseFindPetsResponseWithDecoders(rsp, nil)
+}
+
+// ParseFindPetsResponseWithDecoders parses an HTTP response from a FindPetsWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseFindPetsResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*FindPetsResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
@@ -585,20 +655,31 @@ func ParseFindPetsResponse(rsp *http.Response) (*FindPetsResponse, error) {
 		HTTPResponse: rsp,
 	}
 
+	contentType := rsp.Header.Get("Content-Type")
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Pet
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	case runtime.MediaTypeMatches(contentType, "application/json") && rsp.StatusCode == 200:
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest []Pet
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.JSON200 = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
 		}
-		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json"):
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	case runtime.MediaTypeMatches(contentType, "application/json"):
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest Error
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.JSONDefault = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
 		}
-		response.JSONDefault = &dest
 
 	}
 
@@ -607,6 +688,15 @@ func ParseFindPetsResponse(rsp *http.Response) (*FindPetsResponse, error) {
 
 // ParseAddPetResponse parses an HTTP response from a AddPetWithResponse call
 func ParseAddPetResponse(rsp *http.Response) (*AddPetResponse, error) {
+	return ParseAddPetResponseWithDecoders(rsp, nil)
+}
+
+// ParseAddPetResponseWithDecoders parses an HTTP response from a AddPetWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseAddPetResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*AddPetResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
@@ -618,20 +708,31 @@ func ParseAddPetResponse(rsp *http.Response) (*AddPetResponse, error) {
 		HTTPResponse: rsp,
 	}
 
+	contentType := rsp.Header.Get("Content-Type")
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Pet
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	case runtime.MediaTypeMatches(contentType, "application/json") && rsp.StatusCode == 200:
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest Pet
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.JSON200 = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
 		}
-		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json"):
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	case runtime.MediaTypeMatches(contentType, "application/json"):
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest Error
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.JSONDefault = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
 		}
-		response.JSONDefault = &dest
 
 	}
 
@@ -640,6 +741,15 @@ func ParseAddPetResponse(rsp *http.Response) (*AddPetResponse, error) {
 
 // ParseDeletePetResponse parses an HTTP response from a DeletePetWithResponse call
 func ParseDeletePetResponse(rsp *http.Response) (*DeletePetResponse, error) {
+	return ParseDeletePetResponseWithDecoders(rsp, nil)
+}
+
+// ParseDeletePetResponseWithDecoders parses an HTTP response from a DeletePetWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseDeletePetResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*DeletePetResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
@@ -651,13 +761,22 @@ func ParseDeletePetResponse(rsp *http.Response) (*DeletePetResponse, error) {
 		HTTPResponse: rsp,
 	}
 
+	contentType := rsp.Header.Get("Content-Type")
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json"):
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	case rsp.StatusCode == 204:
+		break // No content-type
+
+	case runtime.MediaTypeMatches(contentType, "application/json"):
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest Error
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.JSONDefault = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
 		}
-		response.JSONDefault = &dest
 
 	}
 
@@ -666,6 +785,15 @@ func ParseDeletePetResponse(rsp *http.Response) (*DeletePetResponse, error) {
 
 // ParseFindPetByIdResponse parses an HTTP response from a FindPetByIdWithResponse call
 func ParseFindPetByIdResponse(rsp *http.Response) (*FindPetByIdResponse, error) {
+	return ParseFindPetByIdResponseWithDecoders(rsp, nil)
+}
+
+// ParseFindPetByIdResponseWithDecoders parses an HTTP response from a FindPetByIdWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseFindPetByIdResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*FindPetByIdResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
@@ -677,20 +805,31 @@ func ParseFindPetByIdResponse(rsp *http.Response) (*FindPetByIdResponse, error)
 		HTTPResponse: rsp,
 	}
 
+	contentType := rsp.Header.Get("Content-Type")
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Pet
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	case runtime.MediaTypeMatches(contentType, "application/json") && rsp.StatusCode == 200:
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest Pet
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.JSON200 = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
 		}
-		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json"):
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	case runtime.MediaTypeMatches(contentType, "application/json"):
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest Error
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.JSONDefault = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
 		}
-		response.JSONDefault = &dest
 
 	}
 
@@ -0,0 +1,226 @@
+// Package api provides primitives to interact the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen DO NOT EDIT.
+package api
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"github.com/getkin/kin-openapi/openapi3"
+	"net/http"
+	"strings"
+)
+
+// Error defines model for Error.
+type Error struct {
+
+	// Error code
+	Code int32 `json:"code"`
+
+	// Error message
+	Message string `json:"message"`
+}
+
+// NewPet defines model for NewPet.
+type NewPet struct {
+
+	// Name of the pet
+	Name string `json:"name"`
+
+	// Type of the pet
+	Tag *string `json:"tag,omitempty"`
+}
+
+// Pet defines model for Pet.
+type Pet struct {
+	// Embedded struct due to allOf(#/components/schemas/NewPet)
+	NewPet
+	// Embedded fields due to inline allOf schema
+
+	// Unique id of the pet
+	Id int64 `json:"id"`
+}
+
+// FindPetsParams defines parameters for FindPets.
+type FindPetsParams struct {
+
+	// tags to filter by
+	Tags *[]string `json:"tags,omitempty"`
+
+	// maximum number of results to return
+	Limit *int32 `json:"limit,omitempty"`
+}
+
+// AddPetJSONBody defines parameters for AddPet.
+type AddPetJSONBody NewPet
+
+// AddPetJSONRequestBody defines body for AddPet for application/json ContentType.
+type AddPetJSONRequestBody AddPetJSONBody
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Returns all pets
+	// (GET /pets)
+	FindPets(w http.ResponseWriter, r *http.Request, params FindPetsParams)
+	// Creates a new pet
+	// (POST /pets)
+	AddPet(w http.ResponseWriter, r *http.Request)
+	// Deletes a pet by ID
+	// (DELETE /pets/{id})
+	DeletePet(w http.ResponseWriter, r *http.Request, id int64)
+	// Returns a pet by ID
+	// (GET /pets/{id})
+	FindPetById(w http.ResponseWriter, r *http.Request, id int64)
+}
+
+// ServerInterfaceWrapper converts requests to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// FindPets converts the request to params.
+func (siw *ServerInterfaceWrapper) FindPets(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+
+	// Parameter object where we will unmarshal all parameters from the request
+	var params FindPetsParams
+	// ------------- Optional query parameter "tags" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "tags", r.URL.Query(), &params.Tags); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter tags: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter limit: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	siw.Handler.FindPets(w, r, params)
+}
+
+// AddPet converts the request to params.
+func (siw *ServerInterfaceWrapper) AddPet(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+
+	siw.Handler.AddPet(w, r)
+}
+
+// DeletePet converts the request to params.
+func (siw *ServerInterfaceWrapper) DeletePet(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	if err := runtime.BindStyledParameter("simple", false, "id", pathParams["id"], &id); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter id: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	siw.Handler.DeletePet(w, r, id)
+}
+
+// FindPetById converts the request to params.
+func (siw *ServerInterfaceWrapper) FindPetById(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	if err := runtime.BindStyledParameter("simple", false, "id", pathParams["id"], &id); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter id: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	siw.Handler.FindPetById(w, r, id)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerFromMux(si, http.NewServeMux())
+}
+
+// HandlerFromMux registers the routes of the OpenAPI spec on the provided
+// ServeMux, under the literal prefixes of their paths, and returns it.
+func HandlerFromMux(si ServerInterface, m *http.ServeMux) http.Handler {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+	router := runtime.NewRouter(
+		runtime.Route{Method: "GET", Path: "/pets", Handler: wrapper.FindPets},
+		runtime.Route{Method: "POST", Path: "/pets", Handler: wrapper.AddPet},
+		runtime.Route{Method: "DELETE", Path: "/pets/{id}", Handler: wrapper.DeletePet},
+		runtime.Route{Method: "GET", Path: "/pets/{id}", Handler: wrapper.FindPetById},
+	)
+	for _, pattern := range router.Patterns() {
+		m.Handle(pattern, router)
+	}
+
+	return m
+}
+
+// DecodeAddPetJSONRequestBody decodes the application/json body of AddPet requests. It returns EOF when the request has no body.
+func DecodeAddPetJSONRequestBody(r *http.Request) (AddPetJSONRequestBody, error) {
+	var body AddPetJSONRequestBody
+	err := json.NewDecoder(r.Body).Decode(&body)
+	return body, err
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/+RXW28bydH9K4X+vsfJULGNfeBTtJYXIJC1lWg3L2s9lHqKZC36pu5qyoTB/x5Uz/Am",
+	"ytosEgQJ8sLLTNf0qXNOVdd8NTb6FAMFKWb+1RS7Jo/t54ecY9YfKcdEWZjaZRsH0u+Bis2chGMw83Ex",
+	"tHudWcbsUczccJC3b0xnZJto/EsrymbXGU+l4OqbD9rfPoQWyRxWZrfrTKbHypkGM//FTBvul9/vOvOR",
+	"nm5JLnEH9C9s9xE9QVyCrAkSyeWGnRFcXcb9tE2vxz0D2nZXeBM2dO7T0sx/+Wr+P9PSzM3/zY5CzCYV",
+	"ZlMuu+55MjxcQvo58GMl4OEc16kY3717QYxnSHkw97v7nV7msIyj5EHQNtzkkZ2ZG0wshP5P5QlXK8o9",
+	"R9NNFJu78Rpc3y7gJ0JvOlOzBq1F0nw2O4nZdc+SuIaCPjlqwbJGgVqoAGoyRWImwAIYgL6MyyTCQD6G",
+	"IhmFYEkoNVMBDo2CT4mCPultfwUlkeUlW2xbdcaxpVDo6A1zndCuCd70V2eQy3w2e3p66rHd7mNezabY",
+	"Mvvz4v2Hj3cf/vCmv+rX4l0zDGVfPi3vKG/Y0kt5z9qSmYrB4k45u53SNJ3ZUC4jKX/sr/orfXJMFDCx",
+	"mZu37VJnEsq6OWKmBOmP1Wiwc1r/SlJzKIDONSZhmaNvDJVtEfIj1fq/FsqwVpKtpVJA4ufwET0UGsDG",
+	"MLCnINUDFenhRyRLAQsI+RQzFFyxCBcomJhCB4Es5HUMthYo5E8WsAB6kh6uKRAGQIFVxg0PCFhXlTpA",
+	"C4y2Om6hPbyvGR9YaoY4cAQXM/kOYg6YCWhFAuRoQhfIdmBrLrVoQTiyUksPN5ULeAapOXHpIFW34YBZ",
+	"96IcNekOhIPloQaBDWauBX6tRWIPiwBrtLBWEFgKQXIohDCwleqVjsVYUpoLDpy4WA4rwCCazTF3x6vq",
+	"8JB5WmMmybgnUdeDj46KMAH7RHlgZepvvEE/JoSOHyt6GBiVmYwFHjW3DTkWCDGAxCwxKyW8pDAcdu/h",
+	"NiMVCqIwKbA/Aqg5IGyiq5JQYEOBAirgkVz98FizPmMRjk9eUp5YX6Jlx+Vsk7aDfnRHfS2UOKAjFXbo",
+	"lEdLGUUT0+8e7mpJFAZWlh2qeYboYu7UgYWsqJtbls0qmnUHG1qzrQ6Bg1AeqgfHD5RjDz/G/MBAlYuP",
+	"w6kMersZ26HlwNh/Dp/DHQ1NiVpgSWo+Fx9ibgEUj47JVXL1PWhteBQ5ks/FdUD1rFpGycFV9aG6s4fb",
+	"NRZybiyMRHkKbzQ3eUlgidXyQx0Jx/0+uu40fkNuko43lDN251trnQAP3aEQAz+se/hZIJFzFISKnhsp",
+	"lkqZjkXUg1KB+yrQottzuX/SPq3GZNeAHGwRarAgmYu0Y2nDgtTDD7VYApLWDYbKhyrQTlEsOcrc4Iz+",
+	"3Qd4dUvFZh5bfcEAHleaMrlJrR7+UsdQH53jvXpUR+8coXSH5gNYrRbJuHKy55j2ZI6pyRyqUc2iAgOH",
+	"7ghlKtzAhfeAi2KwLHVghVoKQpW9zyYhx53OSGv79XB7KkxjbsKYMglXf9K5RtPU7sTf2nr7z3rExaT1",
+	"xDEsBjM3P3AY9Hxpx0ZWAiiXNoOcHxaCK+37sGQnlOFha3QUMHPzWClvj+e8rjPdNDK2qUTItzPocoYa",
+	"L2DOuNX/Rbbt2NPhpI035wg8fmGvbbz6B8o6z2Qq1UmDldtZ9g1Mjj3LGajfHEZ3953JVJK2lob+zdXV",
+	"fuqhME5rKblpcJj9WmI4Tspnab82yo1z3DMidhfzTyKBPZhxOlpidfK78LwGYxzqX9i4BvqSyAppDx7X",
+	"dKZU7zFvXxggFFuK5YVR430mlDayBXrStftZrM01egaP2HVJJn1gfKLhwqzXg3rVjLMpFfk+Dtt/GQv7",
+	"ufqShlsS9RgOg34dYJvTGVlypd0/6ZnftMp/jzUuBG/32zw6+8rDbrSII3nh9Wu8rrGFw8q1dxZ4QG2z",
+	"cXTN4gZK1Zxe8MhNix5t8mpHW9xoD0mjthOWqX/oAH1sHzxcKP2tXvLdu3+sl7y7zFqBjCiG/yQhbw5i",
+	"NBW2sLhReK+/UJwrdtBxcfOt4+f77WL4XXotSez63ybX/2wZP1N0VL8tobzZy3T2Hr9/Je9PXmwxsdnd",
+	"7/4+AL+Kpl9XEgAA",
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file.
+func GetSwagger() (*openapi3.Swagger, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %s", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %s", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %s", err)
+	}
+
+	swagger, err := openapi3.NewSwaggerLoader().LoadSwaggerFromData(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("error loading Swagger: %s", err)
+	}
+	return swagger, nil
+}
@@ -0,0 +1,33 @@
+// This is an example of implementing the Pet Store from the OpenAPI documentation
+// found at:
+// https://github.com/OAI/OpenAPI-Specification/blob/master/examples/v3.0/petstore.yaml
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+
+	api "github.com/deepmap/oapi-codegen/examples/petstore-expanded/stdhttp/api"
+)
+
+func main() {
+	var port = flag.Int("port", 8080, "Port for test HTTP server")
+	flag.Parse()
+
+	// Create an instance of our handler which satisfies the generated interface
+	petStore := api.NewPetStore()
+
+	// We now register our petStore above as the handler for the interface
+	h := api.Handler(petStore)
+
+	s := &http.Server{
+		Handler: h,
+		Addr:    fmt.Sprintf("0.0.0.0:%d", *port),
+	}
+
+	// And we serve HTTP until the world ends.
+	log.Fatal(s.ListenAndServe())
+}
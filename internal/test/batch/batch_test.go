@@ -0,0 +1,70 @@
+package batch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deepmap/oapi-codegen/internal/test/batch/billing"
+	"github.com/deepmap/oapi-codegen/internal/test/batch/shared"
+	"github.com/deepmap/oapi-codegen/internal/test/batch/users"
+)
+
+func TestSharedSchemas(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("cursor") == "" {
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(shared.Error{Code: 404, Message: r.URL.Path + " not found"})
+			return
+		}
+		next := shared.Cursor("next")
+		page := map[string]interface{}{
+			"users":      []users.User{{Id: "u1"}},
+			"invoices":   []billing.Invoice{{Id: "i1", User: billing.User{Id: "u1", Account: "a1"}, Total: 10}},
+			"pagination": shared.Pagination{Next: &next},
+		}
+		_ = json.NewEncoder(w).Encode(page)
+	}))
+	defer server.Close()
+
+	usersClient, err := users.NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+	billingClient, err := billing.NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	// Both services return the same shared types
+	var errs []*shared.Error
+	usersResponse, err := usersClient.ListUsersWithResponse(context.Background(), &users.ListUsersParams{})
+	require.NoError(t, err)
+	errs = append(errs, usersResponse.JSONDefault)
+	billingResponse, err := billingClient.ListInvoicesWithResponse(context.Background(), &billing.ListInvoicesParams{})
+	require.NoError(t, err)
+	errs = append(errs, billingResponse.JSONDefault)
+	assert.Equal(t, []*shared.Error{
+		{Code: 404, Message: "/users not found"},
+		{Code: 404, Message: "/invoices not found"},
+	}, errs)
+
+	cursor := shared.Cursor("first")
+	usersResponse, err = usersClient.ListUsersWithResponse(context.Background(), &users.ListUsersParams{Cursor: &cursor})
+	require.NoError(t, err)
+	require.NotNil(t, usersResponse.JSON200)
+	assert.Equal(t, shared.Cursor("next"), *usersResponse.JSON200.Pagination.Next)
+	assert.Equal(t, []users.User{{Id: "u1"}}, usersResponse.JSON200.Users)
+
+	billingResponse, err = billingClient.ListInvoicesWithResponse(context.Background(), &billing.ListInvoicesParams{Cursor: &cursor})
+	require.NoError(t, err)
+	require.NotNil(t, billingResponse.JSON200)
+	assert.Equal(t, "a1", billingResponse.JSON200.Invoices[0].User.Account)
+
+	// The shared types keep their validation
+	empty := shared.Cursor("")
+	_, err = billingClient.ListInvoicesWithResponse(context.Background(), &billing.ListInvoicesParams{Cursor: &empty})
+	assert.Error(t, err)
+}
@@ -0,0 +1,309 @@
+// Code generated by github.com/deepmap/oapi-codegen DO NOT EDIT.
+
+package billing
+
+import (
+	"context"
+	"fmt"
+	"github.com/deepmap/oapi-codegen/internal/test/batch/shared"
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A callback for modifying requests which are generated before sending over
+	// the network.
+	RequestEditor RequestEditorFn
+
+	// The decoders of response bodies, by media type. When nil,
+	// runtime.DefaultResponseDecoders is used.
+	ResponseDecoders *runtime.ResponseDecoders
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = http.DefaultClient
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditor = fn
+		return nil
+	}
+}
+
+// WithResponseDecoder registers the decoder of the response bodies of a media
+// type, such as application/x-protobuf, for this client only. Registering the
+// decoder of application/cbor decodes the media types with a +cbor suffix too.
+func WithResponseDecoder(mediaType string, decoder runtime.ResponseDecoder) ClientOption {
+	return func(c *Client) error {
+		if c.ResponseDecoders == nil {
+			c.ResponseDecoders = runtime.DefaultResponseDecoders.Clone()
+		}
+		c.ResponseDecoders.Register(mediaType, decoder)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// ListInvoices request
+	ListInvoices(ctx context.Context, params *ListInvoicesParams) (*http.Response, error)
+}
+
+func (c *Client) ListInvoices(ctx context.Context, params *ListInvoicesParams) (*http.Response, error) {
+	req, err := NewListInvoicesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if c.RequestEditor != nil {
+		err = c.RequestEditor(ctx, req)
+		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
+			return nil, err
+		}
+	}
+	return c.Client.Do(req)
+}
+
+// NewListInvoicesRequest generates requests for ListInvoices
+func NewListInvoicesRequest(server string, params *ListInvoicesParams) (*http.Request, error) {
+	var err error
+
+	err = params.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/invoices")
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryUrl.Query()
+
+	var queryFrag string
+	var parsed url.Values
+	var queryParamBuf []byte
+	_ = queryFrag
+	_ = parsed
+	_ = queryParamBuf
+
+	if params.Cursor != nil {
+
+		if queryFrag, err = runtime.StyleParam("form", true, "cursor", *params.Cursor); err != nil {
+			return nil, err
+		} else if parsed, err = url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryUrl.RawQuery = queryValues.Encode()
+
+	var req *http.Request
+	req, err = http.NewRequest("GET", queryUrl.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+
+	// The decoders of response bodies, from the ResponseDecoders of the
+	// Client.
+	decoders *runtime.ResponseDecoders
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{ClientInterface: client, decoders: client.ResponseDecoders}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ListInvoices request
+	ListInvoicesWithResponse(ctx context.Context, params *ListInvoicesParams) (*ListInvoicesResponse, error)
+}
+
+type ListInvoicesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InvoicePage
+	JSONDefault  *shared.Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListInvoicesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListInvoicesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ListInvoicesWithResponse request returning *ListInvoicesResponse
+func (c *ClientWithResponses) ListInvoicesWithResponse(ctx context.Context, params *ListInvoicesParams) (*ListInvoicesResponse, error) {
+	rsp, err := c.ListInvoices(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListInvoicesResponseWithDecoders(rsp, c.decoders)
+}
+
+// ParseListInvoicesResponse parses an HTTP response from a ListInvoicesWithResponse call
+func ParseListInvoicesResponse(rsp *http.Response) (*ListInvoicesResponse, error) {
+	return ParseListInvoicesResponseWithDecoders(rsp, nil)
+}
+
+// ParseListInvoicesResponseWithDecoders parses an HTTP response from a ListInvoicesWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseListInvoicesResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*ListInvoicesResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListInvoicesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	contentType := rsp.Header.Get("Content-Type")
+	switch {
+	case runtime.MediaTypeMatches(contentType, "application/json") && rsp.StatusCode == 200:
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest InvoicePage
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.JSON200 = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
+		}
+
+	case runtime.MediaTypeMatches(contentType, "application/json"):
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest shared.Error
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.JSONDefault = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
+		}
+
+	}
+
+	return response, nil
+}
@@ -0,0 +1,68 @@
+// Package billing provides primitives to interact the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen DO NOT EDIT.
+package billing
+
+import (
+	"github.com/deepmap/oapi-codegen/internal/test/batch/shared"
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+)
+
+// Invoice defines model for Invoice.
+type Invoice struct {
+	Id    string  `json:"id"`
+	Total float32 `json:"total"`
+	User  User    `json:"user"`
+}
+
+// InvoicePage defines model for InvoicePage.
+type InvoicePage struct {
+	Invoices   []Invoice         `json:"invoices"`
+	Pagination shared.Pagination `json:"pagination"`
+}
+
+// User defines model for User.
+type User struct {
+	Account string `json:"account"`
+	Id      string `json:"id"`
+}
+
+// ListInvoicesParams defines parameters for ListInvoices.
+type ListInvoicesParams struct {
+	Cursor *shared.Cursor `json:"cursor,omitempty"`
+}
+
+// Validate checks the value of ListInvoicesParams against the constraints of its schema.
+func (t ListInvoicesParams) Validate() error {
+	var errs runtime.ValidationErrors
+	if t.Cursor != nil {
+		errs.Append("cursor", runtime.ValidateValue(*t.Cursor))
+	}
+	return errs.ErrorOrNil()
+}
+
+// Validate checks the value of Invoice against the constraints of its schema.
+func (t Invoice) Validate() error {
+	var errs runtime.ValidationErrors
+	errs.Append("user", runtime.ValidateValue(t.User))
+	return errs.ErrorOrNil()
+}
+
+// Validate checks the value of InvoicePage against the constraints of its schema.
+func (t InvoicePage) Validate() error {
+	var errs runtime.ValidationErrors
+	if t.Invoices == nil {
+		errs.Required("invoices")
+	} else {
+		for i0, item0 := range t.Invoices {
+			errs.Append(runtime.IndexPath("invoices", i0), runtime.ValidateValue(item0))
+		}
+	}
+	errs.Append("pagination", runtime.ValidateValue(t.Pagination))
+	return errs.ErrorOrNil()
+}
+
+// Validate checks the value of User against the constraints of its schema.
+func (User) Validate() error {
+	return nil
+}
@@ -0,0 +1,3 @@
+package batch
+
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen -config batch.yaml
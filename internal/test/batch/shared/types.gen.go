@@ -0,0 +1,43 @@
+// Package shared provides primitives to interact the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen DO NOT EDIT.
+package shared
+
+import (
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+)
+
+// Cursor defines model for Cursor.
+type Cursor string
+
+// Error defines model for Error.
+type Error struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Pagination defines model for Pagination.
+type Pagination struct {
+	Next *Cursor `json:"next,omitempty"`
+}
+
+// Validate checks the value of Cursor against the constraints of its schema.
+func (t Cursor) Validate() error {
+	var errs runtime.ValidationErrors
+	errs.MinLength("", string(t), 1)
+	return errs.ErrorOrNil()
+}
+
+// Validate checks the value of Error against the constraints of its schema.
+func (Error) Validate() error {
+	return nil
+}
+
+// Validate checks the value of Pagination against the constraints of its schema.
+func (t Pagination) Validate() error {
+	var errs runtime.ValidationErrors
+	if t.Next != nil {
+		errs.Append("next", runtime.ValidateValue(*t.Next))
+	}
+	return errs.ErrorOrNil()
+}
@@ -0,0 +1,54 @@
+// Package users provides primitives to interact the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen DO NOT EDIT.
+package users
+
+import (
+	"github.com/deepmap/oapi-codegen/internal/test/batch/shared"
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+)
+
+// User defines model for User.
+type User struct {
+	Id   string  `json:"id"`
+	Name *string `json:"name,omitempty"`
+}
+
+// UserPage defines model for UserPage.
+type UserPage struct {
+	Pagination shared.Pagination `json:"pagination"`
+	Users      []User            `json:"users"`
+}
+
+// ListUsersParams defines parameters for ListUsers.
+type ListUsersParams struct {
+	Cursor *shared.Cursor `json:"cursor,omitempty"`
+}
+
+// Validate checks the value of ListUsersParams against the constraints of its schema.
+func (t ListUsersParams) Validate() error {
+	var errs runtime.ValidationErrors
+	if t.Cursor != nil {
+		errs.Append("cursor", runtime.ValidateValue(*t.Cursor))
+	}
+	return errs.ErrorOrNil()
+}
+
+// Validate checks the value of User against the constraints of its schema.
+func (User) Validate() error {
+	return nil
+}
+
+// Validate checks the value of UserPage against the constraints of its schema.
+func (t UserPage) Validate() error {
+	var errs runtime.ValidationErrors
+	errs.Append("pagination", runtime.ValidateValue(t.Pagination))
+	if t.Users == nil {
+		errs.Required("users")
+	} else {
+		for i0, item0 := range t.Users {
+			errs.Append(runtime.IndexPath("users", i0), runtime.ValidateValue(item0))
+		}
+	}
+	return errs.ErrorOrNil()
+}
@@ -0,0 +1,1254 @@
+// Package bodies provides primitives to interact the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen DO NOT EDIT.
+package bodies
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// NewPet defines model for NewPet.
+type NewPet struct {
+	Age   *int   `json:"age,omitempty"`
+	Name  string `json:"name"`
+	Owner *struct {
+		Name *string `json:"name,omitempty"`
+	} `json:"owner,omitempty"`
+	Tags *[]string `json:"tags,omitempty"`
+}
+
+// Received defines model for Received.
+type Received struct {
+	Body        string `json:"body"`
+	ContentType string `json:"contentType"`
+}
+
+// UploadFileMultipartBody defines parameters for UploadFile.
+type UploadFileMultipartBody struct {
+	File openapi_types.File `json:"file"`
+	Meta *struct {
+		Author *string `json:"author,omitempty"`
+	} `json:"meta,omitempty"`
+}
+
+// UploadFileParams defines parameters for UploadFile.
+type UploadFileParams struct {
+	Folder *string `json:"folder,omitempty"`
+}
+
+// AddPetJSONBody defines parameters for AddPet.
+type AddPetJSONBody NewPet
+
+// AddPetFormdataBody defines parameters for AddPet.
+type AddPetFormdataBody NewPet
+
+// AddPetMultipartBody defines parameters for AddPet.
+type AddPetMultipartBody struct {
+	Name  string              `json:"name"`
+	Photo *openapi_types.File `json:"photo,omitempty"`
+	Tags  *[]string           `json:"tags,omitempty"`
+}
+
+// UploadFileMultipartRequestBody defines body for UploadFile for multipart/form-data ContentType.
+type UploadFileMultipartRequestBody UploadFileMultipartBody
+
+// PutNoteOctetStreamRequestBody defines body for PutNote for application/octet-stream ContentType.
+type PutNoteOctetStreamRequestBody io.Reader
+
+// PutNoteTextRequestBody defines body for PutNote for text/plain ContentType.
+type PutNoteTextRequestBody string
+
+// AddPetJSONRequestBody defines body for AddPet for application/json ContentType.
+type AddPetJSONRequestBody AddPetJSONBody
+
+// AddPetFormdataRequestBody defines body for AddPet for application/x-www-form-urlencoded ContentType.
+type AddPetFormdataRequestBody AddPetFormdataBody
+
+// AddPetMultipartRequestBody defines body for AddPet for multipart/form-data ContentType.
+type AddPetMultipartRequestBody AddPetMultipartBody
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A callback for modifying requests which are generated before sending over
+	// the network.
+	RequestEditor RequestEditorFn
+
+	// The decoders of response bodies, by media type. When nil,
+	// runtime.DefaultResponseDecoders is used.
+	ResponseDecoders *runtime.ResponseDecoders
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = http.DefaultClient
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditor = fn
+		return nil
+	}
+}
+
+// WithResponseDecoder registers the decoder of the response bodies of a media
+// type, such as application/x-protobuf, for this client only. Registering the
+// decoder of application/cbor decodes the media types with a +cbor suffix too.
+func WithResponseDecoder(mediaType string, decoder runtime.ResponseDecoder) ClientOption {
+	return func(c *Client) error {
+		if c.ResponseDecoders == nil {
+			c.ResponseDecoders = runtime.DefaultResponseDecoders.Clone()
+		}
+		c.ResponseDecoders.Register(mediaType, decoder)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// UploadFile request  with any body
+	UploadFileWithBody(ctx context.Context, params *UploadFileParams, contentType string, body io.Reader) (*http.Response, error)
+
+	UploadFileWithMultipartBody(ctx context.Context, params *UploadFileParams, body UploadFileMultipartRequestBody) (*http.Response, error)
+
+	// PutNote request  with any body
+	PutNoteWithBody(ctx context.Context, contentType string, body io.Reader) (*http.Response, error)
+
+	PutNoteWithOctetStreamBody(ctx context.Context, body PutNoteOctetStreamRequestBody) (*http.Response, error)
+
+	PutNoteWithTextBody(ctx context.Context, body PutNoteTextRequestBody) (*http.Response, error)
+
+	// AddPet request  with any body
+	AddPetWithBody(ctx context.Context, contentType string, body io.Reader) (*http.Response, error)
+
+	AddPet(ctx context.Context, body AddPetJSONRequestBody) (*http.Response, error)
+
+	AddPetWithFormdataBody(ctx context.Context, body AddPetFormdataRequestBody) (*http.Response, error)
+
+	AddPetWithMultipartBody(ctx context.Context, body AddPetMultipartRequestBody) (*http.Response, error)
+}
+
+func (c *Client) UploadFileWithBody(ctx context.Context, params *UploadFileParams, contentType string, body io.Reader) (*http.Response, error) {
+	req, err := NewUploadFileRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if c.RequestEditor != nil {
+		err = c.RequestEditor(ctx, req)
+		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
+			return nil, err
+		}
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UploadFileWithMultipartBody(ctx context.Context, params *UploadFileParams, body UploadFileMultipartRequestBody) (*http.Response, error) {
+	req, err := NewUploadFileRequestWithMultipartBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if c.RequestEditor != nil {
+		err = c.RequestEditor(ctx, req)
+		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
+			return nil, err
+		}
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutNoteWithBody(ctx context.Context, contentType string, body io.Reader) (*http.Response, error) {
+	req, err := NewPutNoteRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if c.RequestEditor != nil {
+		err = c.RequestEditor(ctx, req)
+		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
+			return nil, err
+		}
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutNoteWithOctetStreamBody(ctx context.Context, body PutNoteOctetStreamRequestBody) (*http.Response, error) {
+	req, err := NewPutNoteRequestWithOctetStreamBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if c.RequestEditor != nil {
+		err = c.RequestEditor(ctx, req)
+		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
+			return nil, err
+		}
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutNoteWithTextBody(ctx context.Context, body PutNoteTextRequestBody) (*http.Response, error) {
+	req, err := NewPutNoteRequestWithTextBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if c.RequestEditor != nil {
+		err = c.RequestEditor(ctx, req)
+		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
+			return nil, err
+		}
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddPetWithBody(ctx context.Context, contentType string, body io.Reader) (*http.Response, error) {
+	req, err := NewAddPetRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if c.RequestEditor != nil {
+		err = c.RequestEditor(ctx, req)
+		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
+			return nil, err
+		}
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddPet(ctx context.Context, body AddPetJSONRequestBody) (*http.Response, error) {
+	req, err := NewAddPetRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if c.RequestEditor != nil {
+		err = c.RequestEditor(ctx, req)
+		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
+			return nil, err
+		}
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddPetWithFormdataBody(ctx context.Context, body AddPetFormdataRequestBody) (*http.Response, error) {
+	req, err := NewAddPetRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if c.RequestEditor != nil {
+		err = c.RequestEditor(ctx, req)
+		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
+			return nil, err
+		}
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddPetWithMultipartBody(ctx context.Context, body AddPetMultipartRequestBody) (*http.Response, error) {
+	req, err := NewAddPetRequestWithMultipartBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if c.RequestEditor != nil {
+		err = c.RequestEditor(ctx, req)
+		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
+			return nil, err
+		}
+	}
+	return c.Client.Do(req)
+}
+
+// NewUploadFileRequestWithMultipartBody calls the generic UploadFile builder with multipart/form-data body
+// The body is written by a goroutine as the request reads it, so the request
+// must either be sent, or have its Body closed, which stops the goroutine.
+// Files made with types.File's InitFromReader are read as they're sent,
+// rather than held in memory.
+func NewUploadFileRequestWithMultipartBody(server string, params *UploadFileParams, body UploadFileMultipartRequestBody) (*http.Request, error) {
+	// The body is streamed to the request as it's written, so that files which
+	// are read from readers aren't held in memory.
+	bodyReader, bodyWriter := io.Pipe()
+	writer := multipart.NewWriter(bodyWriter)
+	go func() {
+		err := runtime.MarshalMultipartWithEncoding(body, writer, map[string]runtime.FormEncoding{
+			"file": {ContentType: "image/png"},
+			"meta": {ContentType: "application/vnd.meta+json"},
+		})
+		if err == nil {
+			err = writer.Close()
+		}
+		bodyWriter.CloseWithError(err)
+	}()
+	req, err := NewUploadFileRequestWithBody(server, params, writer.FormDataContentType(), bodyReader)
+	if err != nil {
+		bodyReader.CloseWithError(err)
+		return nil, err
+	}
+	return req, nil
+}
+
+// NewUploadFileRequestWithBody generates requests for UploadFile with any type of body
+func NewUploadFileRequestWithBody(server string, params *UploadFileParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/files")
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryUrl.Query()
+
+	var queryFrag string
+	var parsed url.Values
+	var queryParamBuf []byte
+	_ = queryFrag
+	_ = parsed
+	_ = queryParamBuf
+
+	if params.Folder != nil {
+
+		if queryFrag, err = runtime.StyleParam("form", true, "folder", *params.Folder); err != nil {
+			return nil, err
+		} else if parsed, err = url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryUrl.RawQuery = queryValues.Encode()
+
+	var req *http.Request
+	req, err = http.NewRequest("POST", queryUrl.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+	return req, nil
+}
+
+// NewPutNoteRequestWithOctetStreamBody calls the generic PutNote builder with application/octet-stream body
+func NewPutNoteRequestWithOctetStreamBody(server string, body PutNoteOctetStreamRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyReader = body
+	return NewPutNoteRequestWithBody(server, "application/octet-stream", bodyReader)
+}
+
+// NewPutNoteRequestWithTextBody calls the generic PutNote builder with text/plain body
+func NewPutNoteRequestWithTextBody(server string, body PutNoteTextRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyReader = strings.NewReader(string(body))
+	return NewPutNoteRequestWithBody(server, "text/plain", bodyReader)
+}
+
+// NewPutNoteRequestWithBody generates requests for PutNote with any type of body
+func NewPutNoteRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/notes")
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var req *http.Request
+	req, err = http.NewRequest("PUT", queryUrl.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+	return req, nil
+}
+
+// NewAddPetRequest calls the generic AddPet builder with application/json body
+func NewAddPetRequest(server string, body AddPetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddPetRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAddPetRequestWithFormdataBody calls the generic AddPet builder with application/x-www-form-urlencoded body
+func NewAddPetRequestWithFormdataBody(server string, body AddPetFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	form, err := runtime.MarshalFormWithEncoding(body, map[string]runtime.FormEncoding{
+		"owner": {Style: "form", Explode: false},
+		"tags":  {Style: "pipeDelimited", Explode: false},
+	})
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(form.Encode())
+	return NewAddPetRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewAddPetRequestWithMultipartBody calls the generic AddPet builder with multipart/form-data body
+// The body is written by a goroutine as the request reads it, so the request
+// must either be sent, or have its Body closed, which stops the goroutine.
+// Files made with types.File's InitFromReader are read as they're sent,
+// rather than held in memory.
+func NewAddPetRequestWithMultipartBody(server string, body AddPetMultipartRequestBody) (*http.Request, error) {
+	// The body is streamed to the request as it's written, so that files which
+	// are read from readers aren't held in memory.
+	bodyReader, bodyWriter := io.Pipe()
+	writer := multipart.NewWriter(bodyWriter)
+	go func() {
+		err := runtime.MarshalMultipart(body, writer)
+		if err == nil {
+			err = writer.Close()
+		}
+		bodyWriter.CloseWithError(err)
+	}()
+	req, err := NewAddPetRequestWithBody(server, writer.FormDataContentType(), bodyReader)
+	if err != nil {
+		bodyReader.CloseWithError(err)
+		return nil, err
+	}
+	return req, nil
+}
+
+// NewAddPetRequestWithBody generates requests for AddPet with any type of body
+func NewAddPetRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/pets")
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var req *http.Request
+	req, err = http.NewRequest("POST", queryUrl.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+	return req, nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+
+	// The decoders of response bodies, from the ResponseDecoders of the
+	// Client.
+	decoders *runtime.ResponseDecoders
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{ClientInterface: client, decoders: client.ResponseDecoders}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// UploadFile request  with any body
+	UploadFileWithBodyWithResponse(ctx context.Context, params *UploadFileParams, contentType string, body io.Reader) (*UploadFileResponse, error)
+
+	UploadFileWithMultipartBodyWithResponse(ctx context.Context, params *UploadFileParams, body UploadFileMultipartRequestBody) (*UploadFileResponse, error)
+
+	// PutNote request  with any body
+	PutNoteWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader) (*PutNoteResponse, error)
+
+	PutNoteWithOctetStreamBodyWithResponse(ctx context.Context, body PutNoteOctetStreamRequestBody) (*PutNoteResponse, error)
+
+	PutNoteWithTextBodyWithResponse(ctx context.Context, body PutNoteTextRequestBody) (*PutNoteResponse, error)
+
+	// AddPet request  with any body
+	AddPetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader) (*AddPetResponse, error)
+
+	AddPetWithResponse(ctx context.Context, body AddPetJSONRequestBody) (*AddPetResponse, error)
+
+	AddPetWithFormdataBodyWithResponse(ctx context.Context, body AddPetFormdataRequestBody) (*AddPetResponse, error)
+
+	AddPetWithMultipartBodyWithResponse(ctx context.Context, body AddPetMultipartRequestBody) (*AddPetResponse, error)
+}
+
+type UploadFileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Received
+}
+
+// Status returns HTTPResponse.Status
+func (r UploadFileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UploadFileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutNoteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Received
+}
+
+// Status returns HTTPResponse.Status
+func (r PutNoteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutNoteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddPetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Received
+}
+
+// Status returns HTTPResponse.Status
+func (r AddPetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddPetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// UploadFileWithBodyWithResponse request with arbitrary body returning *UploadFileResponse
+func (c *ClientWithResponses) UploadFileWithBodyWithResponse(ctx context.Context, params *UploadFileParams, contentType string, body io.Reader) (*UploadFileResponse, error) {
+	rsp, err := c.UploadFileWithBody(ctx, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUploadFileResponseWithDecoders(rsp, c.decoders)
+}
+
+func (c *ClientWithResponses) UploadFileWithMultipartBodyWithResponse(ctx context.Context, params *UploadFileParams, body UploadFileMultipartRequestBody) (*UploadFileResponse, error) {
+	rsp, err := c.UploadFileWithMultipartBody(ctx, params, body)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUploadFileResponseWithDecoders(rsp, c.decoders)
+}
+
+// PutNoteWithBodyWithResponse request with arbitrary body returning *PutNoteResponse
+func (c *ClientWithResponses) PutNoteWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader) (*PutNoteResponse, error) {
+	rsp, err := c.PutNoteWithBody(ctx, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutNoteResponseWithDecoders(rsp, c.decoders)
+}
+
+func (c *ClientWithResponses) PutNoteWithOctetStreamBodyWithResponse(ctx context.Context, body PutNoteOctetStreamRequestBody) (*PutNoteResponse, error) {
+	rsp, err := c.PutNoteWithOctetStreamBody(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutNoteResponseWithDecoders(rsp, c.decoders)
+}
+
+func (c *ClientWithResponses) PutNoteWithTextBodyWithResponse(ctx context.Context, body PutNoteTextRequestBody) (*PutNoteResponse, error) {
+	rsp, err := c.PutNoteWithTextBody(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutNoteResponseWithDecoders(rsp, c.decoders)
+}
+
+// AddPetWithBodyWithResponse request with arbitrary body returning *AddPetResponse
+func (c *ClientWithResponses) AddPetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader) (*AddPetResponse, error) {
+	rsp, err := c.AddPetWithBody(ctx, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddPetResponseWithDecoders(rsp, c.decoders)
+}
+
+func (c *ClientWithResponses) AddPetWithResponse(ctx context.Context, body AddPetJSONRequestBody) (*AddPetResponse, error) {
+	rsp, err := c.AddPet(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddPetResponseWithDecoders(rsp, c.decoders)
+}
+
+func (c *ClientWithResponses) AddPetWithFormdataBodyWithResponse(ctx context.Context, body AddPetFormdataRequestBody) (*AddPetResponse, error) {
+	rsp, err := c.AddPetWithFormdataBody(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddPetResponseWithDecoders(rsp, c.decoders)
+}
+
+func (c *ClientWithResponses) AddPetWithMultipartBodyWithResponse(ctx context.Context, body AddPetMultipartRequestBody) (*AddPetResponse, error) {
+	rsp, err := c.AddPetWithMultipartBody(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddPetResponseWithDecoders(rsp, c.decoders)
+}
+
+// ParseUploadFileResponse parses an HTTP response from a UploadFileWithResponse call
+func ParseUploadFileResponse(rsp *http.Response) (*UploadFileResponse, error) {
+	return ParseUploadFileResponseWithDecoders(rsp, nil)
+}
+
+// ParseUploadFileResponseWithDecoders parses an HTTP response from a UploadFileWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseUploadFileResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*UploadFileResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UploadFileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	contentType := rsp.Header.Get("Content-Type")
+	switch {
+	case runtime.MediaTypeMatches(contentType, "application/json") && rsp.StatusCode == 200:
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest Received
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.JSON200 = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
+		}
+
+	}
+
+	return response, nil
+}
+
+// ParsePutNoteResponse parses an HTTP response from a PutNoteWithResponse call
+func ParsePutNoteResponse(rsp *http.Response) (*PutNoteResponse, error) {
+	return ParsePutNoteResponseWithDecoders(rsp, nil)
+}
+
+// ParsePutNoteResponseWithDecoders parses an HTTP response from a PutNoteWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParsePutNoteResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*PutNoteResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutNoteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	contentType := rsp.Header.Get("Content-Type")
+	switch {
+	case runtime.MediaTypeMatches(contentType, "application/json") && rsp.StatusCode == 200:
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest Received
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.JSON200 = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
+		}
+
+	}
+
+	return response, nil
+}
+
+// ParseAddPetResponse parses an HTTP response from a AddPetWithResponse call
+func ParseAddPetResponse(rsp *http.Response) (*AddPetResponse, error) {
+	return ParseAddPetResponseWithDecoders(rsp, nil)
+}
+
+// ParseAddPetResponseWithDecoders parses an HTTP response from a AddPetWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseAddPetResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*AddPetResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddPetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	contentType := rsp.Header.Get("Content-Type")
+	switch {
+	case runtime.MediaTypeMatches(contentType, "application/json") && rsp.StatusCode == 200:
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest Received
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.JSON200 = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
+		}
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (POST /files)
+	UploadFile(w http.ResponseWriter, r *http.Request, params UploadFileParams)
+
+	// (PUT /notes)
+	PutNote(w http.ResponseWriter, r *http.Request)
+
+	// (POST /pets)
+	AddPet(w http.ResponseWriter, r *http.Request)
+}
+
+// ServerInterfaceWrapper converts requests to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// UploadFile converts the request to params.
+func (siw *ServerInterfaceWrapper) UploadFile(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+
+	// Parameter object where we will unmarshal all parameters from the request
+	var params UploadFileParams
+	// ------------- Optional query parameter "folder" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "folder", r.URL.Query(), &params.Folder); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter folder: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	siw.Handler.UploadFile(w, r, params)
+}
+
+// PutNote converts the request to params.
+func (siw *ServerInterfaceWrapper) PutNote(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+
+	siw.Handler.PutNote(w, r)
+}
+
+// AddPet converts the request to params.
+func (siw *ServerInterfaceWrapper) AddPet(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+
+	siw.Handler.AddPet(w, r)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerFromMux(si, http.NewServeMux())
+}
+
+// HandlerFromMux registers the routes of the OpenAPI spec on the provided
+// ServeMux, under the literal prefixes of their paths, and returns it.
+func HandlerFromMux(si ServerInterface, m *http.ServeMux) http.Handler {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+	router := runtime.NewRouter(
+		runtime.Route{Method: "POST", Path: "/files", Handler: wrapper.UploadFile},
+		runtime.Route{Method: "PUT", Path: "/notes", Handler: wrapper.PutNote},
+		runtime.Route{Method: "POST", Path: "/pets", Handler: wrapper.AddPet},
+	)
+	for _, pattern := range router.Patterns() {
+		m.Handle(pattern, router)
+	}
+
+	return m
+}
+
+// DecodeUploadFileMultipartRequestBody decodes the multipart/form-data body of UploadFile requests. It returns EOF when the request has no body.
+func DecodeUploadFileMultipartRequestBody(r *http.Request) (UploadFileMultipartRequestBody, error) {
+	var body UploadFileMultipartRequestBody
+	if r.ContentLength == 0 {
+		return body, io.EOF
+	}
+	if err := r.ParseMultipartForm(runtime.MultipartMemory); err != nil {
+		return body, err
+	}
+	err := runtime.BindMultipartWithEncoding(&body, r.MultipartForm, map[string]runtime.FormEncoding{
+		"file": {ContentType: "image/png"},
+		"meta": {ContentType: "application/vnd.meta+json"},
+	})
+	return body, err
+}
+
+// DecodePutNoteOctetStreamRequestBody decodes the application/octet-stream body of PutNote requests. It returns EOF when the request has no body.
+func DecodePutNoteOctetStreamRequestBody(r *http.Request) (PutNoteOctetStreamRequestBody, error) {
+	if r.ContentLength == 0 {
+		return nil, io.EOF
+	}
+	return r.Body, nil
+}
+
+// DecodePutNoteTextRequestBody decodes the text/plain body of PutNote requests. It returns EOF when the request has no body.
+func DecodePutNoteTextRequestBody(r *http.Request) (PutNoteTextRequestBody, error) {
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return "", err
+	}
+	if len(data) == 0 {
+		return "", io.EOF
+	}
+	return PutNoteTextRequestBody(data), nil
+}
+
+// DecodeAddPetJSONRequestBody decodes the application/json body of AddPet requests. It returns EOF when the request has no body.
+func DecodeAddPetJSONRequestBody(r *http.Request) (AddPetJSONRequestBody, error) {
+	var body AddPetJSONRequestBody
+	err := json.NewDecoder(r.Body).Decode(&body)
+	return body, err
+}
+
+// DecodeAddPetFormdataRequestBody decodes the application/x-www-form-urlencoded body of AddPet requests. It returns EOF when the request has no body.
+func DecodeAddPetFormdataRequestBody(r *http.Request) (AddPetFormdataRequestBody, error) {
+	var body AddPetFormdataRequestBody
+	if r.ContentLength == 0 {
+		return body, io.EOF
+	}
+	if err := r.ParseForm(); err != nil {
+		return body, err
+	}
+	err := runtime.BindFormWithEncoding(&body, r.PostForm, map[string]runtime.FormEncoding{
+		"owner": {Style: "form", Explode: false},
+		"tags":  {Style: "pipeDelimited", Explode: false},
+	})
+	return body, err
+}
+
+// DecodeAddPetMultipartRequestBody decodes the multipart/form-data body of AddPet requests. It returns EOF when the request has no body.
+func DecodeAddPetMultipartRequestBody(r *http.Request) (AddPetMultipartRequestBody, error) {
+	var body AddPetMultipartRequestBody
+	if r.ContentLength == 0 {
+		return body, io.EOF
+	}
+	if err := r.ParseMultipartForm(runtime.MultipartMemory); err != nil {
+		return body, err
+	}
+	err := runtime.BindMultipart(&body, r.MultipartForm)
+	return body, err
+}
+
+// UploadFileRequestObject holds the parameters and body of UploadFile requests.
+type UploadFileRequestObject struct {
+	Params        UploadFileParams
+	MultipartBody *UploadFileMultipartRequestBody
+}
+
+// UploadFileResponseObject is one of the responses which UploadFile may return.
+type UploadFileResponseObject interface {
+	VisitUploadFileResponse(w http.ResponseWriter) error
+}
+
+// UploadFile200JSONResponse is the 200 response of UploadFile, for its application/json content type.
+type UploadFile200JSONResponse Received
+
+func (response UploadFile200JSONResponse) VisitUploadFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	return json.NewEncoder(w).Encode((Received)(response))
+}
+
+// PutNoteRequestObject holds the parameters and body of PutNote requests.
+type PutNoteRequestObject struct {
+	OctetStreamBody PutNoteOctetStreamRequestBody
+	TextBody        *PutNoteTextRequestBody
+}
+
+// PutNoteResponseObject is one of the responses which PutNote may return.
+type PutNoteResponseObject interface {
+	VisitPutNoteResponse(w http.ResponseWriter) error
+}
+
+// PutNote200JSONResponse is the 200 response of PutNote, for its application/json content type.
+type PutNote200JSONResponse Received
+
+func (response PutNote200JSONResponse) VisitPutNoteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	return json.NewEncoder(w).Encode((Received)(response))
+}
+
+// AddPetRequestObject holds the parameters and body of AddPet requests.
+type AddPetRequestObject struct {
+	Body          *AddPetJSONRequestBody
+	FormdataBody  *AddPetFormdataRequestBody
+	MultipartBody *AddPetMultipartRequestBody
+}
+
+// AddPetResponseObject is one of the responses which AddPet may return.
+type AddPetResponseObject interface {
+	VisitAddPetResponse(w http.ResponseWriter) error
+}
+
+// AddPet200JSONResponse is the 200 response of AddPet, for its application/json content type.
+type AddPet200JSONResponse Received
+
+func (response AddPet200JSONResponse) VisitAddPetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	return json.NewEncoder(w).Encode((Received)(response))
+}
+
+// StrictServerInterface represents all server handlers, which take the
+// request objects, and return the response objects, of the operations.
+type StrictServerInterface interface {
+
+	// (POST /files)
+	UploadFile(ctx context.Context, request UploadFileRequestObject) (UploadFileResponseObject, error)
+
+	// (PUT /notes)
+	PutNote(ctx context.Context, request PutNoteRequestObject) (PutNoteResponseObject, error)
+
+	// (POST /pets)
+	AddPet(ctx context.Context, request AddPetRequestObject) (AddPetResponseObject, error)
+}
+
+// StrictHTTPServerOptions holds the functions which respond to the errors of
+// a strict handler: RequestErrorHandlerFunc to request bodies which can't be
+// decoded, and ResponseErrorHandlerFunc to the errors which the
+// StrictServerInterface returns, or which writing its responses runs into.
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type strictHandler struct {
+	ssi     StrictServerInterface
+	options StrictHTTPServerOptions
+}
+
+// NewStrictHandler returns a ServerInterface which decodes requests into the
+// request objects of ssi, and writes the response objects which it returns.
+// Requests which can't be decoded are answered with 400 Bad Request, and the
+// errors of ssi with 500 Internal Server Error.
+func NewStrictHandler(ssi StrictServerInterface) ServerInterface {
+	return NewStrictHandlerWithOptions(ssi, StrictHTTPServerOptions{})
+}
+
+// NewStrictHandlerWithOptions is NewStrictHandler, with the errors handled by
+// the given functions, where they're set.
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, options StrictHTTPServerOptions) ServerInterface {
+	if options.RequestErrorHandlerFunc == nil {
+		options.RequestErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	if options.ResponseErrorHandlerFunc == nil {
+		options.ResponseErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	}
+	return &strictHandler{ssi: ssi, options: options}
+}
+
+// UploadFile operation.
+func (sh *strictHandler) UploadFile(w http.ResponseWriter, r *http.Request, params UploadFileParams) {
+	var request UploadFileRequestObject
+	request.Params = params
+
+	body, err := DecodeUploadFileMultipartRequestBody(r)
+	if err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("invalid request body: %s", err))
+		return
+	}
+	request.MultipartBody = &body
+
+	response, err := sh.ssi.UploadFile(r.Context(), request)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response from UploadFile")
+	}
+	if err == nil {
+		err = response.VisitUploadFileResponse(w)
+	}
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	}
+}
+
+// PutNote operation.
+func (sh *strictHandler) PutNote(w http.ResponseWriter, r *http.Request) {
+	var request PutNoteRequestObject
+
+	if runtime.MediaTypeMatches(r.Header.Get("Content-Type"), "application/octet-stream") {
+		body, err := DecodePutNoteOctetStreamRequestBody(r)
+		if err == nil {
+			request.OctetStreamBody = body
+		} else if err != io.EOF {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("invalid request body: %s", err))
+			return
+		}
+	}
+
+	if runtime.MediaTypeMatches(r.Header.Get("Content-Type"), "text/plain") {
+		body, err := DecodePutNoteTextRequestBody(r)
+		if err == nil {
+			request.TextBody = &body
+		} else if err != io.EOF {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("invalid request body: %s", err))
+			return
+		}
+	}
+
+	response, err := sh.ssi.PutNote(r.Context(), request)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response from PutNote")
+	}
+	if err == nil {
+		err = response.VisitPutNoteResponse(w)
+	}
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	}
+}
+
+// AddPet operation.
+func (sh *strictHandler) AddPet(w http.ResponseWriter, r *http.Request) {
+	var request AddPetRequestObject
+
+	if runtime.MediaTypeMatches(r.Header.Get("Content-Type"), "application/json") {
+		body, err := DecodeAddPetJSONRequestBody(r)
+		if err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("invalid request body: %s", err))
+			return
+		}
+		request.Body = &body
+	}
+
+	if runtime.MediaTypeMatches(r.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		body, err := DecodeAddPetFormdataRequestBody(r)
+		if err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("invalid request body: %s", err))
+			return
+		}
+		request.FormdataBody = &body
+	}
+
+	if runtime.MediaTypeMatches(r.Header.Get("Content-Type"), "multipart/form-data") {
+		body, err := DecodeAddPetMultipartRequestBody(r)
+		if err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("invalid request body: %s", err))
+			return
+		}
+		request.MultipartBody = &body
+	}
+
+	response, err := sh.ssi.AddPet(r.Context(), request)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response from AddPet")
+	}
+	if err == nil {
+		err = response.VisitAddPetResponse(w)
+	}
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	}
+}
@@ -0,0 +1,224 @@
+package bodies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"github.com/deepmap/oapi-codegen/pkg/types"
+)
+
+// bodyServer answers each request with the body which it was decoded into.
+type bodyServer struct{}
+
+func (bodyServer) AddPet(ctx context.Context, request AddPetRequestObject) (AddPetResponseObject, error) {
+	switch {
+	case request.Body != nil:
+		return AddPet200JSONResponse{ContentType: "json", Body: describePet(NewPet(*request.Body))}, nil
+	case request.FormdataBody != nil:
+		return AddPet200JSONResponse{ContentType: "form", Body: describePet(NewPet(*request.FormdataBody))}, nil
+	case request.MultipartBody != nil:
+		body := request.MultipartBody
+		description := fmt.Sprintf("%s %v", body.Name, *body.Tags)
+		if body.Photo != nil {
+			data, err := body.Photo.Bytes()
+			if err != nil {
+				return nil, err
+			}
+			description += fmt.Sprintf(" %s=%s", body.Photo.Filename(), data)
+		}
+		return AddPet200JSONResponse{ContentType: "multipart", Body: description}, nil
+	}
+	return AddPet200JSONResponse{ContentType: "none"}, nil
+}
+
+func (bodyServer) UploadFile(ctx context.Context, request UploadFileRequestObject) (UploadFileResponseObject, error) {
+	body := request.MultipartBody
+	data, err := body.File.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	description := fmt.Sprintf("%s %s=%s", *request.Params.Folder, body.File.Filename(), data)
+	if body.Meta != nil && body.Meta.Author != nil {
+		description += " by " + *body.Meta.Author
+	}
+	return UploadFile200JSONResponse{ContentType: "multipart", Body: description}, nil
+}
+
+func (bodyServer) PutNote(ctx context.Context, request PutNoteRequestObject) (PutNoteResponseObject, error) {
+	switch {
+	case request.TextBody != nil:
+		return PutNote200JSONResponse{ContentType: "text", Body: string(*request.TextBody)}, nil
+	case request.OctetStreamBody != nil:
+		data, err := ioutil.ReadAll(request.OctetStreamBody)
+		if err != nil {
+			return nil, err
+		}
+		return PutNote200JSONResponse{ContentType: "octet-stream", Body: string(data)}, nil
+	}
+	return PutNote200JSONResponse{ContentType: "none"}, nil
+}
+
+func describePet(pet NewPet) string {
+	description := pet.Name
+	if pet.Age != nil {
+		description += fmt.Sprintf(" %d", *pet.Age)
+	}
+	if pet.Owner != nil && pet.Owner.Name != nil {
+		description += " of " + *pet.Owner.Name
+	}
+	if pet.Tags != nil {
+		description += fmt.Sprintf(" %v", *pet.Tags)
+	}
+	return description
+}
+
+func TestRequestBodies(t *testing.T) {
+	server := httptest.NewServer(Handler(NewStrictHandler(bodyServer{})))
+	defer server.Close()
+	client, err := NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	age := 3
+	owner := "Ann"
+	pet := NewPet{Name: "Tom", Age: &age}
+	pet.Owner = &struct {
+		Name *string `json:"name,omitempty"`
+	}{Name: &owner}
+	pet.Tags = &[]string{"cat", "grey"}
+
+	rsp, err := client.AddPetWithResponse(ctx, AddPetJSONRequestBody(pet))
+	require.NoError(t, err)
+	require.NotNil(t, rsp.JSON200)
+	assert.Equal(t, Received{ContentType: "json", Body: "Tom 3 of Ann [cat grey]"}, *rsp.JSON200)
+
+	rsp, err = client.AddPetWithFormdataBodyWithResponse(ctx, AddPetFormdataRequestBody(pet))
+	require.NoError(t, err)
+	require.NotNil(t, rsp.JSON200)
+	assert.Equal(t, Received{ContentType: "form", Body: "Tom 3 of Ann [cat grey]"}, *rsp.JSON200)
+
+	var photo types.File
+	photo.InitFromBytes([]byte("meow"), "tom.jpg")
+	tags := []string{"cat", "grey"}
+	rsp, err = client.AddPetWithMultipartBodyWithResponse(ctx, AddPetMultipartRequestBody{Name: "Tom", Tags: &tags, Photo: &photo})
+	require.NoError(t, err)
+	require.NotNil(t, rsp.JSON200)
+	assert.Equal(t, Received{ContentType: "multipart", Body: "Tom [cat grey] tom.jpg=meow"}, *rsp.JSON200)
+
+	folder := "pets"
+	author := "Ann"
+	var file types.File
+	file.InitFromBytes([]byte("meow"), "tom.png")
+	upload := UploadFileMultipartRequestBody{File: file}
+	upload.Meta = &struct {
+		Author *string `json:"author,omitempty"`
+	}{Author: &author}
+	uploadRsp, err := client.UploadFileWithMultipartBodyWithResponse(ctx, &UploadFileParams{Folder: &folder}, upload)
+	require.NoError(t, err)
+	require.NotNil(t, uploadRsp.JSON200)
+	assert.Equal(t, Received{ContentType: "multipart", Body: "pets tom.png=meow by Ann"}, *uploadRsp.JSON200)
+
+	// Files can be streamed from readers, as the request is sent.
+	photoReader, photoWriter := io.Pipe()
+	go func() {
+		_, err := io.WriteString(photoWriter, "purr")
+		photoWriter.CloseWithError(err)
+	}()
+	photo.InitFromReader(photoReader, "tom.jpg")
+	rsp, err = client.AddPetWithMultipartBodyWithResponse(ctx, AddPetMultipartRequestBody{Name: "Tom", Tags: &tags, Photo: &photo})
+	require.NoError(t, err)
+	require.NotNil(t, rsp.JSON200)
+	assert.Equal(t, Received{ContentType: "multipart", Body: "Tom [cat grey] tom.jpg=purr"}, *rsp.JSON200)
+
+	noteRsp, err := client.PutNoteWithTextBodyWithResponse(ctx, "hello")
+	require.NoError(t, err)
+	require.NotNil(t, noteRsp.JSON200)
+	assert.Equal(t, Received{ContentType: "text", Body: "hello"}, *noteRsp.JSON200)
+
+	noteRsp, err = client.PutNoteWithOctetStreamBodyWithResponse(ctx, strings.NewReader("\x00\x01"))
+	require.NoError(t, err)
+	require.NotNil(t, noteRsp.JSON200)
+	assert.Equal(t, Received{ContentType: "octet-stream", Body: "\x00\x01"}, *noteRsp.JSON200)
+
+	// The body of PutNote is optional
+	noteRsp, err = client.PutNoteWithBodyWithResponse(ctx, "text/plain", nil)
+	require.NoError(t, err)
+	require.NotNil(t, noteRsp.JSON200)
+	assert.Equal(t, Received{ContentType: "none"}, *noteRsp.JSON200)
+
+	// Bodies are told apart by their media types, whatever the case and
+	// parameters of their Content-Type
+	rsp, err = client.AddPetWithBodyWithResponse(ctx, "Application/JSON; charset=utf-8", strings.NewReader(`{"name":"Tom"}`))
+	require.NoError(t, err)
+	require.NotNil(t, rsp.JSON200)
+	assert.Equal(t, Received{ContentType: "json", Body: "Tom"}, *rsp.JSON200)
+
+	// Forms which can't be bound are rejected
+	rsp, err = client.AddPetWithBodyWithResponse(ctx, "application/x-www-form-urlencoded", strings.NewReader("name=Tom&age=old"))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rsp.StatusCode())
+}
+
+func TestRequestEditorErrorClosesBody(t *testing.T) {
+	// A request which the editor fails isn't sent, so the writing of its
+	// multipart body has to be stopped by closing it.
+	var edited *http.Request
+	errEditor := errors.New("editor")
+	client, err := NewClient("http://example.com", WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+		edited = req
+		return errEditor
+	}))
+	require.NoError(t, err)
+
+	var photo types.File
+	photo.InitFromBytes([]byte("meow"), "tom.jpg")
+	_, err = client.AddPetWithMultipartBody(context.Background(), AddPetMultipartRequestBody{Name: "Tom", Photo: &photo})
+	assert.Equal(t, errEditor, err)
+	require.NotNil(t, edited)
+	_, err = ioutil.ReadAll(edited.Body)
+	assert.Equal(t, io.ErrClosedPipe, err)
+}
+
+func TestFormEncodings(t *testing.T) {
+	owner := "Ann"
+	pet := AddPetFormdataRequestBody{Name: "Tom", Tags: &[]string{"cat", "grey"}}
+	pet.Owner = &struct {
+		Name *string `json:"name,omitempty"`
+	}{Name: &owner}
+
+	// The properties of urlencoded forms are written in the style of their
+	// encoding.
+	req, err := NewAddPetRequestWithFormdataBody("http://example.com", pet)
+	require.NoError(t, err)
+	require.NoError(t, req.ParseForm())
+	assert.Equal(t, url.Values{
+		"name":  {"Tom"},
+		"owner": {"name,Ann"},
+		"tags":  {"cat|grey"},
+	}, req.PostForm)
+
+	// and the parts of multipart forms have the content types of theirs.
+	var file types.File
+	file.InitFromBytes([]byte("meow"), "tom.png")
+	upload := UploadFileMultipartRequestBody{File: file}
+	upload.Meta = &struct {
+		Author *string `json:"author,omitempty"`
+	}{Author: &owner}
+	req, err = NewUploadFileRequestWithMultipartBody("http://example.com", &UploadFileParams{}, upload)
+	require.NoError(t, err)
+	require.NoError(t, req.ParseMultipartForm(runtime.MultipartMemory))
+	assert.Equal(t, "image/png", req.MultipartForm.File["file"][0].Header.Get("Content-Type"))
+	assert.Equal(t, []string{`{"author":"Ann"}`}, req.MultipartForm.Value["meta"])
+}
@@ -0,0 +1,3 @@
+package bodies
+
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --generate=types,client,std-http,strict-server --package=bodies -o bodies.gen.go bodies.yaml
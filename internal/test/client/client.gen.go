@@ -10,12 +10,14 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"path"
 	"strings"
 )
 
@@ -31,12 +33,18 @@ type PostBothJSONBody SchemaObject
 // PostJsonJSONBody defines parameters for PostJson.
 type PostJsonJSONBody SchemaObject
 
-// PostBothRequestBody defines body for PostBoth for application/json ContentType.
+// PostBothJSONRequestBody defines body for PostBoth for application/json ContentType.
 type PostBothJSONRequestBody PostBothJSONBody
 
-// PostJsonRequestBody defines body for PostJson for application/json ContentType.
+// PostBothOctetStreamRequestBody defines body for PostBoth for application/octet-stream ContentType.
+type PostBothOctetStreamRequestBody io.Reader
+
+// PostJsonJSONRequestBody defines body for PostJson for application/json ContentType.
 type PostJsonJSONRequestBody PostJsonJSONBody
 
+// PostOtherOctetStreamRequestBody defines body for PostOther for application/octet-stream ContentType.
+type PostOtherOctetStreamRequestBody io.Reader
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -60,6 +68,10 @@ type Client struct {
 	// A callback for modifying requests which are generated before sending over
 	// the network.
 	RequestEditor RequestEditorFn
+
+	// The decoders of response bodies, by media type. When nil,
+	// runtime.DefaultResponseDecoders is used.
+	ResponseDecoders *runtime.ResponseDecoders
 }
 
 // ClientOption allows setting custom parameters during construction
@@ -106,6 +118,19 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 	}
 }
 
+// WithResponseDecoder registers the decoder of the response bodies of a media
+// type, such as application/x-protobuf, for this client only. Registering the
+// decoder of application/cbor decodes the media types with a +cbor suffix too.
+func WithResponseDecoder(mediaType string, decoder runtime.ResponseDecoder) ClientOption {
+	return func(c *Client) error {
+		if c.ResponseDecoders == nil {
+			c.ResponseDecoders = runtime.DefaultResponseDecoders.Clone()
+		}
+		c.ResponseDecoders.Register(mediaType, decoder)
+		return nil
+	}
+}
+
 // The interface specification for the client above.
 type ClientInterface interface {
 	// PostBoth request  with any body
@@ -113,6 +138,8 @@ type ClientInterface interface {
 
 	PostBoth(ctx context.Context, body PostBothJSONRequestBody) (*http.Response, error)
 
+	PostBothWithOctetStreamBody(ctx context.Context, body PostBothOctetStreamRequestBody) (*http.Response, error)
+
 	// GetBoth request
 	GetBoth(ctx context.Context) (*http.Response, error)
 
@@ -127,6 +154,8 @@ type ClientInterface interface {
 	// PostOther request  with any body
 	PostOtherWithBody(ctx context.Context, contentType string, body io.Reader) (*http.Response, error)
 
+	PostOtherWithOctetStreamBody(ctx context.Context, body PostOtherOctetStreamRequestBody) (*http.Response, error)
+
 	// GetOther request
 	GetOther(ctx context.Context) (*http.Response, error)
 
@@ -143,6 +172,11 @@ func (c *Client) PostBothWithBody(ctx context.Context, contentType string, body
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -158,6 +192,31 @@ func (c *Client) PostBoth(ctx context.Context, body PostBothJSONRequestBody) (*h
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
+			return nil, err
+		}
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostBothWithOctetStreamBody(ctx context.Context, body PostBothOctetStreamRequestBody) (*http.Response, error) {
+	req, err := NewPostBothRequestWithOctetStreamBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if c.RequestEditor != nil {
+		err = c.RequestEditor(ctx, req)
+		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -173,6 +232,11 @@ func (c *Client) GetBoth(ctx context.Context) (*http.Response, error) {
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -188,6 +252,11 @@ func (c *Client) PostJsonWithBody(ctx context.Context, contentType string, body
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -203,6 +272,11 @@ func (c *Client) PostJson(ctx context.Context, body PostJsonJSONRequestBody) (*h
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -218,6 +292,11 @@ func (c *Client) GetJson(ctx context.Context) (*http.Response, error) {
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -233,6 +312,31 @@ func (c *Client) PostOtherWithBody(ctx context.Context, contentType string, body
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
+			return nil, err
+		}
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostOtherWithOctetStreamBody(ctx context.Context, body PostOtherOctetStreamRequestBody) (*http.Response, error) {
+	req, err := NewPostOtherRequestWithOctetStreamBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if c.RequestEditor != nil {
+		err = c.RequestEditor(ctx, req)
+		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -248,6 +352,11 @@ func (c *Client) GetOther(ctx context.Context) (*http.Response, error) {
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -263,6 +372,11 @@ func (c *Client) GetJsonWithTrailingSlash(ctx context.Context) (*http.Response,
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -280,11 +394,19 @@ func NewPostBothRequest(server string, body PostBothJSONRequestBody) (*http.Requ
 	return NewPostBothRequestWithBody(server, "application/json", bodyReader)
 }
 
+// NewPostBothRequestWithOctetStreamBody calls the generic PostBoth builder with application/octet-stream body
+func NewPostBothRequestWithOctetStreamBody(server string, body PostBothOctetStreamRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyReader = body
+	return NewPostBothRequestWithBody(server, "application/octet-stream", bodyReader)
+}
+
 // NewPostBothRequestWithBody generates requests for PostBoth with any type of body
 func NewPostBothRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	queryUrl, err := url.Parse(server)
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
@@ -299,7 +421,8 @@ func NewPostBothRequestWithBody(server string, contentType string, body io.Reade
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryUrl.String(), body)
+	var req *http.Request
+	req, err = http.NewRequest("POST", queryUrl.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -312,7 +435,8 @@ func NewPostBothRequestWithBody(server string, contentType string, body io.Reade
 func NewGetBothRequest(server string) (*http.Request, error) {
 	var err error
 
-	queryUrl, err := url.Parse(server)
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
@@ -327,7 +451,8 @@ func NewGetBothRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	var req *http.Request
+	req, err = http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -350,7 +475,8 @@ func NewPostJsonRequest(server string, body PostJsonJSONRequestBody) (*http.Requ
 func NewPostJsonRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	queryUrl, err := url.Parse(server)
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
@@ -365,7 +491,8 @@ func NewPostJsonRequestWithBody(server string, contentType string, body io.Reade
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryUrl.String(), body)
+	var req *http.Request
+	req, err = http.NewRequest("POST", queryUrl.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -378,7 +505,8 @@ func NewPostJsonRequestWithBody(server string, contentType string, body io.Reade
 func NewGetJsonRequest(server string) (*http.Request, error) {
 	var err error
 
-	queryUrl, err := url.Parse(server)
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
@@ -393,7 +521,8 @@ func NewGetJsonRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	var req *http.Request
+	req, err = http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -401,11 +530,19 @@ func NewGetJsonRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
+// NewPostOtherRequestWithOctetStreamBody calls the generic PostOther builder with application/octet-stream body
+func NewPostOtherRequestWithOctetStreamBody(server string, body PostOtherOctetStreamRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyReader = body
+	return NewPostOtherRequestWithBody(server, "application/octet-stream", bodyReader)
+}
+
 // NewPostOtherRequestWithBody generates requests for PostOther with any type of body
 func NewPostOtherRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	queryUrl, err := url.Parse(server)
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
@@ -420,7 +557,8 @@ func NewPostOtherRequestWithBody(server string, contentType string, body io.Read
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryUrl.String(), body)
+	var req *http.Request
+	req, err = http.NewRequest("POST", queryUrl.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -433,7 +571,8 @@ func NewPostOtherRequestWithBody(server string, contentType string, body io.Read
 func NewGetOtherRequest(server string) (*http.Request, error) {
 	var err error
 
-	queryUrl, err := url.Parse(server)
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
@@ -448,7 +587,8 @@ func NewGetOtherRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	var req *http.Request
+	req, err = http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -460,7 +600,8 @@ func NewGetOtherRequest(server string) (*http.Request, error) {
 func NewGetJsonWithTrailingSlashRequest(server string) (*http.Request, error) {
 	var err error
 
-	queryUrl, err := url.Parse(server)
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
@@ -475,7 +616,8 @@ func NewGetJsonWithTrailingSlashRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	var req *http.Request
+	req, err = http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -486,6 +628,10 @@ func NewGetJsonWithTrailingSlashRequest(server string) (*http.Request, error) {
 // ClientWithResponses builds on ClientInterface to offer response payloads
 type ClientWithResponses struct {
 	ClientInterface
+
+	// The decoders of response bodies, from the ResponseDecoders of the
+	// Client.
+	decoders *runtime.ResponseDecoders
 }
 
 // NewClientWithResponses creates a new ClientWithResponses, which wraps
@@ -495,7 +641,7 @@ func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithRes
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+	return &ClientWithResponses{ClientInterface: client, decoders: client.ResponseDecoders}, nil
 }
 
 // WithBaseURL overrides the baseURL.
@@ -517,6 +663,8 @@ type ClientWithResponsesInterface interface {
 
 	PostBothWithResponse(ctx context.Context, body PostBothJSONRequestBody) (*PostBothResponse, error)
 
+	PostBothWithOctetStreamBodyWithResponse(ctx context.Context, body PostBothOctetStreamRequestBody) (*PostBothResponse, error)
+
 	// GetBoth request
 	GetBothWithResponse(ctx context.Context) (*GetBothResponse, error)
 
@@ -531,6 +679,8 @@ type ClientWithResponsesInterface interface {
 	// PostOther request  with any body
 	PostOtherWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader) (*PostOtherResponse, error)
 
+	PostOtherWithOctetStreamBodyWithResponse(ctx context.Context, body PostOtherOctetStreamRequestBody) (*PostOtherResponse, error)
+
 	// GetOther request
 	GetOtherWithResponse(ctx context.Context) (*GetOtherResponse, error)
 
@@ -691,7 +841,7 @@ func (c *ClientWithResponses) PostBothWithBodyWithResponse(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostBothResponse(rsp)
+	return ParsePostBothResponseWithDecoders(rsp, c.decoders)
 }
 
 func (c *ClientWithResponses) PostBothWithResponse(ctx context.Context, body PostBothJSONRequestBody) (*PostBothResponse, error) {
@@ -699,7 +849,15 @@ func (c *ClientWithResponses) PostBothWithResponse(ctx context.Context, body Pos
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostBothResponse(rsp)
+	return ParsePostBothResponseWithDecoders(rsp, c.decoders)
+}
+
+func (c *ClientWithResponses) PostBothWithOctetStreamBodyWithResponse(ctx context.Context, body PostBothOctetStreamRequestBody) (*PostBothResponse, error) {
+	rsp, err := c.PostBothWithOctetStreamBody(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostBothResponseWithDecoders(rsp, c.decoders)
 }
 
 // GetBothWithResponse request returning *GetBothResponse
@@ -708,7 +866,7 @@ func (c *ClientWithResponses) GetBothWithResponse(ctx context.Context) (*GetBoth
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetBothResponse(rsp)
+	return ParseGetBothResponseWithDecoders(rsp, c.decoders)
 }
 
 // PostJsonWithBodyWithResponse request with arbitrary body returning *PostJsonResponse
@@ -717,7 +875,7 @@ func (c *ClientWithResponses) PostJsonWithBodyWithResponse(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostJsonResponse(rsp)
+	return ParsePostJsonResponseWithDecoders(rsp, c.decoders)
 }
 
 func (c *ClientWithResponses) PostJsonWithResponse(ctx context.Context, body PostJsonJSONRequestBody) (*PostJsonResponse, error) {
@@ -725,7 +883,7 @@ func (c *ClientWithResponses) PostJsonWithResponse(ctx context.Context, body Pos
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostJsonResponse(rsp)
+	return ParsePostJsonResponseWithDecoders(rsp, c.decoders)
 }
 
 // GetJsonWithResponse request returning *GetJsonResponse
@@ -734,7 +892,7 @@ func (c *ClientWithResponses) GetJsonWithResponse(ctx context.Context) (*GetJson
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetJsonResponse(rsp)
+	return ParseGetJsonResponseWithDecoders(rsp, c.decoders)
 }
 
 // PostOtherWithBodyWithResponse request with arbitrary body returning *PostOtherResponse
@@ -743,7 +901,15 @@ func (c *ClientWithResponses) PostOtherWithBodyWithResponse(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostOtherResponse(rsp)
+	return ParsePostOtherResponseWithDecoders(rsp, c.decoders)
+}
+
+func (c *ClientWithResponses) PostOtherWithOctetStreamBodyWithResponse(ctx context.Context, body PostOtherOctetStreamRequestBody) (*PostOtherResponse, error) {
+	rsp, err := c.PostOtherWithOctetStreamBody(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOtherResponseWithDecoders(rsp, c.decoders)
 }
 
 // GetOtherWithResponse request returning *GetOtherResponse
@@ -752,7 +918,7 @@ func (c *ClientWithResponses) GetOtherWithResponse(ctx context.Context) (*GetOth
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetOtherResponse(rsp)
+	return ParseGetOtherResponseWithDecoders(rsp, c.decoders)
 }
 
 // GetJsonWithTrailingSlashWithResponse request returning *GetJsonWithTrailingSlashResponse
@@ -761,11 +927,20 @@ func (c *ClientWithResponses) GetJsonWithTrailingSlashWithResponse(ctx context.C
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetJsonWithTrailingSlashResponse(rsp)
+	return ParseGetJsonWithTrailingSlashResponseWithDecoders(rsp, c.decoders)
 }
 
 // ParsePostBothResponse parses an HTTP response from a PostBothWithResponse call
 func ParsePostBothResponse(rsp *http.Response) (*PostBothResponse, error) {
+	return ParsePostBothResponseWithDecoders(rsp, nil)
+}
+
+// ParsePostBothResponseWithDecoders parses an HTTP response from a PostBothWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParsePostBothResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*PostBothResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
@@ -785,6 +960,15 @@ func ParsePostBothResponse(rsp *http.Response) (*PostBothResponse, error) {
 
 // ParseGetBothResponse parses an HTTP response from a GetBothWithResponse call
 func ParseGetBothResponse(rsp *http.Response) (*GetBothResponse, error) {
+	return ParseGetBothResponseWithDecoders(rsp, nil)
+}
+
+// ParseGetBothResponseWithDecoders parses an HTTP response from a GetBothWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseGetBothResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*GetBothResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
@@ -797,6 +981,9 @@ func ParseGetBothResponse(rsp *http.Response) (*GetBothResponse, error) {
 	}
 
 	switch {
+	case rsp.StatusCode == 200:
+		break // No content-type
+
 	}
 
 	return response, nil
@@ -804,6 +991,15 @@ func ParseGetBothResponse(rsp *http.Response) (*GetBothResponse, error) {
 
 // ParsePostJsonResponse parses an HTTP response from a PostJsonWithResponse call
 func ParsePostJsonResponse(rsp *http.Response) (*PostJsonResponse, error) {
+	return ParsePostJsonResponseWithDecoders(rsp, nil)
+}
+
+// ParsePostJsonResponseWithDecoders parses an HTTP response from a PostJsonWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParsePostJsonResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*PostJsonResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
@@ -823,6 +1019,15 @@ func ParsePostJsonResponse(rsp *http.Response) (*PostJsonResponse, error) {
 
 // ParseGetJsonResponse parses an HTTP response from a GetJsonWithResponse call
 func ParseGetJsonResponse(rsp *http.Response) (*GetJsonResponse, error) {
+	return ParseGetJsonResponseWithDecoders(rsp, nil)
+}
+
+// ParseGetJsonResponseWithDecoders parses an HTTP response from a GetJsonWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseGetJsonResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*GetJsonResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
@@ -835,6 +1040,9 @@ func ParseGetJsonResponse(rsp *http.Response) (*GetJsonResponse, error) {
 	}
 
 	switch {
+	case rsp.StatusCode == 200:
+		break // No content-type
+
 	}
 
 	return response, nil
@@ -842,6 +1050,15 @@ func ParseGetJsonResponse(rsp *http.Response) (*GetJsonResponse, error) {
 
 // ParsePostOtherResponse parses an HTTP response from a PostOtherWithResponse call
 func ParsePostOtherResponse(rsp *http.Response) (*PostOtherResponse, error) {
+	return ParsePostOtherResponseWithDecoders(rsp, nil)
+}
+
+// ParsePostOtherResponseWithDecoders parses an HTTP response from a PostOtherWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParsePostOtherResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*PostOtherResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
@@ -861,6 +1078,15 @@ func ParsePostOtherResponse(rsp *http.Response) (*PostOtherResponse, error) {
 
 // ParseGetOtherResponse parses an HTTP response from a GetOtherWithResponse call
 func ParseGetOtherResponse(rsp *http.Response) (*GetOtherResponse, error) {
+	return ParseGetOtherResponseWithDecoders(rsp, nil)
+}
+
+// ParseGetOtherResponseWithDecoders parses an HTTP response from a GetOtherWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseGetOtherResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*GetOtherResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
@@ -873,6 +1099,9 @@ func ParseGetOtherResponse(rsp *http.Response) (*GetOtherResponse, error) {
 	}
 
 	switch {
+	case rsp.StatusCode == 200:
+		break // No content-type
+
 	}
 
 	return response, nil
@@ -880,6 +1109,15 @@ func ParseGetOtherResponse(rsp *http.Response) (*GetOtherResponse, error) {
 
 // ParseGetJsonWithTrailingSlashResponse parses an HTTP response from a GetJsonWithTrailingSlashWithResponse call
 func ParseGetJsonWithTrailingSlashResponse(rsp *http.Response) (*GetJsonWithTrailingSlashResponse, error) {
+	return ParseGetJsonWithTrailingSlashResponseWithDecoders(rsp, nil)
+}
+
+// ParseGetJsonWithTrailingSlashResponseWithDecoders parses an HTTP response from a GetJsonWithTrailingSlashWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseGetJsonWithTrailingSlashResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*GetJsonWithTrailingSlashResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
@@ -892,6 +1130,9 @@ func ParseGetJsonWithTrailingSlashResponse(rsp *http.Response) (*GetJsonWithTrai
 	}
 
 	switch {
+	case rsp.StatusCode == 200:
+		break // No content-type
+
 	}
 
 	return response, nil
@@ -1010,34 +1251,64 @@ type EchoRouter interface {
 }
 
 // RegisterHandlers adds each server route to the EchoRouter.
-func RegisterHandlers(router EchoRouter, si ServerInterface) {
+func RegisterHandlers(router EchoRouter, si ServerInterface, pathPrefix string) {
 
 	wrapper := ServerInterfaceWrapper{
 		Handler: si,
 	}
 
-	router.POST("/with_both_bodies", wrapper.PostBoth)
-	router.GET("/with_both_responses", wrapper.GetBoth)
-	router.POST("/with_json_body", wrapper.PostJson)
-	router.GET("/with_json_response", wrapper.GetJson)
-	router.POST("/with_other_body", wrapper.PostOther)
-	router.GET("/with_other_response", wrapper.GetOther)
-	router.GET("/with_trailing_slash/", wrapper.GetJsonWithTrailingSlash)
+	router.POST(path.Join(pathPrefix, "/with_both_bodies"), wrapper.PostBoth)
+	router.GET(path.Join(pathPrefix, "/with_both_responses"), wrapper.GetBoth)
+	router.POST(path.Join(pathPrefix, "/with_json_body"), wrapper.PostJson)
+	router.GET(path.Join(pathPrefix, "/with_json_response"), wrapper.GetJson)
+	router.POST(path.Join(pathPrefix, "/with_other_body"), wrapper.PostOther)
+	router.GET(path.Join(pathPrefix, "/with_other_response"), wrapper.GetOther)
+	router.GET(path.Join(pathPrefix, "/with_trailing_slash/"), wrapper.GetJsonWithTrailingSlash)
+
+}
+
+// DecodePostBothJSONRequestBody decodes the application/json body of PostBoth requests. It returns EOF when the request has no body.
+func DecodePostBothJSONRequestBody(r *http.Request) (PostBothJSONRequestBody, error) {
+	var body PostBothJSONRequestBody
+	err := json.NewDecoder(r.Body).Decode(&body)
+	return body, err
+}
 
+// DecodePostBothOctetStreamRequestBody decodes the application/octet-stream body of PostBoth requests. It returns EOF when the request has no body.
+func DecodePostBothOctetStreamRequestBody(r *http.Request) (PostBothOctetStreamRequestBody, error) {
+	if r.ContentLength == 0 {
+		return nil, io.EOF
+	}
+	return r.Body, nil
+}
+
+// DecodePostJsonJSONRequestBody decodes the application/json body of PostJson requests. It returns EOF when the request has no body.
+func DecodePostJsonJSONRequestBody(r *http.Request) (PostJsonJSONRequestBody, error) {
+	var body PostJsonJSONRequestBody
+	err := json.NewDecoder(r.Body).Decode(&body)
+	return body, err
+}
+
+// DecodePostOtherOctetStreamRequestBody decodes the application/octet-stream body of PostOther requests. It returns EOF when the request has no body.
+func DecodePostOtherOctetStreamRequestBody(r *http.Request) (PostOtherOctetStreamRequestBody, error) {
+	if r.ContentLength == 0 {
+		return nil, io.EOF
+	}
+	return r.Body, nil
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/8yUz24TMRDGX2U1cFyyKdz2CAdUJAgikTiEqHK8k9jVrm1mJq1W0b47GicliahCkGjV",
-	"SzTO/NE338/rLdjYpRgwCEO9BbYOO5PDaQ4ny1u0oudEMSGJx5xdeWL5YjrUg/QJoQYW8mENQwkU28cS",
-	"msGfG0/YQD3fVZVHoxaDlviwitrcIFvySXwMUMPMeS4EWbi4dygOqRCHxYfWY5DChGYffvfiviGnGBi5",
-	"MITFGgOSEWwKG4nQStv/CFBC6y0GzjpDXgQ+X89UvXhR+TBDlmKKdIcEJdwh8U7K1Wg8GmthTBhM8lDD",
-	"u9F4dAUlJCMu+1Pde3E3y5h/mr1pKXK2Uo00utd1AzV8jSzvozjYuYN6anqtszEIhtxiUmq9zU3VLauM",
-	"B1gavSZcQQ2vqgPNao+yOuGo/h6PilZQ3rAQmu505CpSZwRqWPpgqIfyD5gnNIU2mP/YOw912LSt1hw5",
-	"cZTdwhof8eIjHqw4qn07Hr9UE4bDjipJaffnWX9S5c/C+p8IZfUP2XOAfut/QkAqi9FuyEsP9XwLk4RZ",
-	"wBx07ojQNFDuYtN0PsBiWBx2ifo+XIBionUXs3i2j2Un/xIWhwXOw/hfV1zI+NaH9Q23hl31t2uij/Fs",
-	"3zLVjhd6b4bhVwAAAP//2pHiCAkHAAA=",
+	"H4sIAAAAAAAC/8yUz27TQBDGX8UaOJo4hZuPcEBFgiASiUOIos16Em9l7y4zk1ZR5HdHsw7YEaUEiVa9",
+	"RLOZP/rm+633CDa0MXj0wlAegW2NrUnhPIWzzQ1a0XOkEJHEYcpuHbF8Mi3qQQ4RoQQWcn4HXQ4UmvsS",
+	"msHve0dYQbnsq/LRqFWnJc5vgzZXyJZcFBc8lLCoHWeCLJzd1Sg1UiY1Zu8ah14y46tT+NVJ/QU5Bs/I",
+	"mSHMduiRjGCV2UCEVprDNw85NM6i56TTp0Xg4/VC1YsTlQ8LZMnmSLdIkMMtEvdSribTyVQLQ0RvooMS",
+	"3kymkyvIIRqpkz/FnZN6vQnppzqZFgMnK9VIo3tdV1DC58DyNkgNvTuop+qgdTZ4QZ9aTIyNs6mpuOHg",
+	"B1gavSTcQgkvioFm0We5OOOo/o5HBSsor1gITXs+chuoNQIlbJw3dID8N5hnNIX2mP44OQ+l3zeN1oyc",
+	"GGWPsMN7vHiPgxWj2tfT6XM1oRt2VEnrzYndn1l/UOVPwvqfCCX1P7MPAfql/xEBqSxGuycnByiXR5hF",
+	"TAKWoHMnhKaCvI9N1ToPq2417BL0fbgAxUzrLmbxZB9LL/8SFsMCD8P4X1dcyLjG+d2aG8N18bdroo/x",
+	"4tQy145nem+67scA2pHiCAkHAAA=",
 }
 
 // GetSwagger returns the Swagger specification corresponding to the generated code
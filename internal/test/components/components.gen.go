@@ -11,6 +11,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
@@ -18,6 +19,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"path"
 	"strings"
 )
 
@@ -48,7 +50,7 @@ type AdditionalPropertiesObject4 struct {
 	AdditionalProperties map[string]interface{}            `json:"-"`
 }
 
-// AdditionalPropertiesObject4_Inner defines model for AdditionalPropertiesObject4.Inner.
+// AdditionalPropertiesObject4_Inner defines model for AdditionalPropertiesObject4.inner.
 type AdditionalPropertiesObject4_Inner struct {
 	Name                 string                 `json:"name"`
 	AdditionalProperties map[string]interface{} `json:"-"`
@@ -59,6 +61,55 @@ type AdditionalPropertiesObject5 struct {
 	AdditionalProperties map[string]SchemaObject `json:"-"`
 }
 
+// AnyOfObject defines model for AnyOfObject.
+type AnyOfObject struct {
+	union json.RawMessage
+}
+
+// DiscriminatedObject defines model for DiscriminatedObject.
+type DiscriminatedObject struct {
+	union json.RawMessage
+}
+
+// DiscriminatedVariantA defines model for DiscriminatedVariantA.
+type DiscriminatedVariantA struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+}
+
+// DiscriminatedVariantB defines model for DiscriminatedVariantB.
+type DiscriminatedVariantB struct {
+	Count int    `json:"count"`
+	Kind  string `json:"kind"`
+}
+
+// IntegerEnum defines model for IntegerEnum.
+type IntegerEnum int
+
+// NumberEnum defines model for NumberEnum.
+type NumberEnum float64
+
+// ObjectWithEnumProperties defines model for ObjectWithEnumProperties.
+type ObjectWithEnumProperties struct {
+	Codes  *[]ObjectWithEnumPropertiesCodesItem `json:"codes,omitempty"`
+	Status ObjectWithEnumPropertiesStatus       `json:"status"`
+}
+
+// ObjectWithEnumPropertiesCodesItem defines model for ObjectWithEnumProperties.codes.Item.
+type ObjectWithEnumPropertiesCodesItem int
+
+// ObjectWithEnumPropertiesStatus defines model for ObjectWithEnumProperties.status.
+type ObjectWithEnumPropertiesStatus string
+
+// ObjectWithFormats defines model for ObjectWithFormats.
+type ObjectWithFormats struct {
+	Address  *openapi_types.IP   `json:"address,omitempty"`
+	Avatar   *openapi_types.File `json:"avatar,omitempty"`
+	Email    openapi_types.Email `json:"email"`
+	Homepage *openapi_types.URI  `json:"homepage,omitempty"`
+	Id       openapi_types.UUID  `json:"id"`
+}
+
 // ObjectWithJsonField defines model for ObjectWithJsonField.
 type ObjectWithJsonField struct {
 	Name   string          `json:"name"`
@@ -66,12 +117,52 @@ type ObjectWithJsonField struct {
 	Value2 json.RawMessage `json:"value2,omitempty"`
 }
 
+// ObjectWithUnionProperty defines model for ObjectWithUnionProperty.
+type ObjectWithUnionProperty struct {
+	Value  ObjectWithUnionPropertyValue         `json:"value"`
+	Values *[]ObjectWithUnionPropertyValuesItem `json:"values,omitempty"`
+}
+
+// ObjectWithUnionPropertyValue1 defines model for ObjectWithUnionProperty.value.1.
+type ObjectWithUnionPropertyValue1 int
+
+// ObjectWithUnionPropertyValue defines model for ObjectWithUnionProperty.value.
+type ObjectWithUnionPropertyValue struct {
+	union json.RawMessage
+}
+
+// ObjectWithUnionPropertyValuesItem defines model for ObjectWithUnionProperty.values.Item.
+type ObjectWithUnionPropertyValuesItem struct {
+	union json.RawMessage
+}
+
+// OneOfObject defines model for OneOfObject.
+type OneOfObject struct {
+	union json.RawMessage
+}
+
+// OneOfObject2 defines model for OneOfObject.2.
+type OneOfObject2 string
+
+// OneOfVariant1 defines model for OneOfVariant1.
+type OneOfVariant1 struct {
+	Name string `json:"name"`
+}
+
+// OneOfVariant2 defines model for OneOfVariant2.
+type OneOfVariant2 struct {
+	Count int `json:"count"`
+}
+
 // SchemaObject defines model for SchemaObject.
 type SchemaObject struct {
 	FirstName string `json:"firstName"`
 	Role      string `json:"role"`
 }
 
+// StringEnum defines model for StringEnum.
+type StringEnum string
+
 // ResponseObject defines model for ResponseObject.
 type ResponseObject struct {
 	Field SchemaObject `json:"Field"`
@@ -117,12 +208,25 @@ type BodyWithAddPropsJSONBody_Inner struct {
 	AdditionalProperties map[string]int `json:"-"`
 }
 
-// EnsureEverythingIsReferencedRequestBody defines body for EnsureEverythingIsReferenced for application/json ContentType.
+// EnsureEverythingIsReferencedJSONRequestBody defines body for EnsureEverythingIsReferenced for application/json ContentType.
 type EnsureEverythingIsReferencedJSONRequestBody RequestBody
 
-// BodyWithAddPropsRequestBody defines body for BodyWithAddProps for application/json ContentType.
+// EnsureEverythingIsReferencedTextRequestBody defines body for EnsureEverythingIsReferenced for text/plain ContentType.
+type EnsureEverythingIsReferencedTextRequestBody string
+
+// BodyWithAddPropsJSONRequestBody defines body for BodyWithAddProps for application/json ContentType.
 type BodyWithAddPropsJSONRequestBody BodyWithAddPropsJSONBody
 
+// MarshalJSON encodes the BodyWithAddPropsJSONRequestBody as the BodyWithAddPropsJSONBody it's defined as.
+func (t BodyWithAddPropsJSONRequestBody) MarshalJSON() ([]byte, error) {
+	return json.Marshal(BodyWithAddPropsJSONBody(t))
+}
+
+// UnmarshalJSON decodes the BodyWithAddPropsJSONRequestBody as the BodyWithAddPropsJSONBody it's defined as.
+func (t *BodyWithAddPropsJSONRequestBody) UnmarshalJSON(b []byte) error {
+	return json.Unmarshal(b, (*BodyWithAddPropsJSONBody)(t))
+}
+
 // Getter for additional properties for ParamsWithAddPropsParams_P1. Returns the specified
 // element and whether it was found
 func (a ParamsWithAddPropsParams_P1) Get(fieldName string) (value interface{}, found bool) {
@@ -719,6 +823,557 @@ func (a AdditionalPropertiesObject5) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
+// AsOneOfVariant1 returns the union data inside the AnyOfObject as a OneOfVariant1
+func (t AnyOfObject) AsOneOfVariant1() (OneOfVariant1, error) {
+	var body OneOfVariant1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOneOfVariant1 overwrites any union data inside the AnyOfObject as the provided OneOfVariant1
+func (t *AnyOfObject) FromOneOfVariant1(v OneOfVariant1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeOneOfVariant1 performs a merge with any union data inside the AnyOfObject, using the provided OneOfVariant1
+func (t *AnyOfObject) MergeOneOfVariant1(v OneOfVariant1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOneOfVariant2 returns the union data inside the AnyOfObject as a OneOfVariant2
+func (t AnyOfObject) AsOneOfVariant2() (OneOfVariant2, error) {
+	var body OneOfVariant2
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOneOfVariant2 overwrites any union data inside the AnyOfObject as the provided OneOfVariant2
+func (t *AnyOfObject) FromOneOfVariant2(v OneOfVariant2) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeOneOfVariant2 performs a merge with any union data inside the AnyOfObject, using the provided OneOfVariant2
+func (t *AnyOfObject) MergeOneOfVariant2(v OneOfVariant2) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// MarshalJSON returns the raw union data of AnyOfObject
+func (t AnyOfObject) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+// UnmarshalJSON stores the raw union data of AnyOfObject, which is decoded by
+// the As* accessors.
+func (t *AnyOfObject) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsDiscriminatedVariantA returns the union data inside the DiscriminatedObject as a DiscriminatedVariantA
+func (t DiscriminatedObject) AsDiscriminatedVariantA() (DiscriminatedVariantA, error) {
+	var body DiscriminatedVariantA
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromDiscriminatedVariantA overwrites any union data inside the DiscriminatedObject as the provided DiscriminatedVariantA
+func (t *DiscriminatedObject) FromDiscriminatedVariantA(v DiscriminatedVariantA) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeDiscriminatedVariantA performs a merge with any union data inside the DiscriminatedObject, using the provided DiscriminatedVariantA
+func (t *DiscriminatedObject) MergeDiscriminatedVariantA(v DiscriminatedVariantA) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsDiscriminatedVariantB returns the union data inside the DiscriminatedObject as a DiscriminatedVariantB
+func (t DiscriminatedObject) AsDiscriminatedVariantB() (DiscriminatedVariantB, error) {
+	var body DiscriminatedVariantB
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromDiscriminatedVariantB overwrites any union data inside the DiscriminatedObject as the provided DiscriminatedVariantB
+func (t *DiscriminatedObject) FromDiscriminatedVariantB(v DiscriminatedVariantB) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeDiscriminatedVariantB performs a merge with any union data inside the DiscriminatedObject, using the provided DiscriminatedVariantB
+func (t *DiscriminatedObject) MergeDiscriminatedVariantB(v DiscriminatedVariantB) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// Discriminator returns the value of the "kind" property of the DiscriminatedObject, which
+// selects the type of the union data
+func (t DiscriminatedObject) Discriminator() (string, error) {
+	var object map[string]json.RawMessage
+	err := json.Unmarshal(t.union, &object)
+	if err != nil {
+		return "", err
+	}
+	var discriminator string
+	if raw, found := object["kind"]; found {
+		err = json.Unmarshal(raw, &discriminator)
+	}
+	return discriminator, err
+}
+
+// ValueByDiscriminator returns the union data inside the DiscriminatedObject as the
+// type selected by its discriminator
+func (t DiscriminatedObject) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := t.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "DiscriminatedVariantB":
+		var body DiscriminatedVariantB
+		err := json.Unmarshal(t.union, &body)
+		return body, err
+	case "a":
+		var body DiscriminatedVariantA
+		err := json.Unmarshal(t.union, &body)
+		return body, err
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+// MarshalJSON returns the raw union data of DiscriminatedObject
+func (t DiscriminatedObject) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+// UnmarshalJSON stores the raw union data of DiscriminatedObject, which is decoded by
+// the As* accessors.
+func (t *DiscriminatedObject) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsOneOfVariant1 returns the union data inside the ObjectWithUnionPropertyValue as a OneOfVariant1
+func (t ObjectWithUnionPropertyValue) AsOneOfVariant1() (OneOfVariant1, error) {
+	var body OneOfVariant1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOneOfVariant1 overwrites any union data inside the ObjectWithUnionPropertyValue as the provided OneOfVariant1
+func (t *ObjectWithUnionPropertyValue) FromOneOfVariant1(v OneOfVariant1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeOneOfVariant1 performs a merge with any union data inside the ObjectWithUnionPropertyValue, using the provided OneOfVariant1
+func (t *ObjectWithUnionPropertyValue) MergeOneOfVariant1(v OneOfVariant1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsObjectWithUnionPropertyValue1 returns the union data inside the ObjectWithUnionPropertyValue as a ObjectWithUnionPropertyValue1
+func (t ObjectWithUnionPropertyValue) AsObjectWithUnionPropertyValue1() (ObjectWithUnionPropertyValue1, error) {
+	var body ObjectWithUnionPropertyValue1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromObjectWithUnionPropertyValue1 overwrites any union data inside the ObjectWithUnionPropertyValue as the provided ObjectWithUnionPropertyValue1
+func (t *ObjectWithUnionPropertyValue) FromObjectWithUnionPropertyValue1(v ObjectWithUnionPropertyValue1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeObjectWithUnionPropertyValue1 performs a merge with any union data inside the ObjectWithUnionPropertyValue, using the provided ObjectWithUnionPropertyValue1
+func (t *ObjectWithUnionPropertyValue) MergeObjectWithUnionPropertyValue1(v ObjectWithUnionPropertyValue1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// MarshalJSON returns the raw union data of ObjectWithUnionPropertyValue
+func (t ObjectWithUnionPropertyValue) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+// UnmarshalJSON stores the raw union data of ObjectWithUnionPropertyValue, which is decoded by
+// the As* accessors.
+func (t *ObjectWithUnionPropertyValue) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsOneOfVariant1 returns the union data inside the ObjectWithUnionPropertyValuesItem as a OneOfVariant1
+func (t ObjectWithUnionPropertyValuesItem) AsOneOfVariant1() (OneOfVariant1, error) {
+	var body OneOfVariant1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOneOfVariant1 overwrites any union data inside the ObjectWithUnionPropertyValuesItem as the provided OneOfVariant1
+func (t *ObjectWithUnionPropertyValuesItem) FromOneOfVariant1(v OneOfVariant1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeOneOfVariant1 performs a merge with any union data inside the ObjectWithUnionPropertyValuesItem, using the provided OneOfVariant1
+func (t *ObjectWithUnionPropertyValuesItem) MergeOneOfVariant1(v OneOfVariant1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOneOfVariant2 returns the union data inside the ObjectWithUnionPropertyValuesItem as a OneOfVariant2
+func (t ObjectWithUnionPropertyValuesItem) AsOneOfVariant2() (OneOfVariant2, error) {
+	var body OneOfVariant2
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOneOfVariant2 overwrites any union data inside the ObjectWithUnionPropertyValuesItem as the provided OneOfVariant2
+func (t *ObjectWithUnionPropertyValuesItem) FromOneOfVariant2(v OneOfVariant2) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeOneOfVariant2 performs a merge with any union data inside the ObjectWithUnionPropertyValuesItem, using the provided OneOfVariant2
+func (t *ObjectWithUnionPropertyValuesItem) MergeOneOfVariant2(v OneOfVariant2) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// MarshalJSON returns the raw union data of ObjectWithUnionPropertyValuesItem
+func (t ObjectWithUnionPropertyValuesItem) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+// UnmarshalJSON stores the raw union data of ObjectWithUnionPropertyValuesItem, which is decoded by
+// the As* accessors.
+func (t *ObjectWithUnionPropertyValuesItem) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsOneOfVariant1 returns the union data inside the OneOfObject as a OneOfVariant1
+func (t OneOfObject) AsOneOfVariant1() (OneOfVariant1, error) {
+	var body OneOfVariant1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOneOfVariant1 overwrites any union data inside the OneOfObject as the provided OneOfVariant1
+func (t *OneOfObject) FromOneOfVariant1(v OneOfVariant1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeOneOfVariant1 performs a merge with any union data inside the OneOfObject, using the provided OneOfVariant1
+func (t *OneOfObject) MergeOneOfVariant1(v OneOfVariant1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOneOfVariant2 returns the union data inside the OneOfObject as a OneOfVariant2
+func (t OneOfObject) AsOneOfVariant2() (OneOfVariant2, error) {
+	var body OneOfVariant2
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOneOfVariant2 overwrites any union data inside the OneOfObject as the provided OneOfVariant2
+func (t *OneOfObject) FromOneOfVariant2(v OneOfVariant2) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeOneOfVariant2 performs a merge with any union data inside the OneOfObject, using the provided OneOfVariant2
+func (t *OneOfObject) MergeOneOfVariant2(v OneOfVariant2) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOneOfObject2 returns the union data inside the OneOfObject as a OneOfObject2
+func (t OneOfObject) AsOneOfObject2() (OneOfObject2, error) {
+	var body OneOfObject2
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOneOfObject2 overwrites any union data inside the OneOfObject as the provided OneOfObject2
+func (t *OneOfObject) FromOneOfObject2(v OneOfObject2) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeOneOfObject2 performs a merge with any union data inside the OneOfObject, using the provided OneOfObject2
+func (t *OneOfObject) MergeOneOfObject2(v OneOfObject2) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// MarshalJSON returns the raw union data of OneOfObject
+func (t OneOfObject) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+// UnmarshalJSON stores the raw union data of OneOfObject, which is decoded by
+// the As* accessors.
+func (t *OneOfObject) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// List of IntegerEnum
+const (
+	IntegerEnum1      IntegerEnum = 1
+	IntegerEnumMinus1 IntegerEnum = -1
+	IntegerEnum100    IntegerEnum = 100
+)
+
+// AllIntegerEnumValues returns all the possible values of IntegerEnum
+func AllIntegerEnumValues() []IntegerEnum {
+	return []IntegerEnum{
+		IntegerEnum1,
+		IntegerEnumMinus1,
+		IntegerEnum100,
+	}
+}
+
+// Valid returns whether the IntegerEnum is one of the values of the enum
+func (e IntegerEnum) Valid() bool {
+	switch e {
+	case IntegerEnum1:
+		return true
+	case IntegerEnumMinus1:
+		return true
+	case IntegerEnum100:
+		return true
+	default:
+		return false
+	}
+}
+
+// List of NumberEnum
+const (
+	NumberEnum1Dot5  NumberEnum = 1.5
+	NumberEnumMinus2 NumberEnum = -2
+)
+
+// AllNumberEnumValues returns all the possible values of NumberEnum
+func AllNumberEnumValues() []NumberEnum {
+	return []NumberEnum{
+		NumberEnum1Dot5,
+		NumberEnumMinus2,
+	}
+}
+
+// Valid returns whether the NumberEnum is one of the values of the enum
+func (e NumberEnum) Valid() bool {
+	switch e {
+	case NumberEnum1Dot5:
+		return true
+	case NumberEnumMinus2:
+		return true
+	default:
+		return false
+	}
+}
+
+// List of ObjectWithEnumPropertiesCodesItem
+const (
+	ObjectWithEnumPropertiesCodesItem1 ObjectWithEnumPropertiesCodesItem = 1
+	ObjectWithEnumPropertiesCodesItem2 ObjectWithEnumPropertiesCodesItem = 2
+)
+
+// AllObjectWithEnumPropertiesCodesItemValues returns all the possible values of ObjectWithEnumPropertiesCodesItem
+func AllObjectWithEnumPropertiesCodesItemValues() []ObjectWithEnumPropertiesCodesItem {
+	return []ObjectWithEnumPropertiesCodesItem{
+		ObjectWithEnumPropertiesCodesItem1,
+		ObjectWithEnumPropertiesCodesItem2,
+	}
+}
+
+// Valid returns whether the ObjectWithEnumPropertiesCodesItem is one of the values of the enum
+func (e ObjectWithEnumPropertiesCodesItem) Valid() bool {
+	switch e {
+	case ObjectWithEnumPropertiesCodesItem1:
+		return true
+	case ObjectWithEnumPropertiesCodesItem2:
+		return true
+	default:
+		return false
+	}
+}
+
+// List of ObjectWithEnumPropertiesStatus
+const (
+	ObjectWithEnumPropertiesStatusActive   ObjectWithEnumPropertiesStatus = "active"
+	ObjectWithEnumPropertiesStatusInactive ObjectWithEnumPropertiesStatus = "inactive"
+)
+
+// The names which the constants of ObjectWithEnumPropertiesStatus had before they were named
+// in CamelCase
+const (
+	// Deprecated: Use ObjectWithEnumPropertiesStatusActive instead.
+	ObjectWithEnumPropertiesStatus_active = ObjectWithEnumPropertiesStatusActive
+	// Deprecated: Use ObjectWithEnumPropertiesStatusInactive instead.
+	ObjectWithEnumPropertiesStatus_inactive = ObjectWithEnumPropertiesStatusInactive
+)
+
+// AllObjectWithEnumPropertiesStatusValues returns all the possible values of ObjectWithEnumPropertiesStatus
+func AllObjectWithEnumPropertiesStatusValues() []ObjectWithEnumPropertiesStatus {
+	return []ObjectWithEnumPropertiesStatus{
+		ObjectWithEnumPropertiesStatusActive,
+		ObjectWithEnumPropertiesStatusInactive,
+	}
+}
+
+// Valid returns whether the ObjectWithEnumPropertiesStatus is one of the values of the enum
+func (e ObjectWithEnumPropertiesStatus) Valid() bool {
+	switch e {
+	case ObjectWithEnumPropertiesStatusActive:
+		return true
+	case ObjectWithEnumPropertiesStatusInactive:
+		return true
+	default:
+		return false
+	}
+}
+
+// List of StringEnum
+const (
+	StringEnumFirst     StringEnum = "first"
+	StringEnumWithDash  StringEnum = "with-dash"
+	StringEnum1st       StringEnum = "1st"
+	StringEnumEmpty     StringEnum = ""
+	StringEnumWithDash2 StringEnum = "With Dash"
+)
+
+// The names which the constants of StringEnum had before they were named
+// in CamelCase
+const (
+	// Deprecated: Use StringEnumFirst instead.
+	StringEnum_first = StringEnumFirst
+	// Deprecated: Use StringEnum1st instead.
+	StringEnum_1st = StringEnum1st
+)
+
+// AllStringEnumValues returns all the possible values of StringEnum
+func AllStringEnumValues() []StringEnum {
+	return []StringEnum{
+		StringEnumFirst,
+		StringEnumWithDash,
+		StringEnum1st,
+		StringEnumEmpty,
+		StringEnumWithDash2,
+	}
+}
+
+// Valid returns whether the StringEnum is one of the values of the enum
+func (e StringEnum) Valid() bool {
+	switch e {
+	case StringEnumFirst:
+		return true
+	case StringEnumWithDash:
+		return true
+	case StringEnum1st:
+		return true
+	case StringEnumEmpty:
+		return true
+	case StringEnumWithDash2:
+		return true
+	default:
+		return false
+	}
+}
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -742,6 +1397,10 @@ type Client struct {
 	// A callback for modifying requests which are generated before sending over
 	// the network.
 	RequestEditor RequestEditorFn
+
+	// The decoders of response bodies, by media type. When nil,
+	// runtime.DefaultResponseDecoders is used.
+	ResponseDecoders *runtime.ResponseDecoders
 }
 
 // ClientOption allows setting custom parameters during construction
@@ -788,6 +1447,19 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 	}
 }
 
+// WithResponseDecoder registers the decoder of the response bodies of a media
+// type, such as application/x-protobuf, for this client only. Registering the
+// decoder of application/cbor decodes the media types with a +cbor suffix too.
+func WithResponseDecoder(mediaType string, decoder runtime.ResponseDecoder) ClientOption {
+	return func(c *Client) error {
+		if c.ResponseDecoders == nil {
+			c.ResponseDecoders = runtime.DefaultResponseDecoders.Clone()
+		}
+		c.ResponseDecoders.Register(mediaType, decoder)
+		return nil
+	}
+}
+
 // The interface specification for the client above.
 type ClientInterface interface {
 	// EnsureEverythingIsReferenced request  with any body
@@ -795,6 +1467,8 @@ type ClientInterface interface {
 
 	EnsureEverythingIsReferenced(ctx context.Context, body EnsureEverythingIsReferencedJSONRequestBody) (*http.Response, error)
 
+	EnsureEverythingIsReferencedWithTextBody(ctx context.Context, body EnsureEverythingIsReferencedTextRequestBody) (*http.Response, error)
+
 	// ParamsWithAddProps request
 	ParamsWithAddProps(ctx context.Context, params *ParamsWithAddPropsParams) (*http.Response, error)
 
@@ -813,6 +1487,11 @@ func (c *Client) EnsureEverythingIsReferencedWithBody(ctx context.Context, conte
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -828,6 +1507,31 @@ func (c *Client) EnsureEverythingIsReferenced(ctx context.Context, body EnsureEv
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
+			return nil, err
+		}
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EnsureEverythingIsReferencedWithTextBody(ctx context.Context, body EnsureEverythingIsReferencedTextRequestBody) (*http.Response, error) {
+	req, err := NewEnsureEverythingIsReferencedRequestWithTextBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if c.RequestEditor != nil {
+		err = c.RequestEditor(ctx, req)
+		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -843,6 +1547,11 @@ func (c *Client) ParamsWithAddProps(ctx context.Context, params *ParamsWithAddPr
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -858,6 +1567,11 @@ func (c *Client) BodyWithAddPropsWithBody(ctx context.Context, contentType strin
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -873,6 +1587,11 @@ func (c *Client) BodyWithAddProps(ctx context.Context, body BodyWithAddPropsJSON
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -890,11 +1609,19 @@ func NewEnsureEverythingIsReferencedRequest(server string, body EnsureEverything
 	return NewEnsureEverythingIsReferencedRequestWithBody(server, "application/json", bodyReader)
 }
 
+// NewEnsureEverythingIsReferencedRequestWithTextBody calls the generic EnsureEverythingIsReferenced builder with text/plain body
+func NewEnsureEverythingIsReferencedRequestWithTextBody(server string, body EnsureEverythingIsReferencedTextRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyReader = strings.NewReader(string(body))
+	return NewEnsureEverythingIsReferencedRequestWithBody(server, "text/plain", bodyReader)
+}
+
 // NewEnsureEverythingIsReferencedRequestWithBody generates requests for EnsureEverythingIsReferenced with any type of body
 func NewEnsureEverythingIsReferencedRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	queryUrl, err := url.Parse(server)
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
@@ -909,7 +1636,8 @@ func NewEnsureEverythingIsReferencedRequestWithBody(server string, contentType s
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryUrl.String(), body)
+	var req *http.Request
+	req, err = http.NewRequest("GET", queryUrl.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -922,7 +1650,8 @@ func NewEnsureEverythingIsReferencedRequestWithBody(server string, contentType s
 func NewParamsWithAddPropsRequest(server string, params *ParamsWithAddPropsParams) (*http.Request, error) {
 	var err error
 
-	queryUrl, err := url.Parse(server)
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
@@ -939,9 +1668,16 @@ func NewParamsWithAddPropsRequest(server string, params *ParamsWithAddPropsParam
 
 	queryValues := queryUrl.Query()
 
-	if queryFrag, err := runtime.StyleParam("simple", true, "p1", params.P1); err != nil {
+	var queryFrag string
+	var parsed url.Values
+	var queryParamBuf []byte
+	_ = queryFrag
+	_ = parsed
+	_ = queryParamBuf
+
+	if queryFrag, err = runtime.StyleParam("simple", true, "p1", params.P1); err != nil {
 		return nil, err
-	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+	} else if parsed, err = url.ParseQuery(queryFrag); err != nil {
 		return nil, err
 	} else {
 		for k, v := range parsed {
@@ -951,9 +1687,9 @@ func NewParamsWithAddPropsRequest(server string, params *ParamsWithAddPropsParam
 		}
 	}
 
-	if queryFrag, err := runtime.StyleParam("form", true, "p2", params.P2); err != nil {
+	if queryFrag, err = runtime.StyleParam("form", true, "p2", params.P2); err != nil {
 		return nil, err
-	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+	} else if parsed, err = url.ParseQuery(queryFrag); err != nil {
 		return nil, err
 	} else {
 		for k, v := range parsed {
@@ -965,7 +1701,8 @@ func NewParamsWithAddPropsRequest(server string, params *ParamsWithAddPropsParam
 
 	queryUrl.RawQuery = queryValues.Encode()
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	var req *http.Request
+	req, err = http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -988,7 +1725,8 @@ func NewBodyWithAddPropsRequest(server string, body BodyWithAddPropsJSONRequestB
 func NewBodyWithAddPropsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	queryUrl, err := url.Parse(server)
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
@@ -1003,7 +1741,8 @@ func NewBodyWithAddPropsRequestWithBody(server string, contentType string, body
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryUrl.String(), body)
+	var req *http.Request
+	req, err = http.NewRequest("POST", queryUrl.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -1015,6 +1754,10 @@ func NewBodyWithAddPropsRequestWithBody(server string, contentType string, body
 // ClientWithResponses builds on ClientInterface to offer response payloads
 type ClientWithResponses struct {
 	ClientInterface
+
+	// The decoders of response bodies, from the ResponseDecoders of the
+	// Client.
+	decoders *runtime.ResponseDecoders
 }
 
 // NewClientWithResponses creates a new ClientWithResponses, which wraps
@@ -1024,7 +1767,7 @@ func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithRes
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+	return &ClientWithResponses{ClientInterface: client, decoders: client.ResponseDecoders}, nil
 }
 
 // WithBaseURL overrides the baseURL.
@@ -1046,6 +1789,8 @@ type ClientWithResponsesInterface interface {
 
 	EnsureEverythingIsReferencedWithResponse(ctx context.Context, body EnsureEverythingIsReferencedJSONRequestBody) (*EnsureEverythingIsReferencedResponse, error)
 
+	EnsureEverythingIsReferencedWithTextBodyWithResponse(ctx context.Context, body EnsureEverythingIsReferencedTextRequestBody) (*EnsureEverythingIsReferencedResponse, error)
+
 	// ParamsWithAddProps request
 	ParamsWithAddPropsWithResponse(ctx context.Context, params *ParamsWithAddPropsParams) (*ParamsWithAddPropsResponse, error)
 
@@ -1060,25 +1805,49 @@ type EnsureEverythingIsReferencedResponse struct {
 	HTTPResponse *http.Response
 	JSON200      *struct {
 
+		// anyOf with references
+		AnyOf *AnyOfObject `json:"anyOf,omitempty"`
+
+		// oneOf with a discriminator, one explicit mapping and one implicit
+		Discriminated *DiscriminatedObject `json:"discriminated,omitempty"`
+
+		// Has inline enums, which need their own types
+		EnumProperties *ObjectWithEnumProperties `json:"enumProperties,omitempty"`
+
 		// Has additional properties with schema for dictionaries
 		Five *AdditionalPropertiesObject5 `json:"five,omitempty"`
 
+		// Well-known string formats have their own types
+		Formats *ObjectWithFormats `json:"formats,omitempty"`
+
 		// Has anonymous field which has additional properties
-		Four      *AdditionalPropertiesObject4 `json:"four,omitempty"`
-		JsonField *ObjectWithJsonField         `json:"jsonField,omitempty"`
+		Four        *AdditionalPropertiesObject4 `json:"four,omitempty"`
+		IntegerEnum *IntegerEnum                 `json:"integerEnum,omitempty"`
+		JsonField   *ObjectWithJsonField         `json:"jsonField,omitempty"`
+		NumberEnum  *NumberEnum                  `json:"numberEnum,omitempty"`
 
 		// Has additional properties of type int
 		One *AdditionalPropertiesObject1 `json:"one,omitempty"`
 
+		// oneOf with references and an inline primitive
+		OneOf *OneOfObject `json:"oneOf,omitempty"`
+
+		// String values which aren't valid Go identifiers
+		StringEnum *StringEnum `json:"stringEnum,omitempty"`
+
 		// Allows any additional property
 		Three *AdditionalPropertiesObject3 `json:"three,omitempty"`
 
 		// Does not allow additional properties
 		Two *AdditionalPropertiesObject2 `json:"two,omitempty"`
+
+		// Has inline union properties, which need their own types
+		UnionProperty *ObjectWithUnionProperty `json:"unionProperty,omitempty"`
 	}
 	JSONDefault *struct {
 		Field SchemaObject `json:"Field"`
 	}
+	TextPlainDefault *string
 }
 
 // Status returns HTTPResponse.Status
@@ -1145,7 +1914,7 @@ func (c *ClientWithResponses) EnsureEverythingIsReferencedWithBodyWithResponse(c
 	if err != nil {
 		return nil, err
 	}
-	return ParseEnsureEverythingIsReferencedResponse(rsp)
+	return ParseEnsureEverythingIsReferencedResponseWithDecoders(rsp, c.decoders)
 }
 
 func (c *ClientWithResponses) EnsureEverythingIsReferencedWithResponse(ctx context.Context, body EnsureEverythingIsReferencedJSONRequestBody) (*EnsureEverythingIsReferencedResponse, error) {
@@ -1153,7 +1922,15 @@ func (c *ClientWithResponses) EnsureEverythingIsReferencedWithResponse(ctx conte
 	if err != nil {
 		return nil, err
 	}
-	return ParseEnsureEverythingIsReferencedResponse(rsp)
+	return ParseEnsureEverythingIsReferencedResponseWithDecoders(rsp, c.decoders)
+}
+
+func (c *ClientWithResponses) EnsureEverythingIsReferencedWithTextBodyWithResponse(ctx context.Context, body EnsureEverythingIsReferencedTextRequestBody) (*EnsureEverythingIsReferencedResponse, error) {
+	rsp, err := c.EnsureEverythingIsReferencedWithTextBody(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEnsureEverythingIsReferencedResponseWithDecoders(rsp, c.decoders)
 }
 
 // ParamsWithAddPropsWithResponse request returning *ParamsWithAddPropsResponse
@@ -1162,7 +1939,7 @@ func (c *ClientWithResponses) ParamsWithAddPropsWithResponse(ctx context.Context
 	if err != nil {
 		return nil, err
 	}
-	return ParseParamsWithAddPropsResponse(rsp)
+	return ParseParamsWithAddPropsResponseWithDecoders(rsp, c.decoders)
 }
 
 // BodyWithAddPropsWithBodyWithResponse request with arbitrary body returning *BodyWithAddPropsResponse
@@ -1171,7 +1948,7 @@ func (c *ClientWithResponses) BodyWithAddPropsWithBodyWithResponse(ctx context.C
 	if err != nil {
 		return nil, err
 	}
-	return ParseBodyWithAddPropsResponse(rsp)
+	return ParseBodyWithAddPropsResponseWithDecoders(rsp, c.decoders)
 }
 
 func (c *ClientWithResponses) BodyWithAddPropsWithResponse(ctx context.Context, body BodyWithAddPropsJSONRequestBody) (*BodyWithAddPropsResponse, error) {
@@ -1179,11 +1956,20 @@ func (c *ClientWithResponses) BodyWithAddPropsWithResponse(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	return ParseBodyWithAddPropsResponse(rsp)
+	return ParseBodyWithAddPropsResponseWithDecoders(rsp, c.decoders)
 }
 
 // ParseEnsureEverythingIsReferencedResponse parses an HTTP response from a EnsureEverythingIsReferencedWithResponse call
 func ParseEnsureEverythingIsReferencedResponse(rsp *http.Response) (*EnsureEverythingIsReferencedResponse, error) {
+	return ParseEnsureEverythingIsReferencedResponseWithDecoders(rsp, nil)
+}
+
+// ParseEnsureEverythingIsReferencedResponseWithDecoders parses an HTTP response from a EnsureEverythingIsReferencedWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseEnsureEverythingIsReferencedResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*EnsureEverythingIsReferencedResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
@@ -1195,42 +1981,85 @@ func ParseEnsureEverythingIsReferencedResponse(rsp *http.Response) (*EnsureEvery
 		HTTPResponse: rsp,
 	}
 
+	contentType := rsp.Header.Get("Content-Type")
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
+	case runtime.MediaTypeMatches(contentType, "application/json") && rsp.StatusCode == 200:
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest struct {
 
-			// Has additional properties with schema for dictionaries
-			Five *AdditionalPropertiesObject5 `json:"five,omitempty"`
+				// anyOf with references
+				AnyOf *AnyOfObject `json:"anyOf,omitempty"`
 
-			// Has anonymous field which has additional properties
-			Four      *AdditionalPropertiesObject4 `json:"four,omitempty"`
-			JsonField *ObjectWithJsonField         `json:"jsonField,omitempty"`
+				// oneOf with a discriminator, one explicit mapping and one implicit
+				Discriminated *DiscriminatedObject `json:"discriminated,omitempty"`
 
-			// Has additional properties of type int
-			One *AdditionalPropertiesObject1 `json:"one,omitempty"`
+				// Has inline enums, which need their own types
+				EnumProperties *ObjectWithEnumProperties `json:"enumProperties,omitempty"`
 
-			// Allows any additional property
-			Three *AdditionalPropertiesObject3 `json:"three,omitempty"`
+				// Has additional properties with schema for dictionaries
+				Five *AdditionalPropertiesObject5 `json:"five,omitempty"`
 
-			// Does not allow additional properties
-			Two *AdditionalPropertiesObject2 `json:"two,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+				// Well-known string formats have their own types
+				Formats *ObjectWithFormats `json:"formats,omitempty"`
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json"):
-		var dest struct {
-			Field SchemaObject `json:"Field"`
+				// Has anonymous field which has additional properties
+				Four        *AdditionalPropertiesObject4 `json:"four,omitempty"`
+				IntegerEnum *IntegerEnum                 `json:"integerEnum,omitempty"`
+				JsonField   *ObjectWithJsonField         `json:"jsonField,omitempty"`
+				NumberEnum  *NumberEnum                  `json:"numberEnum,omitempty"`
+
+				// Has additional properties of type int
+				One *AdditionalPropertiesObject1 `json:"one,omitempty"`
+
+				// oneOf with references and an inline primitive
+				OneOf *OneOfObject `json:"oneOf,omitempty"`
+
+				// String values which aren't valid Go identifiers
+				StringEnum *StringEnum `json:"stringEnum,omitempty"`
+
+				// Allows any additional property
+				Three *AdditionalPropertiesObject3 `json:"three,omitempty"`
+
+				// Does not allow additional properties
+				Two *AdditionalPropertiesObject2 `json:"two,omitempty"`
+
+				// Has inline union properties, which need their own types
+				UnionProperty *ObjectWithUnionProperty `json:"unionProperty,omitempty"`
+			}
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.JSON200 = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
 		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+
+	case runtime.MediaTypeMatches(contentType, "application/json"):
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest struct {
+				Field SchemaObject `json:"Field"`
+			}
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.JSONDefault = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
 		}
-		response.JSONDefault = &dest
 
-	default:
-		// Content-type (text/plain) unsupported
+	case runtime.MediaTypeMatches(contentType, "text/plain"):
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest string
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.TextPlainDefault = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
+		}
 
 	}
 
@@ -1239,6 +2068,15 @@ func ParseEnsureEverythingIsReferencedResponse(rsp *http.Response) (*EnsureEvery
 
 // ParseParamsWithAddPropsResponse parses an HTTP response from a ParamsWithAddPropsWithResponse call
 func ParseParamsWithAddPropsResponse(rsp *http.Response) (*ParamsWithAddPropsResponse, error) {
+	return ParseParamsWithAddPropsResponseWithDecoders(rsp, nil)
+}
+
+// ParseParamsWithAddPropsResponseWithDecoders parses an HTTP response from a ParamsWithAddPropsWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseParamsWithAddPropsResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*ParamsWithAddPropsResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
@@ -1258,6 +2096,15 @@ func ParseParamsWithAddPropsResponse(rsp *http.Response) (*ParamsWithAddPropsRes
 
 // ParseBodyWithAddPropsResponse parses an HTTP response from a BodyWithAddPropsWithResponse call
 func ParseBodyWithAddPropsResponse(rsp *http.Response) (*BodyWithAddPropsResponse, error) {
+	return ParseBodyWithAddPropsResponseWithDecoders(rsp, nil)
+}
+
+// ParseBodyWithAddPropsResponseWithDecoders parses an HTTP response from a BodyWithAddPropsWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseBodyWithAddPropsResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*BodyWithAddPropsResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
@@ -1352,38 +2199,74 @@ type EchoRouter interface {
 }
 
 // RegisterHandlers adds each server route to the EchoRouter.
-func RegisterHandlers(router EchoRouter, si ServerInterface) {
+func RegisterHandlers(router EchoRouter, si ServerInterface, pathPrefix string) {
 
 	wrapper := ServerInterfaceWrapper{
 		Handler: si,
 	}
 
-	router.GET("/ensure-everything-is-referenced", wrapper.EnsureEverythingIsReferenced)
-	router.GET("/params_with_add_props", wrapper.ParamsWithAddProps)
-	router.POST("/params_with_add_props", wrapper.BodyWithAddProps)
+	router.GET(path.Join(pathPrefix, "/ensure-everything-is-referenced"), wrapper.EnsureEverythingIsReferenced)
+	router.GET(path.Join(pathPrefix, "/params_with_add_props"), wrapper.ParamsWithAddProps)
+	router.POST(path.Join(pathPrefix, "/params_with_add_props"), wrapper.BodyWithAddProps)
+
+}
+
+// DecodeEnsureEverythingIsReferencedJSONRequestBody decodes the application/json body of EnsureEverythingIsReferenced requests. It returns EOF when the request has no body.
+func DecodeEnsureEverythingIsReferencedJSONRequestBody(r *http.Request) (EnsureEverythingIsReferencedJSONRequestBody, error) {
+	var body EnsureEverythingIsReferencedJSONRequestBody
+	err := json.NewDecoder(r.Body).Decode(&body)
+	return body, err
+}
+
+// DecodeEnsureEverythingIsReferencedTextRequestBody decodes the text/plain body of EnsureEverythingIsReferenced requests. It returns EOF when the request has no body.
+func DecodeEnsureEverythingIsReferencedTextRequestBody(r *http.Request) (EnsureEverythingIsReferencedTextRequestBody, error) {
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return "", err
+	}
+	if len(data) == 0 {
+		return "", io.EOF
+	}
+	return EnsureEverythingIsReferencedTextRequestBody(data), nil
+}
 
+// DecodeBodyWithAddPropsJSONRequestBody decodes the application/json body of BodyWithAddProps requests. It returns EOF when the request has no body.
+func DecodeBodyWithAddPropsJSONRequestBody(r *http.Request) (BodyWithAddPropsJSONRequestBody, error) {
+	var body BodyWithAddPropsJSONRequestBody
+	err := json.NewDecoder(r.Body).Decode(&body)
+	return body, err
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9RXTW/jNhD9KwTboxLHzvaim4tu0RRoG+wG6GFjBLQ4ipjKQy05sldY6L8XQ8nflNdx",
-	"9rK5RJY5X49v3oy/yswuKouA5GX6VTr4XIOnX602EF582Lxo+GNmkQCJH1VVlSZTZCyOXrxFfuezAhaK",
-	"nypnK3DUe/ndQKn54WcHuUzlT6Nt2FFn5Ecfw/9/5i+QkWzbJCRjHGiZfuo9zPg1wRcaVaUyByGpqUCm",
-	"0pMz+Cxb/mMfvrLo18V0H/oYP1o9idTgM2cqzlGmciq8WVQliHWRwm6D9Vmwo6nWhk1Ueb+poktrHAqP",
-	"fL0T3yDBMzh5FP4P5cXWVmwREjYXbCwMkkwOoDM67hvVAiJVJ9JWXYAYJPuYBhcJR5gl66NrRJITKEyG",
-	"UchV6eGw8N8seIGWhCpLu4pj8Na6v1Npt8OlkauPKptyQV4obCJVNUc1vSL316X97nVpByaixWZhay9y",
-	"bi2xKkxWiGKIo8f3gwjuW2G/a/nnmXd5JZeg+Mup7j5fuc7v+5WhQnRORG6d0CYLh1wH+FHqXYR/DRV/",
-	"eosbUT0L5UQuVVlDULDcuoUimcqg28nA0ckZR+Nt10eKob8H1VHuuXGe/h4qwNnyDAKEU8mOq1kYBQZz",
-	"y8alyQA9bJGSf909sHcyxO7lA3gSH8EtA42W4Hx3jePrm+ubTmABVWVkKm+vb67H3BmKipD/CNDXDq5g",
-	"Ca6hwuDzlfFXDnJwgBmE23qGUPg+Rx4K4wWgrqxBEvDFePLCW0GFIrFlnMgUijmIzIEi0MKgoML4R/QV",
-	"ZEKhDjI7B1G5GkE/8o0xvmFK32mZyvchwfeb/O78h212yc4+0wyRfm/lGe3uO4frw+Tm5g07Q26W8K3G",
-	"O9XLbSJzW7vLXbxjFy+7jXbKT6w3mSz4hiLGgZeFgzf4uA0+VvZyD5PQYged3K9XuapLGmZKT4bRwSLZ",
-	"WY8q5dTCP7EKPimtn/j+/WCLTAW3WaeZwRIInA+kV2JuddOPsF4L4pIb6Yj7kAVf3FTr+5ACd/Q6gEw/",
-	"RZt1c2J4ZoZgvKXKzzW4Zj2UUlmN5a5mdbNy2wenJuqRoHpqgmx1q61sk3OyxZ3xHwbmZmfpQUQA7QVZ",
-	"MYdHpNphEBuyQvUnu4WVh1YsW7ZcWfffMAKTkwi8atWITIpDssZWhFnbzvYEC+uybBNZWR9hXxjiote+",
-	"XbqxuimD/K02DjKKApIwTx/xJPBM7JhthLMstweMdRf+8Dx/fTv3GnaW9Qt3uPX2vr6n9pAr7fHFtW37",
-	"fwAAAP//Xv36ip0PAAA=",
+	"H4sIAAAAAAAC/9RZT2/buBL/KgTfA96FiWO3vfiWou3bLLBN0Ha3h8YoaHEcsZWGKkk5NQp99wVJyZIs",
+	"ypbTYoE9RZbmz2+G84+THzRReaEQ0Bq6/EE1fCvB2JdKSPAv3u1f7NzPRKEFtO6RF0UmE26lwtkXo9C9",
+	"M0kKOXdPhVYFaFtLeSMhE+7hvxo2dEn/M2vVzgKTmb33f2/XXyCxtKqYByM1CLr8VEtYudcWvttZkXF5",
+	"oNLuCqBLaqyW+ECrqgoyTKHQNMaEH7WOf5s9jAowiZaFw0iX9JoYmRcZkMZIolplNQon6FoI6Vh4dre3",
+	"IsCae8Mjnzv6JVp4AE0H6n/jhrS8pPUQURvimIlES9mB66SIy0aeQ8RqRlURFMRc0vepF8GchhVrSBuP",
+	"sCNeWIx7YcMzA4eGv1JgCCpLeJapx7gPftbuX2Tas3HTrC4Hll07gwzhuItYtRvYdAb282A/Pw+2j0RU",
+	"uMtVacjGpRZ5TGWSknQsRofngwj6lNpfav409oCLPcWLL45l9/TKNT3vH6VNSRBCNkoTIRNPpIPDh9Bx",
+	"d7tpizF3P+ny03Fwtwi3m7+4lhztnFZsOvWCVqtDY7zOAFzDBjRgAsZheyUdWS6RWxAtxj63Qmi4OREt",
+	"h9KMKAQC311PkZbkvCgkPhCOwn+QefhAGe2xORU1rXvkY2b10NXmXTvYTa6+9cFFv0oU7rUHetK1Y1LP",
+	"53pJq9WhE/cCB+3Uo4zV/mkp4tmPZEgc4ABFosowCQyL9AjAOI4gJwbkJkh8jWXupIH/+2nOLuZsfnW1",
+	"YhHNb8t8PeS4fMEuFitGN0rn3NIlFapcZ9DmGHo2JyCE7kdpUyekXwOGeS0xky5uscwNq2soAghiU5Ca",
+	"qEf0nX1YPhMlwoO0kJuedYuoYfUbrjXfud/Gclt2GSlPrNz6rof144qdOIFaSMz3rR/eeKdFHPARsuzi",
+	"Kzojg3wS/GtIyrdw0gVcCA3GP+7PRRbb55QNA5tvueW6R7qWyPUuRgw5l1mPNryJkKYqh4I/QI+61DJG",
+	"K0WfqpSCnnKwJwnajzv5d6NwPyVPapuMbnlWwrwHyg/ibIR0MYE0PkfVmo6b8CdKhXfN7HMsXUpH2WmE",
+	"Z2WOx+IeptXoYfs7zK1V459+Pj5V/DnddZDXB+4Ptkbd7iRNaLNtk/a9lGNzBoWr8HXB+AdMZYP4XTVW",
+	"7EVOjfypA2MfwvT+dSB/vD/15r6B/I3Uxr4dS16tsgm2eSrWEeW89t7TNj2uf/ThGwnxXKcV14D/s+6d",
+	"FOT/ikgBaOVGgnbZ1bQPr4My6sLmQnCTUkbn/g1l1OU3eeVeRnqKq424UQ5MJhNAA+3h0T9uPvj2Ja2z",
+	"l34AY8l70Fs/pG9Bm4B7fnl1eRWur4C8kHRJn11eXc5d+nObeofOAE2p4QK2oHc2lfhwIc3FPsJ96XyA",
+	"SD58SKUhgKJQEi2B79JYQ4wiNuWWtLFLEo5kDSTRwC0IIpHYVJp7NAUkPn3cJXbtcqdEEPdIPVztdyA3",
+	"gi7paw/w9R7fjXnXomOdbdFu7ErRWyjNutukw+XM4urqJzYy9d3heA537xtVb/KGk9uc2H2gCtE2/WI1",
+	"OoxVLie2cNKAI3e9qhkHz0DRjEKet9RPV/+cVqwpOE0iH5PUnYQrRr90p4Vp0NsBo2L1uDtFcWeeDtei",
+	"p9s871ysJvSONmZMr94dvYi3lK7mpBp+Au8zL+NRPV2C63u0PByLpp1Xf5qqqkH7qRecG15mdrya1AVj",
+	"drDKDdyzgmuem8+u5H/mQnx2NcKMltFr4kpxmCs8J1jQ9VxB1krs6n5TN7D40iNSNe88Cmf0tRB3HgKj",
+	"rQI/mEQK+p5ifGvllUnH8a0Ef1mou1Ixp91GG7ZVba08ttMaTAHG7nxrC8tlP+qcRoudBZxfWe23hp1Z",
+	"2BCryBru0ZYafUOyivCaMqyM3doohtZxPir9ddwDi6MeOGvZFxlvDoM1tqRbVW6/1GlqWGaZ28koY+P3",
+	"B07q/tgNN9cBuUTjV0oaEht1CHNxeo9HHe/3UhHeSMy6lnwQsfqJ//qZvkCdegzDtcG5W9Rmf96cU3UY",
+	"K9Xw4Kqq+nsALlc2IB8bAAA=",
 }
 
 // GetSwagger returns the Swagger specification corresponding to the generated code
@@ -70,3 +70,138 @@ func TestAdditionalProperties(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, bossSchema, obj5.AdditionalProperties["boss"])
 }
+
+func TestOneOf(t *testing.T) {
+	// Setting a member and marshaling should produce that member's JSON
+	var dst OneOfObject
+	err := dst.FromOneOfVariant1(OneOfVariant1{Name: "bob"})
+	assert.NoError(t, err)
+	buf, err := json.Marshal(dst)
+	assert.NoError(t, err)
+	assertJsonEqual(t, []byte(`{"name":"bob"}`), buf)
+
+	// Unmarshaled data can be retrieved as either member
+	err = json.Unmarshal([]byte(`"hello"`), &dst)
+	assert.NoError(t, err)
+	str, err := dst.AsOneOfObject2()
+	assert.NoError(t, err)
+	assert.Equal(t, OneOfObject2("hello"), str)
+	_, err = dst.AsOneOfVariant1()
+	assert.Error(t, err)
+
+	// Merging combines the fields of both members
+	var any AnyOfObject
+	err = any.FromOneOfVariant1(OneOfVariant1{Name: "bob"})
+	assert.NoError(t, err)
+	err = any.MergeOneOfVariant2(OneOfVariant2{Count: 7})
+	assert.NoError(t, err)
+	buf, err = json.Marshal(any)
+	assert.NoError(t, err)
+	assertJsonEqual(t, []byte(`{"name":"bob","count":7}`), buf)
+	v1, err := any.AsOneOfVariant1()
+	assert.NoError(t, err)
+	assert.Equal(t, "bob", v1.Name)
+	v2, err := any.AsOneOfVariant2()
+	assert.NoError(t, err)
+	assert.Equal(t, 7, v2.Count)
+
+	// Inline union properties round trip inside their parent object
+	const buf2 = `{"value":5,"values":[{"name":"bob"},{"count":3}]}`
+	var obj ObjectWithUnionProperty
+	err = json.Unmarshal([]byte(buf2), &obj)
+	assert.NoError(t, err)
+	num, err := obj.Value.AsObjectWithUnionPropertyValue1()
+	assert.NoError(t, err)
+	assert.Equal(t, ObjectWithUnionPropertyValue1(5), num)
+	assert.Len(t, *obj.Values, 2)
+	item, err := (*obj.Values)[1].AsOneOfVariant2()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, item.Count)
+	buf, err = json.Marshal(obj)
+	assert.NoError(t, err)
+	assertJsonEqual(t, []byte(buf2), buf)
+}
+
+func TestDiscriminator(t *testing.T) {
+	// Explicitly mapped discriminator values select their type
+	var dst DiscriminatedObject
+	err := json.Unmarshal([]byte(`{"kind":"a","name":"bob"}`), &dst)
+	assert.NoError(t, err)
+	discriminator, err := dst.Discriminator()
+	assert.NoError(t, err)
+	assert.Equal(t, "a", discriminator)
+	value, err := dst.ValueByDiscriminator()
+	assert.NoError(t, err)
+	assert.Equal(t, DiscriminatedVariantA{Kind: "a", Name: "bob"}, value)
+
+	// Unmapped schemas are selected by their schema name
+	err = json.Unmarshal([]byte(`{"kind":"DiscriminatedVariantB","count":3}`), &dst)
+	assert.NoError(t, err)
+	value, err = dst.ValueByDiscriminator()
+	assert.NoError(t, err)
+	switch v := value.(type) {
+	case DiscriminatedVariantB:
+		assert.Equal(t, 3, v.Count)
+	default:
+		t.Errorf("unexpected type %T", v)
+	}
+
+	// Unknown values are an error
+	err = json.Unmarshal([]byte(`{"kind":"c"}`), &dst)
+	assert.NoError(t, err)
+	_, err = dst.ValueByDiscriminator()
+	assert.Error(t, err)
+}
+
+func TestEnums(t *testing.T) {
+	// Values which aren't valid identifiers still get distinct constants
+	assert.Equal(t, StringEnum("with-dash"), StringEnumWithDash)
+	assert.Equal(t, StringEnum("With Dash"), StringEnumWithDash2)
+	assert.Equal(t, StringEnum("1st"), StringEnum1st)
+	assert.Equal(t, StringEnum(""), StringEnumEmpty)
+	assert.Equal(t, IntegerEnum(-1), IntegerEnumMinus1)
+	assert.Equal(t, NumberEnum(1.5), NumberEnum1Dot5)
+
+	// The names from before the constants were in CamelCase still compile,
+	// for the values which had them.
+	assert.Equal(t, StringEnumFirst, StringEnum_first)
+	assert.Equal(t, StringEnum1st, StringEnum_1st)
+
+	assert.Len(t, AllStringEnumValues(), 5)
+	for _, v := range AllIntegerEnumValues() {
+		assert.True(t, v.Valid())
+	}
+	assert.False(t, StringEnum("second").Valid())
+	assert.False(t, IntegerEnum(2).Valid())
+	assert.True(t, NumberEnumMinus2.Valid())
+
+	// Inline enums are given their own types
+	const buf = `{"status":"active","codes":[1,3]}`
+	var obj ObjectWithEnumProperties
+	err := json.Unmarshal([]byte(buf), &obj)
+	assert.NoError(t, err)
+	assert.Equal(t, ObjectWithEnumPropertiesStatusActive, obj.Status)
+	assert.True(t, obj.Status.Valid())
+	assert.True(t, (*obj.Codes)[0].Valid())
+	assert.False(t, (*obj.Codes)[1].Valid())
+}
+
+func TestStringFormats(t *testing.T) {
+	const buf = `{"id":"f81d4fae-7dec-11d0-a765-00a0c91e6bf6","email":"alex@example.com","homepage":"https://example.com","address":"10.0.0.1","avatar":"aGVsbG8="}`
+	var obj ObjectWithFormats
+	err := json.Unmarshal([]byte(buf), &obj)
+	assert.NoError(t, err)
+	assert.Equal(t, "f81d4fae-7dec-11d0-a765-00a0c91e6bf6", obj.Id.String())
+	assert.Equal(t, "example.com", obj.Homepage.Host)
+	avatar, err := obj.Avatar.Bytes()
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", string(avatar))
+
+	buf2, err := json.Marshal(obj)
+	assert.NoError(t, err)
+	assertJsonEqual(t, []byte(buf), buf2)
+
+	// Invalid values are rejected as they're decoded
+	err = json.Unmarshal([]byte(`{"id":"f81d4fae-7dec-11d0-a765-00a0c91e6bf6","email":"alex"}`), &obj)
+	assert.Error(t, err)
+}
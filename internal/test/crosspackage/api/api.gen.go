@@ -0,0 +1,111 @@
+// Package api provides primitives to interact the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen DO NOT EDIT.
+package api
+
+// Error defines model for Error.
+type Error struct {
+	Message string `json:"message"`
+}
+
+// Kind defines model for Kind.
+type Kind string
+
+// Pet defines model for Pet.
+type Pet struct {
+	Id   PetId    `json:"id"`
+	Kind Kind     `json:"kind"`
+	Name string   `json:"name"`
+	Size *PetSize `json:"size,omitempty"`
+}
+
+// PetSize defines model for Pet.size.
+type PetSize string
+
+// PetId defines model for PetId.
+type PetId int64
+
+// FindPetsParams defines parameters for FindPets.
+type FindPetsParams struct {
+	Kind  *Kind `json:"kind,omitempty"`
+	Limit *int  `json:"limit,omitempty"`
+}
+
+// AddPetJSONBody defines parameters for AddPet.
+type AddPetJSONBody struct {
+	Kind Kind   `json:"kind"`
+	Name string `json:"name"`
+}
+
+// AddPetJSONRequestBody defines body for AddPet for application/json ContentType.
+type AddPetJSONRequestBody AddPetJSONBody
+
+// List of Kind
+const (
+	KindCat Kind = "cat"
+	KindDog Kind = "dog"
+)
+
+// The names which the constants of Kind had before they were named
+// in CamelCase
+const (
+	// Deprecated: Use KindCat instead.
+	Kind_cat = KindCat
+	// Deprecated: Use KindDog instead.
+	Kind_dog = KindDog
+)
+
+// AllKindValues returns all the possible values of Kind
+func AllKindValues() []Kind {
+	return []Kind{
+		KindCat,
+		KindDog,
+	}
+}
+
+// Valid returns whether the Kind is one of the values of the enum
+func (e Kind) Valid() bool {
+	switch e {
+	case KindCat:
+		return true
+	case KindDog:
+		return true
+	default:
+		return false
+	}
+}
+
+// List of PetSize
+const (
+	PetSizeSmall PetSize = "small"
+	PetSizeLarge PetSize = "large"
+)
+
+// The names which the constants of PetSize had before they were named
+// in CamelCase
+const (
+	// Deprecated: Use PetSizeSmall instead.
+	PetSize_small = PetSizeSmall
+	// Deprecated: Use PetSizeLarge instead.
+	PetSize_large = PetSizeLarge
+)
+
+// AllPetSizeValues returns all the possible values of PetSize
+func AllPetSizeValues() []PetSize {
+	return []PetSize{
+		PetSizeSmall,
+		PetSizeLarge,
+	}
+}
+
+// Valid returns whether the PetSize is one of the values of the enum
+func (e PetSize) Valid() bool {
+	switch e {
+	case PetSizeSmall:
+		return true
+	case PetSizeLarge:
+		return true
+	default:
+		return false
+	}
+}
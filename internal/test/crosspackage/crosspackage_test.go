@@ -0,0 +1,78 @@
+package crosspackage
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/deepmap/oapi-codegen/internal/test/crosspackage/api"
+	"github.com/deepmap/oapi-codegen/internal/test/crosspackage/client"
+	"github.com/deepmap/oapi-codegen/internal/test/crosspackage/server"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type petServer struct {
+	pets []api.Pet
+}
+
+func (s *petServer) FindPets(ctx echo.Context, params api.FindPetsParams) error {
+	var pets []api.Pet
+	for _, pet := range s.pets {
+		if params.Kind == nil || pet.Kind == *params.Kind {
+			pets = append(pets, pet)
+		}
+	}
+	return ctx.JSON(http.StatusOK, pets)
+}
+
+func (s *petServer) AddPet(ctx echo.Context) error {
+	var body api.AddPetJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	pet := api.Pet{Id: api.PetId(len(s.pets) + 1), Name: body.Name, Kind: body.Kind}
+	s.pets = append(s.pets, pet)
+	return ctx.JSON(http.StatusCreated, pet)
+}
+
+func (s *petServer) GetPet(ctx echo.Context, id api.PetId) error {
+	for _, pet := range s.pets {
+		if pet.Id == id {
+			return ctx.JSON(http.StatusOK, pet)
+		}
+	}
+	return ctx.JSON(http.StatusNotFound, api.Error{Message: "no such pet"})
+}
+
+func TestCrossPackage(t *testing.T) {
+	e := echo.New()
+	server.RegisterHandlers(e, &petServer{}, "")
+	ts := httptest.NewServer(e)
+	defer ts.Close()
+
+	c, err := client.NewClientWithResponses(ts.URL)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	added, err := c.AddPetWithResponse(ctx, api.AddPetJSONRequestBody{Name: "Tom", Kind: api.KindCat})
+	require.NoError(t, err)
+	require.NotNil(t, added.JSON201)
+	assert.Equal(t, api.Pet{Id: 1, Name: "Tom", Kind: api.KindCat}, *added.JSON201)
+
+	_, err = c.AddPetWithResponse(ctx, api.AddPetJSONRequestBody{Name: "Rex", Kind: api.KindDog})
+	require.NoError(t, err)
+
+	kind := api.KindDog
+	found, err := c.FindPetsWithResponse(ctx, &api.FindPetsParams{Kind: &kind})
+	require.NoError(t, err)
+	require.NotNil(t, found.JSON200)
+	assert.Equal(t, []api.Pet{{Id: 2, Name: "Rex", Kind: api.KindDog}}, *found.JSON200)
+
+	missing, err := c.GetPetWithResponse(ctx, 3)
+	require.NoError(t, err)
+	require.NotNil(t, missing.JSONDefault)
+	assert.Equal(t, "no such pet", missing.JSONDefault.Message)
+}
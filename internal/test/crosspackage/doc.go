@@ -0,0 +1,5 @@
+package crosspackage
+
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --package=api -generate types -o api/api.gen.go crosspackage.yaml
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --package=client -generate client -types-package github.com/deepmap/oapi-codegen/internal/test/crosspackage/api -o client/client.gen.go crosspackage.yaml
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --package=server -generate server -types-package github.com/deepmap/oapi-codegen/internal/test/crosspackage/api -o server/server.gen.go crosspackage.yaml
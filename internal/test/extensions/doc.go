@@ -0,0 +1,3 @@
+package extensions
+
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --package=extensions -generate types -o extensions.gen.go extensions.yaml
@@ -0,0 +1,19 @@
+// Package extensions provides primitives to interact the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen DO NOT EDIT.
+package extensions
+
+import (
+	apitypes "github.com/deepmap/oapi-codegen/pkg/types"
+	"math/big"
+)
+
+// Amount defines model for Amount.
+type Amount = big.Float
+
+// Order defines model for legacy_order.
+type Order struct {
+	ID     string         `json:"id"`
+	Placed *apitypes.Date `json:"placed,omitempty"`
+	Total  *Amount        `json:"total,omitempty"`
+}
@@ -0,0 +1,33 @@
+package extensions
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	apitypes "github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGoTypeOverrides(t *testing.T) {
+	// Amount is an alias, so a big.Float may be used directly
+	total := big.NewFloat(12.5)
+	placed := apitypes.Date{Time: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)}
+	order := Order{
+		ID:     "1234",
+		Total:  total,
+		Placed: &placed,
+	}
+
+	buf, err := json.Marshal(order)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"id":"1234","total":"12.5","placed":"2020-01-02"}`, string(buf))
+
+	var decoded Order
+	err = json.Unmarshal(buf, &decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, "1234", decoded.ID)
+	assert.Equal(t, 0, total.Cmp(decoded.Total))
+	assert.Equal(t, placed, *decoded.Placed)
+}
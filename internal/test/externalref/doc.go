@@ -0,0 +1,4 @@
+package externalref
+
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --package=common -generate types,skip-prune -o common/common.gen.go common/common.yaml
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --package=externalref -generate types,client -import-mapping common/common.yaml=github.com/deepmap/oapi-codegen/internal/test/externalref/common -o externalref.gen.go externalref.yaml
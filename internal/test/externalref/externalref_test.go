@@ -0,0 +1,45 @@
+package externalref
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/deepmap/oapi-codegen/internal/test/externalref/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExternalRefs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var invoice Invoice
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&invoice))
+
+		w.Header().Set("Content-Type", "application/json")
+		if invoice.Total.Currency != "EUR" {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(common.Error{Code: 42, Message: "unsupported currency"})
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(invoice)
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	invoice := Invoice{Id: "1", Total: common.Money{Amount: 100, Currency: "EUR"}}
+	created, err := client.CreateInvoiceWithResponse(context.Background(), CreateInvoiceJSONRequestBody(invoice))
+	require.NoError(t, err)
+	require.NotNil(t, created.JSON201)
+	assert.Equal(t, invoice, *created.JSON201)
+
+	invoice.Total.Currency = "USD"
+	failed, err := client.CreateInvoiceWithResponse(context.Background(), CreateInvoiceJSONRequestBody(invoice))
+	require.NoError(t, err)
+	require.NotNil(t, failed.JSONDefault)
+	assert.Equal(t, common.Error{Code: 42, Message: "unsupported currency"}, *failed.JSONDefault)
+}
@@ -0,0 +1,3 @@
+package ginserver
+
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --generate=types,gin-server --package=ginserver -o ginserver.gen.go ../parameters/parameters.yaml
@@ -0,0 +1,687 @@
+// Package ginserver provides primitives to interact the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen DO NOT EDIT.
+package ginserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"net/url"
+)
+
+// ComplexObject defines model for ComplexObject.
+type ComplexObject struct {
+	Id      int    `json:"Id"`
+	IsAdmin bool   `json:"IsAdmin"`
+	Object  Object `json:"Object"`
+}
+
+// Object defines model for Object.
+type Object struct {
+	FirstName string `json:"firstName"`
+	Role      string `json:"role"`
+}
+
+// GetCookieParams defines parameters for GetCookie.
+type GetCookieParams struct {
+
+	// primitive
+	P *int32 `json:"p,omitempty"`
+
+	// primitive
+	Ep *int32 `json:"ep,omitempty"`
+
+	// exploded array
+	Ea *[]int32 `json:"ea,omitempty"`
+
+	// array
+	A *[]int32 `json:"a,omitempty"`
+
+	// exploded object
+	Eo *Object `json:"eo,omitempty"`
+
+	// object
+	O *Object `json:"o,omitempty"`
+
+	// complex object
+	Co *ComplexObject `json:"co,omitempty"`
+}
+
+// GetHeaderParams defines parameters for GetHeader.
+type GetHeaderParams struct {
+
+	// primitive
+	XPrimitive *int32 `json:"X-Primitive,omitempty"`
+
+	// primitive
+	XPrimitiveExploded *int32 `json:"X-Primitive-Exploded,omitempty"`
+
+	// exploded array
+	XArrayExploded *[]int32 `json:"X-Array-Exploded,omitempty"`
+
+	// array
+	XArray *[]int32 `json:"X-Array,omitempty"`
+
+	// exploded object
+	XObjectExploded *Object `json:"X-Object-Exploded,omitempty"`
+
+	// object
+	XObject *Object `json:"X-Object,omitempty"`
+
+	// complex object
+	XComplexObject *ComplexObject `json:"X-Complex-Object,omitempty"`
+}
+
+// GetDeepObjectParams defines parameters for GetDeepObject.
+type GetDeepObjectParams struct {
+
+	// deep object
+	DeepObj ComplexObject `json:"deepObj"`
+}
+
+// GetQueryFormParams defines parameters for GetQueryForm.
+type GetQueryFormParams struct {
+
+	// exploded array
+	Ea *[]int32 `json:"ea,omitempty"`
+
+	// array
+	A *[]int32 `json:"a,omitempty"`
+
+	// exploded object
+	Eo *Object `json:"eo,omitempty"`
+
+	// object
+	O *Object `json:"o,omitempty"`
+
+	// exploded primitive
+	Ep *int32 `json:"ep,omitempty"`
+
+	// primitive
+	P *int32 `json:"p,omitempty"`
+
+	// complex object
+	Co *ComplexObject `json:"co,omitempty"`
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /contentObject/{param})
+	GetContentObject(c *gin.Context, param ComplexObject)
+
+	// (GET /cookie)
+	GetCookie(c *gin.Context, params GetCookieParams)
+
+	// (GET /header)
+	GetHeader(c *gin.Context, params GetHeaderParams)
+
+	// (GET /labelExplodeArray/{.param*})
+	GetLabelExplodeArray(c *gin.Context, param []int32)
+
+	// (GET /labelExplodeObject/{.param*})
+	GetLabelExplodeObject(c *gin.Context, param Object)
+
+	// (GET /labelNoExplodeArray/{.param})
+	GetLabelNoExplodeArray(c *gin.Context, param []int32)
+
+	// (GET /labelNoExplodeObject/{.param})
+	GetLabelNoExplodeObject(c *gin.Context, param Object)
+
+	// (GET /matrixExplodeArray/{.id*})
+	GetMatrixExplodeArray(c *gin.Context, id []int32)
+
+	// (GET /matrixExplodeObject/{.id*})
+	GetMatrixExplodeObject(c *gin.Context, id Object)
+
+	// (GET /matrixNoExplodeArray/{.id})
+	GetMatrixNoExplodeArray(c *gin.Context, id []int32)
+
+	// (GET /matrixNoExplodeObject/{.id})
+	GetMatrixNoExplodeObject(c *gin.Context, id Object)
+
+	// (GET /passThrough/{param})
+	GetPassThrough(c *gin.Context, param string)
+
+	// (GET /queryDeepObject)
+	GetDeepObject(c *gin.Context, params GetDeepObjectParams)
+
+	// (GET /queryForm)
+	GetQueryForm(c *gin.Context, params GetQueryFormParams)
+
+	// (GET /simpleExplodeArray/{param*})
+	GetSimpleExplodeArray(c *gin.Context, param []int32)
+
+	// (GET /simpleExplodeObject/{param*})
+	GetSimpleExplodeObject(c *gin.Context, param Object)
+
+	// (GET /simpleNoExplodeArray/{param})
+	GetSimpleNoExplodeArray(c *gin.Context, param []int32)
+
+	// (GET /simpleNoExplodeObject/{param})
+	GetSimpleNoExplodeObject(c *gin.Context, param Object)
+
+	// (GET /simplePrimitive/{param})
+	GetSimplePrimitive(c *gin.Context, param int32)
+}
+
+// ServerInterfaceWrapper converts gin contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// GetContentObject converts gin context to params.
+func (siw *ServerInterfaceWrapper) GetContentObject(c *gin.Context) {
+	// ------------- Path parameter "param" -------------
+	var param ComplexObject
+
+	if err := json.Unmarshal([]byte(c.Param("param")), &param); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": "Error unmarshaling parameter 'param' as JSON"})
+		return
+	}
+
+	siw.Handler.GetContentObject(c, param)
+}
+
+// GetCookie converts gin context to params.
+func (siw *ServerInterfaceWrapper) GetCookie(c *gin.Context) {
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCookieParams
+
+	if cookie, err := c.Request.Cookie("p"); err == nil {
+
+		var value int32
+		if err := runtime.BindStyledParameter("simple", false, "p", cookie.Value, &value); err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Invalid format for parameter p: %s", err)})
+			return
+		}
+		params.P = &value
+
+	}
+
+	if cookie, err := c.Request.Cookie("ep"); err == nil {
+
+		var value int32
+		if err := runtime.BindStyledParameter("simple", true, "ep", cookie.Value, &value); err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Invalid format for parameter ep: %s", err)})
+			return
+		}
+		params.Ep = &value
+
+	}
+
+	if cookie, err := c.Request.Cookie("ea"); err == nil {
+
+		var value []int32
+		if err := runtime.BindStyledParameter("simple", true, "ea", cookie.Value, &value); err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Invalid format for parameter ea: %s", err)})
+			return
+		}
+		params.Ea = &value
+
+	}
+
+	if cookie, err := c.Request.Cookie("a"); err == nil {
+
+		var value []int32
+		if err := runtime.BindStyledParameter("simple", false, "a", cookie.Value, &value); err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Invalid format for parameter a: %s", err)})
+			return
+		}
+		params.A = &value
+
+	}
+
+	if cookie, err := c.Request.Cookie("eo"); err == nil {
+
+		var value Object
+		if err := runtime.BindStyledParameter("simple", true, "eo", cookie.Value, &value); err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Invalid format for parameter eo: %s", err)})
+			return
+		}
+		params.Eo = &value
+
+	}
+
+	if cookie, err := c.Request.Cookie("o"); err == nil {
+
+		var value Object
+		if err := runtime.BindStyledParameter("simple", false, "o", cookie.Value, &value); err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Invalid format for parameter o: %s", err)})
+			return
+		}
+		params.O = &value
+
+	}
+
+	if cookie, err := c.Request.Cookie("co"); err == nil {
+
+		var value ComplexObject
+		decoded, err := url.QueryUnescape(cookie.Value)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": "Error unescaping cookie parameter 'co'"})
+			return
+		}
+		if err := json.Unmarshal([]byte(decoded), &value); err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": "Error unmarshaling parameter 'co' as JSON"})
+			return
+		}
+		params.Co = &value
+
+	}
+
+	siw.Handler.GetCookie(c, params)
+}
+
+// GetHeader converts gin context to params.
+func (siw *ServerInterfaceWrapper) GetHeader(c *gin.Context) {
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetHeaderParams
+
+	headers := c.Request.Header
+	// ------------- Optional header parameter "X-Primitive" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Primitive")]; found {
+		var XPrimitive int32
+		n := len(valueList)
+		if n != 1 {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Expected one value for X-Primitive, got %d", n)})
+			return
+		}
+
+		if err := runtime.BindStyledParameter("simple", false, "X-Primitive", valueList[0], &XPrimitive); err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Invalid format for parameter X-Primitive: %s", err)})
+			return
+		}
+
+		params.XPrimitive = &XPrimitive
+	}
+	// ------------- Optional header parameter "X-Primitive-Exploded" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Primitive-Exploded")]; found {
+		var XPrimitiveExploded int32
+		n := len(valueList)
+		if n != 1 {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Expected one value for X-Primitive-Exploded, got %d", n)})
+			return
+		}
+
+		if err := runtime.BindStyledParameter("simple", true, "X-Primitive-Exploded", valueList[0], &XPrimitiveExploded); err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Invalid format for parameter X-Primitive-Exploded: %s", err)})
+			return
+		}
+
+		params.XPrimitiveExploded = &XPrimitiveExploded
+	}
+	// ------------- Optional header parameter "X-Array-Exploded" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Array-Exploded")]; found {
+		var XArrayExploded []int32
+		n := len(valueList)
+		if n != 1 {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Expected one value for X-Array-Exploded, got %d", n)})
+			return
+		}
+
+		if err := runtime.BindStyledParameter("simple", true, "X-Array-Exploded", valueList[0], &XArrayExploded); err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Invalid format for parameter X-Array-Exploded: %s", err)})
+			return
+		}
+
+		params.XArrayExploded = &XArrayExploded
+	}
+	// ------------- Optional header parameter "X-Array" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Array")]; found {
+		var XArray []int32
+		n := len(valueList)
+		if n != 1 {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Expected one value for X-Array, got %d", n)})
+			return
+		}
+
+		if err := runtime.BindStyledParameter("simple", false, "X-Array", valueList[0], &XArray); err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Invalid format for parameter X-Array: %s", err)})
+			return
+		}
+
+		params.XArray = &XArray
+	}
+	// ------------- Optional header parameter "X-Object-Exploded" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Object-Exploded")]; found {
+		var XObjectExploded Object
+		n := len(valueList)
+		if n != 1 {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Expected one value for X-Object-Exploded, got %d", n)})
+			return
+		}
+
+		if err := runtime.BindStyledParameter("simple", true, "X-Object-Exploded", valueList[0], &XObjectExploded); err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Invalid format for parameter X-Object-Exploded: %s", err)})
+			return
+		}
+
+		params.XObjectExploded = &XObjectExploded
+	}
+	// ------------- Optional header parameter "X-Object" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Object")]; found {
+		var XObject Object
+		n := len(valueList)
+		if n != 1 {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Expected one value for X-Object, got %d", n)})
+			return
+		}
+
+		if err := runtime.BindStyledParameter("simple", false, "X-Object", valueList[0], &XObject); err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Invalid format for parameter X-Object: %s", err)})
+			return
+		}
+
+		params.XObject = &XObject
+	}
+	// ------------- Optional header parameter "X-Complex-Object" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Complex-Object")]; found {
+		var XComplexObject ComplexObject
+		n := len(valueList)
+		if n != 1 {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Expected one value for X-Complex-Object, got %d", n)})
+			return
+		}
+
+		if err := json.Unmarshal([]byte(valueList[0]), &XComplexObject); err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": "Error unmarshaling parameter 'X-Complex-Object' as JSON"})
+			return
+		}
+
+		params.XComplexObject = &XComplexObject
+	}
+
+	siw.Handler.GetHeader(c, params)
+}
+
+// GetLabelExplodeArray converts gin context to params.
+func (siw *ServerInterfaceWrapper) GetLabelExplodeArray(c *gin.Context) {
+	// ------------- Path parameter "param" -------------
+	var param []int32
+
+	if err := runtime.BindStyledParameter("label", true, "param", c.Param("param"), &param); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Invalid format for parameter param: %s", err)})
+		return
+	}
+
+	siw.Handler.GetLabelExplodeArray(c, param)
+}
+
+// GetLabelExplodeObject converts gin context to params.
+func (siw *ServerInterfaceWrapper) GetLabelExplodeObject(c *gin.Context) {
+	// ------------- Path parameter "param" -------------
+	var param Object
+
+	if err := runtime.BindStyledParameter("label", true, "param", c.Param("param"), &param); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Invalid format for parameter param: %s", err)})
+		return
+	}
+
+	siw.Handler.GetLabelExplodeObject(c, param)
+}
+
+// GetLabelNoExplodeArray converts gin context to params.
+func (siw *ServerInterfaceWrapper) GetLabelNoExplodeArray(c *gin.Context) {
+	// ------------- Path parameter "param" -------------
+	var param []int32
+
+	if err := runtime.BindStyledParameter("label", false, "param", c.Param("param"), &param); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Invalid format for parameter param: %s", err)})
+		return
+	}
+
+	siw.Handler.GetLabelNoExplodeArray(c, param)
+}
+
+// GetLabelNoExplodeObject converts gin context to params.
+func (siw *ServerInterfaceWrapper) GetLabelNoExplodeObject(c *gin.Context) {
+	// ------------- Path parameter "param" -------------
+	var param Object
+
+	if err := runtime.BindStyledParameter("label", false, "param", c.Param("param"), &param); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Invalid format for parameter param: %s", err)})
+		return
+	}
+
+	siw.Handler.GetLabelNoExplodeObject(c, param)
+}
+
+// GetMatrixExplodeArray converts gin context to params.
+func (siw *ServerInterfaceWrapper) GetMatrixExplodeArray(c *gin.Context) {
+	// ------------- Path parameter "id" -------------
+	var id []int32
+
+	if err := runtime.BindStyledParameter("matrix", true, "id", c.Param("id"), &id); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Invalid format for parameter id: %s", err)})
+		return
+	}
+
+	siw.Handler.GetMatrixExplodeArray(c, id)
+}
+
+// GetMatrixExplodeObject converts gin context to params.
+func (siw *ServerInterfaceWrapper) GetMatrixExplodeObject(c *gin.Context) {
+	// ------------- Path parameter "id" -------------
+	var id Object
+
+	if err := runtime.BindStyledParameter("matrix", true, "id", c.Param("id"), &id); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Invalid format for parameter id: %s", err)})
+		return
+	}
+
+	siw.Handler.GetMatrixExplodeObject(c, id)
+}
+
+// GetMatrixNoExplodeArray converts gin context to params.
+func (siw *ServerInterfaceWrapper) GetMatrixNoExplodeArray(c *gin.Context) {
+	// ------------- Path parameter "id" -------------
+	var id []int32
+
+	if err := runtime.BindStyledParameter("matrix", false, "id", c.Param("id"), &id); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Invalid format for parameter id: %s", err)})
+		return
+	}
+
+	siw.Handler.GetMatrixNoExplodeArray(c, id)
+}
+
+// GetMatrixNoExplodeObject converts gin context to params.
+func (siw *ServerInterfaceWrapper) GetMatrixNoExplodeObject(c *gin.Context) {
+	// ------------- Path parameter "id" -------------
+	var id Object
+
+	if err := runtime.BindStyledParameter("matrix", false, "id", c.Param("id"), &id); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Invalid format for parameter id: %s", err)})
+		return
+	}
+
+	siw.Handler.GetMatrixNoExplodeObject(c, id)
+}
+
+// GetPassThrough converts gin context to params.
+func (siw *ServerInterfaceWrapper) GetPassThrough(c *gin.Context) {
+	// ------------- Path parameter "param" -------------
+	var param string
+
+	param = c.Param("param")
+
+	siw.Handler.GetPassThrough(c, param)
+}
+
+// GetDeepObject converts gin context to params.
+func (siw *ServerInterfaceWrapper) GetDeepObject(c *gin.Context) {
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDeepObjectParams
+	// ------------- Required query parameter "deepObj" -------------
+
+	if err := runtime.BindQueryParameter("deepObject", true, true, "deepObj", c.Request.URL.Query(), &params.DeepObj); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Invalid format for parameter deepObj: %s", err)})
+		return
+	}
+
+	siw.Handler.GetDeepObject(c, params)
+}
+
+// GetQueryForm converts gin context to params.
+func (siw *ServerInterfaceWrapper) GetQueryForm(c *gin.Context) {
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetQueryFormParams
+	// ------------- Optional query parameter "ea" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "ea", c.Request.URL.Query(), &params.Ea); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Invalid format for parameter ea: %s", err)})
+		return
+	}
+
+	// ------------- Optional query parameter "a" -------------
+
+	if err := runtime.BindQueryParameter("form", false, false, "a", c.Request.URL.Query(), &params.A); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Invalid format for parameter a: %s", err)})
+		return
+	}
+
+	// ------------- Optional query parameter "eo" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "eo", c.Request.URL.Query(), &params.Eo); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Invalid format for parameter eo: %s", err)})
+		return
+	}
+
+	// ------------- Optional query parameter "o" -------------
+
+	if err := runtime.BindQueryParameter("form", false, false, "o", c.Request.URL.Query(), &params.O); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Invalid format for parameter o: %s", err)})
+		return
+	}
+
+	// ------------- Optional query parameter "ep" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "ep", c.Request.URL.Query(), &params.Ep); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Invalid format for parameter ep: %s", err)})
+		return
+	}
+
+	// ------------- Optional query parameter "p" -------------
+
+	if err := runtime.BindQueryParameter("form", false, false, "p", c.Request.URL.Query(), &params.P); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Invalid format for parameter p: %s", err)})
+		return
+	}
+
+	// ------------- Optional query parameter "co" -------------
+
+	if paramValue := c.Request.URL.Query().Get("co"); paramValue != "" {
+
+		var value ComplexObject
+		if err := json.Unmarshal([]byte(paramValue), &value); err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": "Error unmarshaling parameter 'co' as JSON"})
+			return
+		}
+		params.Co = &value
+
+	}
+
+	siw.Handler.GetQueryForm(c, params)
+}
+
+// GetSimpleExplodeArray converts gin context to params.
+func (siw *ServerInterfaceWrapper) GetSimpleExplodeArray(c *gin.Context) {
+	// ------------- Path parameter "param" -------------
+	var param []int32
+
+	if err := runtime.BindStyledParameter("simple", true, "param", c.Param("param"), &param); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Invalid format for parameter param: %s", err)})
+		return
+	}
+
+	siw.Handler.GetSimpleExplodeArray(c, param)
+}
+
+// GetSimpleExplodeObject converts gin context to params.
+func (siw *ServerInterfaceWrapper) GetSimpleExplodeObject(c *gin.Context) {
+	// ------------- Path parameter "param" -------------
+	var param Object
+
+	if err := runtime.BindStyledParameter("simple", true, "param", c.Param("param"), &param); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Invalid format for parameter param: %s", err)})
+		return
+	}
+
+	siw.Handler.GetSimpleExplodeObject(c, param)
+}
+
+// GetSimpleNoExplodeArray converts gin context to params.
+func (siw *ServerInterfaceWrapper) GetSimpleNoExplodeArray(c *gin.Context) {
+	// ------------- Path parameter "param" -------------
+	var param []int32
+
+	if err := runtime.BindStyledParameter("simple", false, "param", c.Param("param"), &param); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Invalid format for parameter param: %s", err)})
+		return
+	}
+
+	siw.Handler.GetSimpleNoExplodeArray(c, param)
+}
+
+// GetSimpleNoExplodeObject converts gin context to params.
+func (siw *ServerInterfaceWrapper) GetSimpleNoExplodeObject(c *gin.Context) {
+	// ------------- Path parameter "param" -------------
+	var param Object
+
+	if err := runtime.BindStyledParameter("simple", false, "param", c.Param("param"), &param); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Invalid format for parameter param: %s", err)})
+		return
+	}
+
+	siw.Handler.GetSimpleNoExplodeObject(c, param)
+}
+
+// GetSimplePrimitive converts gin context to params.
+func (siw *ServerInterfaceWrapper) GetSimplePrimitive(c *gin.Context) {
+	// ------------- Path parameter "param" -------------
+	var param int32
+
+	if err := runtime.BindStyledParameter("simple", false, "param", c.Param("param"), &param); err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Invalid format for parameter param: %s", err)})
+		return
+	}
+
+	siw.Handler.GetSimplePrimitive(c, param)
+}
+
+// RegisterHandlers adds each server route to the gin router.
+func RegisterHandlers(router gin.IRoutes, si ServerInterface) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.Handle("GET", "/contentObject/:param", wrapper.GetContentObject)
+	router.Handle("GET", "/cookie", wrapper.GetCookie)
+	router.Handle("GET", "/header", wrapper.GetHeader)
+	router.Handle("GET", "/labelExplodeArray/:param", wrapper.GetLabelExplodeArray)
+	router.Handle("GET", "/labelExplodeObject/:param", wrapper.GetLabelExplodeObject)
+	router.Handle("GET", "/labelNoExplodeArray/:param", wrapper.GetLabelNoExplodeArray)
+	router.Handle("GET", "/labelNoExplodeObject/:param", wrapper.GetLabelNoExplodeObject)
+	router.Handle("GET", "/matrixExplodeArray/:id", wrapper.GetMatrixExplodeArray)
+	router.Handle("GET", "/matrixExplodeObject/:id", wrapper.GetMatrixExplodeObject)
+	router.Handle("GET", "/matrixNoExplodeArray/:id", wrapper.GetMatrixNoExplodeArray)
+	router.Handle("GET", "/matrixNoExplodeObject/:id", wrapper.GetMatrixNoExplodeObject)
+	router.Handle("GET", "/passThrough/:param", wrapper.GetPassThrough)
+	router.Handle("GET", "/queryDeepObject", wrapper.GetDeepObject)
+	router.Handle("GET", "/queryForm", wrapper.GetQueryForm)
+	router.Handle("GET", "/simpleExplodeArray/:param", wrapper.GetSimpleExplodeArray)
+	router.Handle("GET", "/simpleExplodeObject/:param", wrapper.GetSimpleExplodeObject)
+	router.Handle("GET", "/simpleNoExplodeArray/:param", wrapper.GetSimpleNoExplodeArray)
+	router.Handle("GET", "/simpleNoExplodeObject/:param", wrapper.GetSimpleNoExplodeObject)
+	router.Handle("GET", "/simplePrimitive/:param", wrapper.GetSimplePrimitive)
+
+}
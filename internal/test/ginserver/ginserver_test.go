@@ -0,0 +1,95 @@
+package ginserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/deepmap/oapi-codegen/internal/test/servertest"
+)
+
+type testServer struct {
+	ServerInterface
+	servertest.Recorder
+}
+
+func (t *testServer) GetLabelExplodeArray(c *gin.Context, param []int32) {
+	t.Record("GetLabelExplodeArray", param)
+}
+
+func (t *testServer) GetSimpleExplodeObject(c *gin.Context, param Object) {
+	t.Record("GetSimpleExplodeObject", param)
+}
+
+func (t *testServer) GetPassThrough(c *gin.Context, param string) {
+	t.Record("GetPassThrough", param)
+	// The handler is given the context which the middleware ran with.
+	t.Record("user", c.GetString("user"))
+}
+
+func (t *testServer) GetCookie(c *gin.Context, params GetCookieParams) {
+	t.Record("GetCookie", params)
+}
+
+func (t *testServer) GetHeader(c *gin.Context, params GetHeaderParams) {
+	t.Record("GetHeader", params)
+	c.Status(http.StatusAccepted)
+}
+
+func (t *testServer) GetQueryForm(c *gin.Context, params GetQueryFormParams) {
+	t.Record("GetQueryForm", params)
+}
+
+func TestGinServer(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	var ts testServer
+	h := gin.New()
+	RegisterHandlers(h, &ts)
+	servertest.Run(t, h, &ts.Recorder)
+
+	// Unlike the other targets, gin doesn't tell the method of a request
+	// apart from its path, unless it's asked to.
+	rr := servertest.Do(h, httptest.NewRequest("POST", "/passThrough/x", nil))
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+
+	h.HandleMethodNotAllowed = true
+	rr = servertest.Do(h, httptest.NewRequest("POST", "/passThrough/x", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rr.Code)
+}
+
+func TestGinServerContext(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	var ts testServer
+	var aborted bool
+	h := gin.New()
+	h.Use(func(c *gin.Context) {
+		c.Set("user", "alex")
+		c.Next()
+		aborted = c.IsAborted()
+	})
+	RegisterHandlers(h, &ts)
+
+	rr := servertest.Do(h, httptest.NewRequest("GET", "/passThrough/x", nil))
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.False(t, aborted)
+	user, _ := ts.Params("user")
+	assert.Equal(t, "alex", user)
+
+	// Parameters which can't be bound abort the context, with the error as
+	// a JSON message.
+	rr = servertest.Do(h, httptest.NewRequest("GET", "/labelExplodeArray/.3.x", nil))
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.True(t, aborted)
+	assert.Equal(t, "application/json; charset=utf-8", rr.Header().Get("Content-Type"))
+	var body struct {
+		Message string `json:"message"`
+	}
+	assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &body))
+	assert.Contains(t, body.Message, "Invalid format for parameter param")
+	_, called := ts.Params("GetLabelExplodeArray")
+	assert.False(t, called)
+}
@@ -0,0 +1,3 @@
+package gorillaserver
+
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --generate=types,gorilla-server --package=gorillaserver -o gorillaserver.gen.go ../parameters/parameters.yaml
@@ -0,0 +1,91 @@
+package gorillaserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/deepmap/oapi-codegen/internal/test/servertest"
+)
+
+type testServer struct {
+	ServerInterface
+	servertest.Recorder
+}
+
+func (t *testServer) GetLabelExplodeArray(w http.ResponseWriter, r *http.Request, param []int32) {
+	t.Record("GetLabelExplodeArray", param)
+}
+
+func (t *testServer) GetSimpleExplodeObject(w http.ResponseWriter, r *http.Request, param Object) {
+	t.Record("GetSimpleExplodeObject", param)
+}
+
+func (t *testServer) GetPassThrough(w http.ResponseWriter, r *http.Request, param string) {
+	t.Record("GetPassThrough", param)
+}
+
+func (t *testServer) GetCookie(w http.ResponseWriter, r *http.Request, params GetCookieParams) {
+	t.Record("GetCookie", params)
+}
+
+func (t *testServer) GetHeader(w http.ResponseWriter, r *http.Request, params GetHeaderParams) {
+	t.Record("GetHeader", params)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (t *testServer) GetQueryForm(w http.ResponseWriter, r *http.Request, params GetQueryFormParams) {
+	t.Record("GetQueryForm", params)
+}
+
+func TestGorillaServer(t *testing.T) {
+	var ts testServer
+	h := Handler(&ts)
+	servertest.Run(t, h, &ts.Recorder)
+
+	rr := servertest.Do(h, httptest.NewRequest("POST", "/passThrough/x", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rr.Code)
+
+	// A variable matches a single segment of the path.
+	rr = servertest.Do(h, httptest.NewRequest("GET", "/passThrough/x/y", nil))
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestGorillaServerVars(t *testing.T) {
+	// The wrappers take path parameters from the variables which gorilla
+	// matched, rather than from the path itself.
+	var ts testServer
+	wrapper := ServerInterfaceWrapper{Handler: &ts}
+	req := httptest.NewRequest("GET", "/somewhere/else", nil)
+	req = mux.SetURLVars(req, map[string]string{"param": ".3.4"})
+	rr := httptest.NewRecorder()
+	wrapper.GetLabelExplodeArray(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	param, _ := ts.Params("GetLabelExplodeArray")
+	assert.Equal(t, []int32{3, 4}, param)
+}
+
+func TestGorillaServerFromMux(t *testing.T) {
+	// The routes can be added to a subrouter, alongside handlers of its own.
+	r := mux.NewRouter()
+	api := r.PathPrefix("/api").Subrouter()
+	api.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}).Methods("GET")
+	var ts testServer
+	HandlerFromMux(&ts, api)
+
+	rr := servertest.Do(r, httptest.NewRequest("GET", "/api/health", nil))
+	assert.Equal(t, http.StatusNoContent, rr.Code)
+
+	rr = servertest.Do(r, httptest.NewRequest("GET", "/api/passThrough/x", nil))
+	assert.Equal(t, http.StatusOK, rr.Code)
+	param, _ := ts.Params("GetPassThrough")
+	assert.Equal(t, "x", param)
+
+	rr = servertest.Do(r, httptest.NewRequest("GET", "/passThrough/x", nil))
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
@@ -10,12 +10,14 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"path"
 	"strings"
 )
 
@@ -27,7 +29,7 @@ type Document struct {
 	Fields *Document_Fields `json:"fields,omitempty"`
 }
 
-// Document_Fields defines model for Document.Fields.
+// Document_Fields defines model for Document.fields.
 type Document_Fields struct {
 	AdditionalProperties map[string]Value `json:"-"`
 }
@@ -114,6 +116,10 @@ type Client struct {
 	// A callback for modifying requests which are generated before sending over
 	// the network.
 	RequestEditor RequestEditorFn
+
+	// The decoders of response bodies, by media type. When nil,
+	// runtime.DefaultResponseDecoders is used.
+	ResponseDecoders *runtime.ResponseDecoders
 }
 
 // ClientOption allows setting custom parameters during construction
@@ -160,6 +166,19 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 	}
 }
 
+// WithResponseDecoder registers the decoder of the response bodies of a media
+// type, such as application/x-protobuf, for this client only. Registering the
+// decoder of application/cbor decodes the media types with a +cbor suffix too.
+func WithResponseDecoder(mediaType string, decoder runtime.ResponseDecoder) ClientOption {
+	return func(c *Client) error {
+		if c.ResponseDecoders == nil {
+			c.ResponseDecoders = runtime.DefaultResponseDecoders.Clone()
+		}
+		c.ResponseDecoders.Register(mediaType, decoder)
+		return nil
+	}
+}
+
 // The interface specification for the client above.
 type ClientInterface interface {
 	// ExampleGet request
@@ -175,6 +194,11 @@ func (c *Client) ExampleGet(ctx context.Context) (*http.Response, error) {
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -185,7 +209,8 @@ func (c *Client) ExampleGet(ctx context.Context) (*http.Response, error) {
 func NewExampleGetRequest(server string) (*http.Request, error) {
 	var err error
 
-	queryUrl, err := url.Parse(server)
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
@@ -200,7 +225,8 @@ func NewExampleGetRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	var req *http.Request
+	req, err = http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -211,6 +237,10 @@ func NewExampleGetRequest(server string) (*http.Request, error) {
 // ClientWithResponses builds on ClientInterface to offer response payloads
 type ClientWithResponses struct {
 	ClientInterface
+
+	// The decoders of response bodies, from the ResponseDecoders of the
+	// Client.
+	decoders *runtime.ResponseDecoders
 }
 
 // NewClientWithResponses creates a new ClientWithResponses, which wraps
@@ -220,7 +250,7 @@ func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithRes
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+	return &ClientWithResponses{ClientInterface: client, decoders: client.ResponseDecoders}, nil
 }
 
 // WithBaseURL overrides the baseURL.
@@ -269,11 +299,20 @@ func (c *ClientWithResponses) ExampleGetWithResponse(ctx context.Context) (*Exam
 	if err != nil {
 		return nil, err
 	}
-	return ParseExampleGetResponse(rsp)
+	return ParseExampleGetResponseWithDecoders(rsp, c.decoders)
 }
 
 // ParseExampleGetResponse parses an HTTP response from a ExampleGetWithResponse call
 func ParseExampleGetResponse(rsp *http.Response) (*ExampleGetResponse, error) {
+	return ParseExampleGetResponseWithDecoders(rsp, nil)
+}
+
+// ParseExampleGetResponseWithDecoders parses an HTTP response from a ExampleGetWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseExampleGetResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*ExampleGetResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
@@ -285,13 +324,19 @@ func ParseExampleGetResponse(rsp *http.Response) (*ExampleGetResponse, error) {
 		HTTPResponse: rsp,
 	}
 
+	contentType := rsp.Header.Get("Content-Type")
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Document
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	case runtime.MediaTypeMatches(contentType, "application/json") && rsp.StatusCode == 200:
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest Document
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.JSON200 = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
 		}
-		response.JSON200 = &dest
 
 	}
 
@@ -335,25 +380,25 @@ type EchoRouter interface {
 }
 
 // RegisterHandlers adds each server route to the EchoRouter.
-func RegisterHandlers(router EchoRouter, si ServerInterface) {
+func RegisterHandlers(router EchoRouter, si ServerInterface, pathPrefix string) {
 
 	wrapper := ServerInterfaceWrapper{
 		Handler: si,
 	}
 
-	router.GET("/example", wrapper.ExampleGet)
+	router.GET(path.Join(pathPrefix, "/example"), wrapper.ExampleGet)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/5RSQU/zMAz9K5W/71i1ZdxyQwIhhBCcOHExibdmpEmUuBPT1P+OnG5jEwjEqcmr3/Pz",
-	"i3egwxCDJ88Z1A6y7mnAcrxKCbfP6EaSm2UaCvw/0RIU/Gs/ie2e1c7VUw28jQQKUCTkfh30OJBnEYgp",
-	"REpsqcgtLTlTTmiMZRs8uqezir80DK9r0gzTV6SG4yjnBvBszJ+anQQy1ZA5Wb86EvftZvQ7AwJZvwxS",
+	"H4sIAAAAAAAC/5RSzU7zMBB8lWi/7xglodx8QwIhhBCcOHFZ7G3j4tiWvamoqrw7Wqf0RyAQp9iTndnZ",
+	"8e5AhyEGT54zqB1k3dOA5XiVEm6f0Y0kN8s0FPh/oiUo+Nceie2e1c7VUw28jQQKUCTkfh30OJBnEYgp",
+	"REpsqcgtLTlTTmiMZRs8uqezir80DK9r0gzTV6SGwyjnBvBszJ+anQQy1ZA5Wb86EPftZvQ7AwJZvwxS",
 	"bChnnWyUcUHBA75RlcdEFffIVSI9pmw3VIlErjBR1aM3jkw1e3fbFw81sGUnLegdh+gIathQyrNm13TN",
-	"hfgMkTxGCwoum65ZQA0RuS+jtwei2sGKyuOIOoqtOwMKbub/t8RQQ6Icg89zaouuk48OnvfPijE6qwu3",
-	"XWfxcNim33I9LkfJyNBpNI/3gk7T9BEAAP//t/QkwqkCAAA=",
+	"hfgMkTxGCwoum65ZQA0RuS+jt59EtYMVlccRdRRbdwYU3Mz/b4mhhkQ5Bp/n1BZdJx8dPO+fFWN0Vhdu",
+	"u87BH7fpt1wPy1EyMnQazeO9oNM0fQwAt/QkwqkCAAA=",
 }
 
 // GetSwagger returns the Swagger specification corresponding to the generated code
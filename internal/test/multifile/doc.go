@@ -0,0 +1,3 @@
+package multifile
+
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --package=multifile -generate types,client,server,spec -output-dir . ../../../examples/petstore-expanded/petstore-expanded.yaml
@@ -0,0 +1,69 @@
+// Code generated by github.com/deepmap/oapi-codegen DO NOT EDIT.
+
+package multifile
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"github.com/getkin/kin-openapi/openapi3"
+	"strings"
+)
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/+RXW28bydH9K4X+vsfJULGNfeBTtJYXIJC1lWg3L2s9lHqKZC36pu5qyoTB/x5Uz/Am",
+	"ytosEgQJ8sLLTNf0qXNOVdd8NTb6FAMFKWb+1RS7Jo/t54ecY9YfKcdEWZjaZRsH0u+Bis2chGMw83Ex",
+	"tHudWcbsUczccJC3b0xnZJto/EsrymbXGU+l4OqbD9rfPoQWyRxWZrfrTKbHypkGM//FTBvul9/vOvOR",
+	"nm5JLnEH9C9s9xE9QVyCrAkSyeWGnRFcXcb9tE2vxz0D2nZXeBM2dO7T0sx/+Wr+P9PSzM3/zY5CzCYV",
+	"ZlMuu+55MjxcQvo58GMl4OEc16kY3717QYxnSHkw97v7nV7msIyj5EHQNtzkkZ2ZG0wshP5P5QlXK8o9",
+	"R9NNFJu78Rpc3y7gJ0JvOlOzBq1F0nw2O4nZdc+SuIaCPjlqwbJGgVqoAGoyRWImwAIYgL6MyyTCQD6G",
+	"IhmFYEkoNVMBDo2CT4mCPultfwUlkeUlW2xbdcaxpVDo6A1zndCuCd70V2eQy3w2e3p66rHd7mNezabY",
+	"Mvvz4v2Hj3cf/vCmv+rX4l0zDGVfPi3vKG/Y0kt5z9qSmYrB4k45u53SNJ3ZUC4jKX/sr/orfXJMFDCx",
+	"mZu37VJnEsq6OWKmBOmP1Wiwc1r/SlJzKIDONSZhmaNvDJVtEfIj1fq/FsqwVpKtpVJA4ufwET0UGsDG",
+	"MLCnINUDFenhRyRLAQsI+RQzFFyxCBcomJhCB4Es5HUMthYo5E8WsAB6kh6uKRAGQIFVxg0PCFhXlTpA",
+	"C4y2Om6hPbyvGR9YaoY4cAQXM/kOYg6YCWhFAuRoQhfIdmBrLrVoQTiyUksPN5ULeAapOXHpIFW34YBZ",
+	"96IcNekOhIPloQaBDWauBX6tRWIPiwBrtLBWEFgKQXIohDCwleqVjsVYUpoLDpy4WA4rwCCazTF3x6vq",
+	"8JB5WmMmybgnUdeDj46KMAH7RHlgZepvvEE/JoSOHyt6GBiVmYwFHjW3DTkWCDGAxCwxKyW8pDAcdu/h",
+	"NiMVCqIwKbA/Aqg5IGyiq5JQYEOBAirgkVz98FizPmMRjk9eUp5YX6Jlx+Vsk7aDfnRHfS2UOKAjFXbo",
+	"lEdLGUUT0+8e7mpJFAZWlh2qeYboYu7UgYWsqJtbls0qmnUHG1qzrQ6Bg1AeqgfHD5RjDz/G/MBAlYuP",
+	"w6kMersZ26HlwNh/Dp/DHQ1NiVpgSWo+Fx9ibgEUj47JVXL1PWhteBQ5ks/FdUD1rFpGycFV9aG6s4fb",
+	"NRZybiyMRHkKbzQ3eUlgidXyQx0Jx/0+uu40fkNuko43lDN251trnQAP3aEQAz+se/hZIJFzFISKnhsp",
+	"lkqZjkXUg1KB+yrQottzuX/SPq3GZNeAHGwRarAgmYu0Y2nDgtTDD7VYApLWDYbKhyrQTlEsOcrc4Iz+",
+	"3Qd4dUvFZh5bfcEAHleaMrlJrR7+UsdQH53jvXpUR+8coXSH5gNYrRbJuHKy55j2ZI6pyRyqUc2iAgOH",
+	"7ghlKtzAhfeAi2KwLHVghVoKQpW9zyYhx53OSGv79XB7KkxjbsKYMglXf9K5RtPU7sTf2nr7z3rExaT1",
+	"xDEsBjM3P3AY9Hxpx0ZWAiiXNoOcHxaCK+37sGQnlOFha3QUMHPzWClvj+e8rjPdNDK2qUTItzPocoYa",
+	"L2DOuNX/Rbbt2NPhpI035wg8fmGvbbz6B8o6z2Qq1UmDldtZ9g1Mjj3LGajfHEZ3953JVJK2lob+zdXV",
+	"fuqhME5rKblpcJj9WmI4Tspnab82yo1z3DMidhfzTyKBPZhxOlpidfK78LwGYxzqX9i4BvqSyAppDx7X",
+	"dKZU7zFvXxggFFuK5YVR430mlDayBXrStftZrM01egaP2HVJJn1gfKLhwqzXg3rVjLMpFfk+Dtt/GQv7",
+	"ufqShlsS9RgOg34dYJvTGVlypd0/6ZnftMp/jzUuBG/32zw6+8rDbrSII3nh9Wu8rrGFw8q1dxZ4QG2z",
+	"cXTN4gZK1Zxe8MhNix5t8mpHW9xoD0mjthOWqX/oAH1sHzxcKP2tXvLdu3+sl7y7zFqBjCiG/yQhbw5i",
+	"NBW2sLhReK+/UJwrdtBxcfOt4+f77WL4XXotSez63ybX/2wZP1N0VL8tobzZy3T2Hr9/Je9PXmwxsdnd",
+	"7/4+AL+Kpl9XEgAA",
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file.
+func GetSwagger() (*openapi3.Swagger, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %s", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %s", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %s", err)
+	}
+
+	swagger, err := openapi3.NewSwaggerLoader().LoadSwaggerFromData(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("error loading Swagger: %s", err)
+	}
+	return swagger, nil
+}
@@ -0,0 +1,3 @@
+package nullable
+
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --package=nullable -generate types,nullable-types -o nullable.gen.go nullable.yaml
@@ -0,0 +1,424 @@
+// Package nullable provides primitives to interact the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen DO NOT EDIT.
+package nullable
+
+import (
+	"encoding/json"
+	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
+)
+
+// Address defines model for Address.
+type Address struct {
+	Street *string `json:"street,omitempty"`
+}
+
+// Patient defines model for Patient.
+type Patient struct {
+	BirthDate  openapi_types.NullableDate    `json:"birthDate,omitempty"`
+	MiddleName openapi_types.NullableString  `json:"middleName"`
+	Name       string                        `json:"name"`
+	Weight     openapi_types.NullableFloat64 `json:"weight,omitempty"`
+}
+
+// PatientPatch defines model for PatientPatch.
+type PatientPatch struct {
+	Address    NullableAddress              `json:"address,omitempty"`
+	Age        openapi_types.NullableInt    `json:"age,omitempty"`
+	Contact    NullablePatientPatchContact  `json:"contact,omitempty"`
+	MiddleName openapi_types.NullableString `json:"middleName,omitempty"`
+	Status     NullablePatientPatchStatus   `json:"status,omitempty"`
+	Tags       NullablePatientPatchTags     `json:"tags,omitempty"`
+}
+
+// PatientPatchContact defines model for PatientPatch.contact.
+type PatientPatchContact struct {
+	Email *string `json:"email,omitempty"`
+}
+
+// PatientPatchStatus defines model for PatientPatch.status.
+type PatientPatchStatus string
+
+// PatientPatchTags defines model for PatientPatch.tags.
+type PatientPatchTags []string
+
+// PatchPatientJSONBody defines parameters for PatchPatient.
+type PatchPatientJSONBody PatientPatch
+
+// PatchPatientJSONRequestBody defines body for PatchPatient for application/json ContentType.
+type PatchPatientJSONRequestBody PatchPatientJSONBody
+
+// List of PatientPatchStatus
+const (
+	PatientPatchStatusActive   PatientPatchStatus = "active"
+	PatientPatchStatusInactive PatientPatchStatus = "inactive"
+)
+
+// The names which the constants of PatientPatchStatus had before they were named
+// in CamelCase
+const (
+	// Deprecated: Use PatientPatchStatusActive instead.
+	PatientPatchStatus_active = PatientPatchStatusActive
+	// Deprecated: Use PatientPatchStatusInactive instead.
+	PatientPatchStatus_inactive = PatientPatchStatusInactive
+)
+
+// AllPatientPatchStatusValues returns all the possible values of PatientPatchStatus
+func AllPatientPatchStatusValues() []PatientPatchStatus {
+	return []PatientPatchStatus{
+		PatientPatchStatusActive,
+		PatientPatchStatusInactive,
+	}
+}
+
+// Valid returns whether the PatientPatchStatus is one of the values of the enum
+func (e PatientPatchStatus) Valid() bool {
+	switch e {
+	case PatientPatchStatusActive:
+		return true
+	case PatientPatchStatusInactive:
+		return true
+	default:
+		return false
+	}
+}
+
+// NullableAddress is a Nullable Address, which may also be null or unspecified.
+// See openapi_types.NullableString for details.
+type NullableAddress map[bool]Address
+
+// NewNullableAddress returns a NullableAddress which is set to the given value.
+func NewNullableAddress(v Address) NullableAddress {
+	return NullableAddress{true: v}
+}
+
+// NewNullNullableAddress returns a NullableAddress which is explicitly null.
+func NewNullNullableAddress() NullableAddress {
+	var zero Address
+	return NullableAddress{false: zero}
+}
+
+// Get returns the value, or an error when it's null or unspecified.
+func (t NullableAddress) Get() (Address, error) {
+	var zero Address
+	if t.IsNull() {
+		return zero, openapi_types.ErrNullableIsNull
+	}
+	if !t.IsSpecified() {
+		return zero, openapi_types.ErrNullableNotSpecified
+	}
+	return t[true], nil
+}
+
+// MustGet returns the value, and panics when it's null or unspecified.
+func (t NullableAddress) MustGet() Address {
+	v, err := t.Get()
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Set sets the value.
+func (t *NullableAddress) Set(v Address) {
+	*t = NullableAddress{true: v}
+}
+
+// IsNull returns whether the value is explicitly null.
+func (t NullableAddress) IsNull() bool {
+	_, found := t[false]
+	return found
+}
+
+// SetNull makes the value explicitly null.
+func (t *NullableAddress) SetNull() {
+	var zero Address
+	*t = NullableAddress{false: zero}
+}
+
+// IsSpecified returns whether the value is either null or set.
+func (t NullableAddress) IsSpecified() bool {
+	return len(t) != 0
+}
+
+// SetUnspecified clears the value, so that it's omitted from JSON.
+func (t *NullableAddress) SetUnspecified() {
+	*t = nil
+}
+
+// MarshalJSON writes the value, or null when it's null or unspecified.
+func (t NullableAddress) MarshalJSON() ([]byte, error) {
+	if t.IsNull() || !t.IsSpecified() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t[true])
+}
+
+// UnmarshalJSON reads the value, recording an explicit null.
+func (t *NullableAddress) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		t.SetNull()
+		return nil
+	}
+	var v Address
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	t.Set(v)
+	return nil
+}
+
+// NullablePatientPatchContact is a Nullable PatientPatchContact, which may also be null or unspecified.
+// See openapi_types.NullableString for details.
+type NullablePatientPatchContact map[bool]PatientPatchContact
+
+// NewNullablePatientPatchContact returns a NullablePatientPatchContact which is set to the given value.
+func NewNullablePatientPatchContact(v PatientPatchContact) NullablePatientPatchContact {
+	return NullablePatientPatchContact{true: v}
+}
+
+// NewNullNullablePatientPatchContact returns a NullablePatientPatchContact which is explicitly null.
+func NewNullNullablePatientPatchContact() NullablePatientPatchContact {
+	var zero PatientPatchContact
+	return NullablePatientPatchContact{false: zero}
+}
+
+// Get returns the value, or an error when it's null or unspecified.
+func (t NullablePatientPatchContact) Get() (PatientPatchContact, error) {
+	var zero PatientPatchContact
+	if t.IsNull() {
+		return zero, openapi_types.ErrNullableIsNull
+	}
+	if !t.IsSpecified() {
+		return zero, openapi_types.ErrNullableNotSpecified
+	}
+	return t[true], nil
+}
+
+// MustGet returns the value, and panics when it's null or unspecified.
+func (t NullablePatientPatchContact) MustGet() PatientPatchContact {
+	v, err := t.Get()
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Set sets the value.
+func (t *NullablePatientPatchContact) Set(v PatientPatchContact) {
+	*t = NullablePatientPatchContact{true: v}
+}
+
+// IsNull returns whether the value is explicitly null.
+func (t NullablePatientPatchContact) IsNull() bool {
+	_, found := t[false]
+	return found
+}
+
+// SetNull makes the value explicitly null.
+func (t *NullablePatientPatchContact) SetNull() {
+	var zero PatientPatchContact
+	*t = NullablePatientPatchContact{false: zero}
+}
+
+// IsSpecified returns whether the value is either null or set.
+func (t NullablePatientPatchContact) IsSpecified() bool {
+	return len(t) != 0
+}
+
+// SetUnspecified clears the value, so that it's omitted from JSON.
+func (t *NullablePatientPatchContact) SetUnspecified() {
+	*t = nil
+}
+
+// MarshalJSON writes the value, or null when it's null or unspecified.
+func (t NullablePatientPatchContact) MarshalJSON() ([]byte, error) {
+	if t.IsNull() || !t.IsSpecified() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t[true])
+}
+
+// UnmarshalJSON reads the value, recording an explicit null.
+func (t *NullablePatientPatchContact) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		t.SetNull()
+		return nil
+	}
+	var v PatientPatchContact
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	t.Set(v)
+	return nil
+}
+
+// NullablePatientPatchStatus is a Nullable PatientPatchStatus, which may also be null or unspecified.
+// See openapi_types.NullableString for details.
+type NullablePatientPatchStatus map[bool]PatientPatchStatus
+
+// NewNullablePatientPatchStatus returns a NullablePatientPatchStatus which is set to the given value.
+func NewNullablePatientPatchStatus(v PatientPatchStatus) NullablePatientPatchStatus {
+	return NullablePatientPatchStatus{true: v}
+}
+
+// NewNullNullablePatientPatchStatus returns a NullablePatientPatchStatus which is explicitly null.
+func NewNullNullablePatientPatchStatus() NullablePatientPatchStatus {
+	var zero PatientPatchStatus
+	return NullablePatientPatchStatus{false: zero}
+}
+
+// Get returns the value, or an error when it's null or unspecified.
+func (t NullablePatientPatchStatus) Get() (PatientPatchStatus, error) {
+	var zero PatientPatchStatus
+	if t.IsNull() {
+		return zero, openapi_types.ErrNullableIsNull
+	}
+	if !t.IsSpecified() {
+		return zero, openapi_types.ErrNullableNotSpecified
+	}
+	return t[true], nil
+}
+
+// MustGet returns the value, and panics when it's null or unspecified.
+func (t NullablePatientPatchStatus) MustGet() PatientPatchStatus {
+	v, err := t.Get()
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Set sets the value.
+func (t *NullablePatientPatchStatus) Set(v PatientPatchStatus) {
+	*t = NullablePatientPatchStatus{true: v}
+}
+
+// IsNull returns whether the value is explicitly null.
+func (t NullablePatientPatchStatus) IsNull() bool {
+	_, found := t[false]
+	return found
+}
+
+// SetNull makes the value explicitly null.
+func (t *NullablePatientPatchStatus) SetNull() {
+	var zero PatientPatchStatus
+	*t = NullablePatientPatchStatus{false: zero}
+}
+
+// IsSpecified returns whether the value is either null or set.
+func (t NullablePatientPatchStatus) IsSpecified() bool {
+	return len(t) != 0
+}
+
+// SetUnspecified clears the value, so that it's omitted from JSON.
+func (t *NullablePatientPatchStatus) SetUnspecified() {
+	*t = nil
+}
+
+// MarshalJSON writes the value, or null when it's null or unspecified.
+func (t NullablePatientPatchStatus) MarshalJSON() ([]byte, error) {
+	if t.IsNull() || !t.IsSpecified() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t[true])
+}
+
+// UnmarshalJSON reads the value, recording an explicit null.
+func (t *NullablePatientPatchStatus) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		t.SetNull()
+		return nil
+	}
+	var v PatientPatchStatus
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	t.Set(v)
+	return nil
+}
+
+// NullablePatientPatchTags is a Nullable PatientPatchTags, which may also be null or unspecified.
+// See openapi_types.NullableString for details.
+type NullablePatientPatchTags map[bool]PatientPatchTags
+
+// NewNullablePatientPatchTags returns a NullablePatientPatchTags which is set to the given value.
+func NewNullablePatientPatchTags(v PatientPatchTags) NullablePatientPatchTags {
+	return NullablePatientPatchTags{true: v}
+}
+
+// NewNullNullablePatientPatchTags returns a NullablePatientPatchTags which is explicitly null.
+func NewNullNullablePatientPatchTags() NullablePatientPatchTags {
+	var zero PatientPatchTags
+	return NullablePatientPatchTags{false: zero}
+}
+
+// Get returns the value, or an error when it's null or unspecified.
+func (t NullablePatientPatchTags) Get() (PatientPatchTags, error) {
+	var zero PatientPatchTags
+	if t.IsNull() {
+		return zero, openapi_types.ErrNullableIsNull
+	}
+	if !t.IsSpecified() {
+		return zero, openapi_types.ErrNullableNotSpecified
+	}
+	return t[true], nil
+}
+
+// MustGet returns the value, and panics when it's null or unspecified.
+func (t NullablePatientPatchTags) MustGet() PatientPatchTags {
+	v, err := t.Get()
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Set sets the value.
+func (t *NullablePatientPatchTags) Set(v PatientPatchTags) {
+	*t = NullablePatientPatchTags{true: v}
+}
+
+// IsNull returns whether the value is explicitly null.
+func (t NullablePatientPatchTags) IsNull() bool {
+	_, found := t[false]
+	return found
+}
+
+// SetNull makes the value explicitly null.
+func (t *NullablePatientPatchTags) SetNull() {
+	var zero PatientPatchTags
+	*t = NullablePatientPatchTags{false: zero}
+}
+
+// IsSpecified returns whether the value is either null or set.
+func (t NullablePatientPatchTags) IsSpecified() bool {
+	return len(t) != 0
+}
+
+// SetUnspecified clears the value, so that it's omitted from JSON.
+func (t *NullablePatientPatchTags) SetUnspecified() {
+	*t = nil
+}
+
+// MarshalJSON writes the value, or null when it's null or unspecified.
+func (t NullablePatientPatchTags) MarshalJSON() ([]byte, error) {
+	if t.IsNull() || !t.IsSpecified() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t[true])
+}
+
+// UnmarshalJSON reads the value, recording an explicit null.
+func (t *NullablePatientPatchTags) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		t.SetNull()
+		return nil
+	}
+	var v PatientPatchTags
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	t.Set(v)
+	return nil
+}
@@ -0,0 +1,88 @@
+package nullable
+
+import (
+	"encoding/json"
+	"testing"
+
+	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNullablePatch(t *testing.T) {
+	// Absent, null and set values are all distinguishable
+	const buf = `{"middleName":null,"age":42,"address":{"street":"Main"}}`
+	var patch PatientPatch
+	err := json.Unmarshal([]byte(buf), &patch)
+	assert.NoError(t, err)
+
+	assert.True(t, patch.MiddleName.IsSpecified())
+	assert.True(t, patch.MiddleName.IsNull())
+	_, err = patch.MiddleName.Get()
+	assert.Equal(t, openapi_types.ErrNullableIsNull, err)
+
+	assert.Equal(t, 42, patch.Age.MustGet())
+
+	address, err := patch.Address.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "Main", *address.Street)
+
+	assert.False(t, patch.Status.IsSpecified())
+	_, err = patch.Status.Get()
+	assert.Equal(t, openapi_types.ErrNullableNotSpecified, err)
+
+	// Marshaling preserves all three states
+	patch.Status.Set(PatientPatchStatusActive)
+	patch.Age.SetUnspecified()
+	patch.Address.SetNull()
+	out, err := json.Marshal(patch)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"middleName":null,"address":null,"status":"active"}`, string(out))
+}
+
+func TestNullableRequired(t *testing.T) {
+	// A required nullable property is written as null even when unspecified
+	patient := Patient{Name: "Alex"}
+	out, err := json.Marshal(patient)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"name":"Alex","middleName":null}`, string(out))
+
+	patient.MiddleName = openapi_types.NewNullableString("Sam")
+	patient.Weight = openapi_types.NewNullNullableFloat64()
+	out, err = json.Marshal(patient)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"name":"Alex","middleName":"Sam","weight":null}`, string(out))
+}
+
+func TestNullableArraysAndObjects(t *testing.T) {
+	// Inline arrays and objects round trip through all three states too
+	tests := []struct {
+		name  string
+		json  string
+		check func(t *testing.T, patch PatientPatch)
+	}{
+		{"absent", `{}`, func(t *testing.T, patch PatientPatch) {
+			assert.False(t, patch.Tags.IsSpecified())
+			assert.False(t, patch.Contact.IsSpecified())
+		}},
+		{"null", `{"tags":null,"contact":null}`, func(t *testing.T, patch PatientPatch) {
+			assert.True(t, patch.Tags.IsNull())
+			assert.True(t, patch.Contact.IsNull())
+		}},
+		{"set", `{"tags":["a","b"],"contact":{"email":"a@b.c"}}`, func(t *testing.T, patch PatientPatch) {
+			assert.Equal(t, PatientPatchTags{"a", "b"}, patch.Tags.MustGet())
+			assert.Equal(t, "a@b.c", *patch.Contact.MustGet().Email)
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var patch PatientPatch
+			err := json.Unmarshal([]byte(tt.json), &patch)
+			assert.NoError(t, err)
+			tt.check(t, patch)
+
+			out, err := json.Marshal(patch)
+			assert.NoError(t, err)
+			assert.JSONEq(t, tt.json, string(out))
+		})
+	}
+}
@@ -0,0 +1,3 @@
+package openapi31
+
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --package=openapi31 -generate types,client,nullable-types -o openapi31.gen.go openapi31.yaml
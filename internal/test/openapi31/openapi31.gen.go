@@ -0,0 +1,440 @@
+// Package openapi31 provides primitives to interact the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen DO NOT EDIT.
+package openapi31
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ApiVersion defines model for ApiVersion.
+type ApiVersion string
+
+// Owner defines model for Owner.
+type Owner struct {
+	Name string `json:"name"`
+}
+
+// Pet defines model for Pet.
+type Pet struct {
+	Age        openapi_types.NullableInt    `json:"age,omitempty"`
+	ApiVersion *ApiVersion                  `json:"apiVersion,omitempty"`
+	Id         int                          `json:"id"`
+	Kind       PetKind                      `json:"kind"`
+	Name       string                       `json:"name"`
+	Nickname   openapi_types.NullableString `json:"nickname,omitempty"`
+	Owner      NullableOwner                `json:"owner,omitempty"`
+	Position   *[]float32                   `json:"position,omitempty"`
+	Tags       *[]interface{}               `json:"tags,omitempty"`
+}
+
+// PetKind defines model for Pet.kind.
+type PetKind string
+
+// List of ApiVersion
+const (
+	ApiVersionV1 ApiVersion = "v1"
+)
+
+// The names which the constants of ApiVersion had before they were named
+// in CamelCase
+const (
+	// Deprecated: Use ApiVersionV1 instead.
+	ApiVersion_v1 = ApiVersionV1
+)
+
+// AllApiVersionValues returns all the possible values of ApiVersion
+func AllApiVersionValues() []ApiVersion {
+	return []ApiVersion{
+		ApiVersionV1,
+	}
+}
+
+// Valid returns whether the ApiVersion is one of the values of the enum
+func (e ApiVersion) Valid() bool {
+	switch e {
+	case ApiVersionV1:
+		return true
+	default:
+		return false
+	}
+}
+
+// List of PetKind
+const (
+	PetKindPet PetKind = "pet"
+)
+
+// The names which the constants of PetKind had before they were named
+// in CamelCase
+const (
+	// Deprecated: Use PetKindPet instead.
+	PetKind_pet = PetKindPet
+)
+
+// AllPetKindValues returns all the possible values of PetKind
+func AllPetKindValues() []PetKind {
+	return []PetKind{
+		PetKindPet,
+	}
+}
+
+// Valid returns whether the PetKind is one of the values of the enum
+func (e PetKind) Valid() bool {
+	switch e {
+	case PetKindPet:
+		return true
+	default:
+		return false
+	}
+}
+
+// NullableOwner is a Nullable Owner, which may also be null or unspecified.
+// See openapi_types.NullableString for details.
+type NullableOwner map[bool]Owner
+
+// NewNullableOwner returns a NullableOwner which is set to the given value.
+func NewNullableOwner(v Owner) NullableOwner {
+	return NullableOwner{true: v}
+}
+
+// NewNullNullableOwner returns a NullableOwner which is explicitly null.
+func NewNullNullableOwner() NullableOwner {
+	var zero Owner
+	return NullableOwner{false: zero}
+}
+
+// Get returns the value, or an error when it's null or unspecified.
+func (t NullableOwner) Get() (Owner, error) {
+	var zero Owner
+	if t.IsNull() {
+		return zero, openapi_types.ErrNullableIsNull
+	}
+	if !t.IsSpecified() {
+		return zero, openapi_types.ErrNullableNotSpecified
+	}
+	return t[true], nil
+}
+
+// MustGet returns the value, and panics when it's null or unspecified.
+func (t NullableOwner) MustGet() Owner {
+	v, err := t.Get()
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Set sets the value.
+func (t *NullableOwner) Set(v Owner) {
+	*t = NullableOwner{true: v}
+}
+
+// IsNull returns whether the value is explicitly null.
+func (t NullableOwner) IsNull() bool {
+	_, found := t[false]
+	return found
+}
+
+// SetNull makes the value explicitly null.
+func (t *NullableOwner) SetNull() {
+	var zero Owner
+	*t = NullableOwner{false: zero}
+}
+
+// IsSpecified returns whether the value is either null or set.
+func (t NullableOwner) IsSpecified() bool {
+	return len(t) != 0
+}
+
+// SetUnspecified clears the value, so that it's omitted from JSON.
+func (t *NullableOwner) SetUnspecified() {
+	*t = nil
+}
+
+// MarshalJSON writes the value, or null when it's null or unspecified.
+func (t NullableOwner) MarshalJSON() ([]byte, error) {
+	if t.IsNull() || !t.IsSpecified() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t[true])
+}
+
+// UnmarshalJSON reads the value, recording an explicit null.
+func (t *NullableOwner) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		t.SetNull()
+		return nil
+	}
+	var v Owner
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	t.Set(v)
+	return nil
+}
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A callback for modifying requests which are generated before sending over
+	// the network.
+	RequestEditor RequestEditorFn
+
+	// The decoders of response bodies, by media type. When nil,
+	// runtime.DefaultResponseDecoders is used.
+	ResponseDecoders *runtime.ResponseDecoders
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = http.DefaultClient
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditor = fn
+		return nil
+	}
+}
+
+// WithResponseDecoder registers the decoder of the response bodies of a media
+// type, such as application/x-protobuf, for this client only. Registering the
+// decoder of application/cbor decodes the media types with a +cbor suffix too.
+func WithResponseDecoder(mediaType string, decoder runtime.ResponseDecoder) ClientOption {
+	return func(c *Client) error {
+		if c.ResponseDecoders == nil {
+			c.ResponseDecoders = runtime.DefaultResponseDecoders.Clone()
+		}
+		c.ResponseDecoders.Register(mediaType, decoder)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// GetPet request
+	GetPet(ctx context.Context, id int) (*http.Response, error)
+}
+
+func (c *Client) GetPet(ctx context.Context, id int) (*http.Response, error) {
+	req, err := NewGetPetRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if c.RequestEditor != nil {
+		err = c.RequestEditor(ctx, req)
+		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
+			return nil, err
+		}
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetPetRequest generates requests for GetPet
+func NewGetPetRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParam("simple", false, "id", id)
+	if err != nil {
+		return nil, err
+	}
+
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/pets/%s", pathParam0)
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var req *http.Request
+	req, err = http.NewRequest("GET", queryUrl.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+
+	// The decoders of response bodies, from the ResponseDecoders of the
+	// Client.
+	decoders *runtime.ResponseDecoders
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{ClientInterface: client, decoders: client.ResponseDecoders}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetPet request
+	GetPetWithResponse(ctx context.Context, id int) (*GetPetResponse, error)
+}
+
+type GetPetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Pet
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetPetWithResponse request returning *GetPetResponse
+func (c *ClientWithResponses) GetPetWithResponse(ctx context.Context, id int) (*GetPetResponse, error) {
+	rsp, err := c.GetPet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPetResponseWithDecoders(rsp, c.decoders)
+}
+
+// ParseGetPetResponse parses an HTTP response from a GetPetWithResponse call
+func ParseGetPetResponse(rsp *http.Response) (*GetPetResponse, error) {
+	return ParseGetPetResponseWithDecoders(rsp, nil)
+}
+
+// ParseGetPetResponseWithDecoders parses an HTTP response from a GetPetWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseGetPetResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*GetPetResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	contentType := rsp.Header.Get("Content-Type")
+	switch {
+	case runtime.MediaTypeMatches(contentType, "application/json") && rsp.StatusCode == 200:
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest Pet
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.JSON200 = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
+		}
+
+	}
+
+	return response, nil
+}
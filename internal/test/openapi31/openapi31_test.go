@@ -0,0 +1,29 @@
+package openapi31
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenAPI31Types(t *testing.T) {
+	var pet Pet
+	err := json.Unmarshal([]byte(`{"id": 1, "apiVersion": "v1", "kind": "pet", "name": "Rex", "nickname": null, "owner": {"name": "Sam"}, "position": [1.5, 2]}`), &pet)
+	require.NoError(t, err)
+
+	assert.Equal(t, ApiVersionV1, *pet.ApiVersion)
+	assert.Equal(t, PetKindPet, pet.Kind)
+	assert.True(t, pet.Kind.Valid())
+
+	// Types which include "null" are nullable
+	assert.True(t, pet.Nickname.IsSpecified())
+	assert.True(t, pet.Nickname.IsNull())
+	assert.False(t, pet.Age.IsSpecified())
+	owner, err := pet.Owner.Get()
+	require.NoError(t, err)
+	assert.Equal(t, Owner{Name: "Sam"}, owner)
+
+	assert.Equal(t, []float32{1.5, 2}, *pet.Position)
+}
@@ -16,6 +16,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"path"
 	"strings"
 )
 
@@ -137,6 +138,10 @@ type Client struct {
 	// A callback for modifying requests which are generated before sending over
 	// the network.
 	RequestEditor RequestEditorFn
+
+	// The decoders of response bodies, by media type. When nil,
+	// runtime.DefaultResponseDecoders is used.
+	ResponseDecoders *runtime.ResponseDecoders
 }
 
 // ClientOption allows setting custom parameters during construction
@@ -183,6 +188,19 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 	}
 }
 
+// WithResponseDecoder registers the decoder of the response bodies of a media
+// type, such as application/x-protobuf, for this client only. Registering the
+// decoder of application/cbor decodes the media types with a +cbor suffix too.
+func WithResponseDecoder(mediaType string, decoder runtime.ResponseDecoder) ClientOption {
+	return func(c *Client) error {
+		if c.ResponseDecoders == nil {
+			c.ResponseDecoders = runtime.DefaultResponseDecoders.Clone()
+		}
+		c.ResponseDecoders.Register(mediaType, decoder)
+		return nil
+	}
+}
+
 // The interface specification for the client above.
 type ClientInterface interface {
 	// GetContentObject request
@@ -252,6 +270,11 @@ func (c *Client) GetContentObject(ctx context.Context, param ComplexObject) (*ht
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -267,6 +290,11 @@ func (c *Client) GetCookie(ctx context.Context, params *GetCookieParams) (*http.
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -282,6 +310,11 @@ func (c *Client) GetHeader(ctx context.Context, params *GetHeaderParams) (*http.
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -297,6 +330,11 @@ func (c *Client) GetLabelExplodeArray(ctx context.Context, param []int32) (*http
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -312,6 +350,11 @@ func (c *Client) GetLabelExplodeObject(ctx context.Context, param Object) (*http
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -327,6 +370,11 @@ func (c *Client) GetLabelNoExplodeArray(ctx context.Context, param []int32) (*ht
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -342,6 +390,11 @@ func (c *Client) GetLabelNoExplodeObject(ctx context.Context, param Object) (*ht
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -357,6 +410,11 @@ func (c *Client) GetMatrixExplodeArray(ctx context.Context, id []int32) (*http.R
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -372,6 +430,11 @@ func (c *Client) GetMatrixExplodeObject(ctx context.Context, id Object) (*http.R
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -387,6 +450,11 @@ func (c *Client) GetMatrixNoExplodeArray(ctx context.Context, id []int32) (*http
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -402,6 +470,11 @@ func (c *Client) GetMatrixNoExplodeObject(ctx context.Context, id Object) (*http
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -417,6 +490,11 @@ func (c *Client) GetPassThrough(ctx context.Context, param string) (*http.Respon
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -432,6 +510,11 @@ func (c *Client) GetDeepObject(ctx context.Context, params *GetDeepObjectParams)
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -447,6 +530,11 @@ func (c *Client) GetQueryForm(ctx context.Context, params *GetQueryFormParams) (
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -462,6 +550,11 @@ func (c *Client) GetSimpleExplodeArray(ctx context.Context, param []int32) (*htt
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -477,6 +570,11 @@ func (c *Client) GetSimpleExplodeObject(ctx context.Context, param Object) (*htt
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -492,6 +590,11 @@ func (c *Client) GetSimpleNoExplodeArray(ctx context.Context, param []int32) (*h
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -507,6 +610,11 @@ func (c *Client) GetSimpleNoExplodeObject(ctx context.Context, param Object) (*h
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -522,6 +630,11 @@ func (c *Client) GetSimplePrimitive(ctx context.Context, param int32) (*http.Res
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -541,7 +654,8 @@ func NewGetContentObjectRequest(server string, param ComplexObject) (*http.Reque
 	}
 	pathParam0 = string(pathParamBuf0)
 
-	queryUrl, err := url.Parse(server)
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
@@ -556,7 +670,8 @@ func NewGetContentObjectRequest(server string, param ComplexObject) (*http.Reque
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	var req *http.Request
+	req, err = http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -568,7 +683,8 @@ func NewGetContentObjectRequest(server string, param ComplexObject) (*http.Reque
 func NewGetCookieRequest(server string, params *GetCookieParams) (*http.Request, error) {
 	var err error
 
-	queryUrl, err := url.Parse(server)
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
@@ -583,7 +699,8 @@ func NewGetCookieRequest(server string, params *GetCookieParams) (*http.Request,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	var req *http.Request
+	req, err = http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -702,7 +819,8 @@ func NewGetCookieRequest(server string, params *GetCookieParams) (*http.Request,
 func NewGetHeaderRequest(server string, params *GetHeaderParams) (*http.Request, error) {
 	var err error
 
-	queryUrl, err := url.Parse(server)
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
@@ -717,7 +835,8 @@ func NewGetHeaderRequest(server string, params *GetHeaderParams) (*http.Request,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	var req *http.Request
+	req, err = http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -815,7 +934,8 @@ func NewGetLabelExplodeArrayRequest(server string, param []int32) (*http.Request
 		return nil, err
 	}
 
-	queryUrl, err := url.Parse(server)
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
@@ -830,7 +950,8 @@ func NewGetLabelExplodeArrayRequest(server string, param []int32) (*http.Request
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	var req *http.Request
+	req, err = http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -849,7 +970,8 @@ func NewGetLabelExplodeObjectRequest(server string, param Object) (*http.Request
 		return nil, err
 	}
 
-	queryUrl, err := url.Parse(server)
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
@@ -864,7 +986,8 @@ func NewGetLabelExplodeObjectRequest(server string, param Object) (*http.Request
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	var req *http.Request
+	req, err = http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -883,7 +1006,8 @@ func NewGetLabelNoExplodeArrayRequest(server string, param []int32) (*http.Reque
 		return nil, err
 	}
 
-	queryUrl, err := url.Parse(server)
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
@@ -898,7 +1022,8 @@ func NewGetLabelNoExplodeArrayRequest(server string, param []int32) (*http.Reque
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	var req *http.Request
+	req, err = http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -917,7 +1042,8 @@ func NewGetLabelNoExplodeObjectRequest(server string, param Object) (*http.Reque
 		return nil, err
 	}
 
-	queryUrl, err := url.Parse(server)
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
@@ -932,7 +1058,8 @@ func NewGetLabelNoExplodeObjectRequest(server string, param Object) (*http.Reque
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	var req *http.Request
+	req, err = http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -951,7 +1078,8 @@ func NewGetMatrixExplodeArrayRequest(server string, id []int32) (*http.Request,
 		return nil, err
 	}
 
-	queryUrl, err := url.Parse(server)
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
@@ -966,7 +1094,8 @@ func NewGetMatrixExplodeArrayRequest(server string, id []int32) (*http.Request,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	var req *http.Request
+	req, err = http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -985,7 +1114,8 @@ func NewGetMatrixExplodeObjectRequest(server string, id Object) (*http.Request,
 		return nil, err
 	}
 
-	queryUrl, err := url.Parse(server)
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
@@ -1000,7 +1130,8 @@ func NewGetMatrixExplodeObjectRequest(server string, id Object) (*http.Request,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	var req *http.Request
+	req, err = http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1019,7 +1150,8 @@ func NewGetMatrixNoExplodeArrayRequest(server string, id []int32) (*http.Request
 		return nil, err
 	}
 
-	queryUrl, err := url.Parse(server)
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
@@ -1034,7 +1166,8 @@ func NewGetMatrixNoExplodeArrayRequest(server string, id []int32) (*http.Request
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	var req *http.Request
+	req, err = http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1053,7 +1186,8 @@ func NewGetMatrixNoExplodeObjectRequest(server string, id Object) (*http.Request
 		return nil, err
 	}
 
-	queryUrl, err := url.Parse(server)
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
@@ -1068,7 +1202,8 @@ func NewGetMatrixNoExplodeObjectRequest(server string, id Object) (*http.Request
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	var req *http.Request
+	req, err = http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1084,7 +1219,8 @@ func NewGetPassThroughRequest(server string, param string) (*http.Request, error
 
 	pathParam0 = param
 
-	queryUrl, err := url.Parse(server)
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
@@ -1099,7 +1235,8 @@ func NewGetPassThroughRequest(server string, param string) (*http.Request, error
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	var req *http.Request
+	req, err = http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1111,7 +1248,8 @@ func NewGetPassThroughRequest(server string, param string) (*http.Request, error
 func NewGetDeepObjectRequest(server string, params *GetDeepObjectParams) (*http.Request, error) {
 	var err error
 
-	queryUrl, err := url.Parse(server)
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
@@ -1128,9 +1266,16 @@ func NewGetDeepObjectRequest(server string, params *GetDeepObjectParams) (*http.
 
 	queryValues := queryUrl.Query()
 
-	if queryFrag, err := runtime.StyleParam("deepObject", true, "deepObj", params.DeepObj); err != nil {
+	var queryFrag string
+	var parsed url.Values
+	var queryParamBuf []byte
+	_ = queryFrag
+	_ = parsed
+	_ = queryParamBuf
+
+	if queryFrag, err = runtime.StyleParam("deepObject", true, "deepObj", params.DeepObj); err != nil {
 		return nil, err
-	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+	} else if parsed, err = url.ParseQuery(queryFrag); err != nil {
 		return nil, err
 	} else {
 		for k, v := range parsed {
@@ -1142,7 +1287,8 @@ func NewGetDeepObjectRequest(server string, params *GetDeepObjectParams) (*http.
 
 	queryUrl.RawQuery = queryValues.Encode()
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	var req *http.Request
+	req, err = http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1154,7 +1300,8 @@ func NewGetDeepObjectRequest(server string, params *GetDeepObjectParams) (*http.
 func NewGetQueryFormRequest(server string, params *GetQueryFormParams) (*http.Request, error) {
 	var err error
 
-	queryUrl, err := url.Parse(server)
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
@@ -1171,11 +1318,18 @@ func NewGetQueryFormRequest(server string, params *GetQueryFormParams) (*http.Re
 
 	queryValues := queryUrl.Query()
 
+	var queryFrag string
+	var parsed url.Values
+	var queryParamBuf []byte
+	_ = queryFrag
+	_ = parsed
+	_ = queryParamBuf
+
 	if params.Ea != nil {
 
-		if queryFrag, err := runtime.StyleParam("form", true, "ea", *params.Ea); err != nil {
+		if queryFrag, err = runtime.StyleParam("form", true, "ea", *params.Ea); err != nil {
 			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		} else if parsed, err = url.ParseQuery(queryFrag); err != nil {
 			return nil, err
 		} else {
 			for k, v := range parsed {
@@ -1189,9 +1343,9 @@ func NewGetQueryFormRequest(server string, params *GetQueryFormParams) (*http.Re
 
 	if params.A != nil {
 
-		if queryFrag, err := runtime.StyleParam("form", false, "a", *params.A); err != nil {
+		if queryFrag, err = runtime.StyleParam("form", false, "a", *params.A); err != nil {
 			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		} else if parsed, err = url.ParseQuery(queryFrag); err != nil {
 			return nil, err
 		} else {
 			for k, v := range parsed {
@@ -1205,9 +1359,9 @@ func NewGetQueryFormRequest(server string, params *GetQueryFormParams) (*http.Re
 
 	if params.Eo != nil {
 
-		if queryFrag, err := runtime.StyleParam("form", true, "eo", *params.Eo); err != nil {
+		if queryFrag, err = runtime.StyleParam("form", true, "eo", *params.Eo); err != nil {
 			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		} else if parsed, err = url.ParseQuery(queryFrag); err != nil {
 			return nil, err
 		} else {
 			for k, v := range parsed {
@@ -1221,9 +1375,9 @@ func NewGetQueryFormRequest(server string, params *GetQueryFormParams) (*http.Re
 
 	if params.O != nil {
 
-		if queryFrag, err := runtime.StyleParam("form", false, "o", *params.O); err != nil {
+		if queryFrag, err = runtime.StyleParam("form", false, "o", *params.O); err != nil {
 			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		} else if parsed, err = url.ParseQuery(queryFrag); err != nil {
 			return nil, err
 		} else {
 			for k, v := range parsed {
@@ -1237,9 +1391,9 @@ func NewGetQueryFormRequest(server string, params *GetQueryFormParams) (*http.Re
 
 	if params.Ep != nil {
 
-		if queryFrag, err := runtime.StyleParam("form", true, "ep", *params.Ep); err != nil {
+		if queryFrag, err = runtime.StyleParam("form", true, "ep", *params.Ep); err != nil {
 			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		} else if parsed, err = url.ParseQuery(queryFrag); err != nil {
 			return nil, err
 		} else {
 			for k, v := range parsed {
@@ -1253,9 +1407,9 @@ func NewGetQueryFormRequest(server string, params *GetQueryFormParams) (*http.Re
 
 	if params.P != nil {
 
-		if queryFrag, err := runtime.StyleParam("form", false, "p", *params.P); err != nil {
+		if queryFrag, err = runtime.StyleParam("form", false, "p", *params.P); err != nil {
 			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		} else if parsed, err = url.ParseQuery(queryFrag); err != nil {
 			return nil, err
 		} else {
 			for k, v := range parsed {
@@ -1269,7 +1423,7 @@ func NewGetQueryFormRequest(server string, params *GetQueryFormParams) (*http.Re
 
 	if params.Co != nil {
 
-		if queryParamBuf, err := json.Marshal(*params.Co); err != nil {
+		if queryParamBuf, err = json.Marshal(*params.Co); err != nil {
 			return nil, err
 		} else {
 			queryValues.Add("co", string(queryParamBuf))
@@ -1279,7 +1433,8 @@ func NewGetQueryFormRequest(server string, params *GetQueryFormParams) (*http.Re
 
 	queryUrl.RawQuery = queryValues.Encode()
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	var req *http.Request
+	req, err = http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1298,7 +1453,8 @@ func NewGetSimpleExplodeArrayRequest(server string, param []int32) (*http.Reques
 		return nil, err
 	}
 
-	queryUrl, err := url.Parse(server)
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
@@ -1313,7 +1469,8 @@ func NewGetSimpleExplodeArrayRequest(server string, param []int32) (*http.Reques
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	var req *http.Request
+	req, err = http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1332,7 +1489,8 @@ func NewGetSimpleExplodeObjectRequest(server string, param Object) (*http.Reques
 		return nil, err
 	}
 
-	queryUrl, err := url.Parse(server)
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
@@ -1347,7 +1505,8 @@ func NewGetSimpleExplodeObjectRequest(server string, param Object) (*http.Reques
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	var req *http.Request
+	req, err = http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1366,7 +1525,8 @@ func NewGetSimpleNoExplodeArrayRequest(server string, param []int32) (*http.Requ
 		return nil, err
 	}
 
-	queryUrl, err := url.Parse(server)
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
@@ -1381,7 +1541,8 @@ func NewGetSimpleNoExplodeArrayRequest(server string, param []int32) (*http.Requ
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	var req *http.Request
+	req, err = http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1400,7 +1561,8 @@ func NewGetSimpleNoExplodeObjectRequest(server string, param Object) (*http.Requ
 		return nil, err
 	}
 
-	queryUrl, err := url.Parse(server)
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
@@ -1415,7 +1577,8 @@ func NewGetSimpleNoExplodeObjectRequest(server string, param Object) (*http.Requ
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	var req *http.Request
+	req, err = http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1434,7 +1597,8 @@ func NewGetSimplePrimitiveRequest(server string, param int32) (*http.Request, er
 		return nil, err
 	}
 
-	queryUrl, err := url.Parse(server)
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
@@ -1449,7 +1613,8 @@ func NewGetSimplePrimitiveRequest(server string, param int32) (*http.Request, er
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	var req *http.Request
+	req, err = http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1460,6 +1625,10 @@ func NewGetSimplePrimitiveRequest(server string, param int32) (*http.Request, er
 // ClientWithResponses builds on ClientInterface to offer response payloads
 type ClientWithResponses struct {
 	ClientInterface
+
+	// The decoders of response bodies, from the ResponseDecoders of the
+	// Client.
+	decoders *runtime.ResponseDecoders
 }
 
 // NewClientWithResponses creates a new ClientWithResponses, which wraps
@@ -1469,7 +1638,7 @@ func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithRes
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+	return &ClientWithResponses{ClientInterface: client, decoders: client.ResponseDecoders}, nil
 }
 
 // WithBaseURL overrides the baseURL.
@@ -1547,6 +1716,7 @@ type ClientWithResponsesInterface interface {
 type GetContentObjectResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	TextPlain200 *string
 }
 
 // Status returns HTTPResponse.Status
@@ -1610,6 +1780,7 @@ func (r GetHeaderResponse) StatusCode() int {
 type GetLabelExplodeArrayResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	TextPlain200 *string
 }
 
 // Status returns HTTPResponse.Status
@@ -1631,6 +1802,7 @@ func (r GetLabelExplodeArrayResponse) StatusCode() int {
 type GetLabelExplodeObjectResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	TextPlain200 *string
 }
 
 // Status returns HTTPResponse.Status
@@ -1652,6 +1824,7 @@ func (r GetLabelExplodeObjectResponse) StatusCode() int {
 type GetLabelNoExplodeArrayResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	TextPlain200 *string
 }
 
 // Status returns HTTPResponse.Status
@@ -1673,6 +1846,7 @@ func (r GetLabelNoExplodeArrayResponse) StatusCode() int {
 type GetLabelNoExplodeObjectResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	TextPlain200 *string
 }
 
 // Status returns HTTPResponse.Status
@@ -1694,6 +1868,7 @@ func (r GetLabelNoExplodeObjectResponse) StatusCode() int {
 type GetMatrixExplodeArrayResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	TextPlain200 *string
 }
 
 // Status returns HTTPResponse.Status
@@ -1715,6 +1890,7 @@ func (r GetMatrixExplodeArrayResponse) StatusCode() int {
 type GetMatrixExplodeObjectResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	TextPlain200 *string
 }
 
 // Status returns HTTPResponse.Status
@@ -1736,6 +1912,7 @@ func (r GetMatrixExplodeObjectResponse) StatusCode() int {
 type GetMatrixNoExplodeArrayResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	TextPlain200 *string
 }
 
 // Status returns HTTPResponse.Status
@@ -1757,6 +1934,7 @@ func (r GetMatrixNoExplodeArrayResponse) StatusCode() int {
 type GetMatrixNoExplodeObjectResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	TextPlain200 *string
 }
 
 // Status returns HTTPResponse.Status
@@ -1778,6 +1956,7 @@ func (r GetMatrixNoExplodeObjectResponse) StatusCode() int {
 type GetPassThroughResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	TextPlain200 *string
 }
 
 // Status returns HTTPResponse.Status
@@ -1820,6 +1999,7 @@ func (r GetDeepObjectResponse) StatusCode() int {
 type GetQueryFormResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	TextPlain200 *string
 }
 
 // Status returns HTTPResponse.Status
@@ -1841,6 +2021,7 @@ func (r GetQueryFormResponse) StatusCode() int {
 type GetSimpleExplodeArrayResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	TextPlain200 *string
 }
 
 // Status returns HTTPResponse.Status
@@ -1862,6 +2043,7 @@ func (r GetSimpleExplodeArrayResponse) StatusCode() int {
 type GetSimpleExplodeObjectResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	TextPlain200 *string
 }
 
 // Status returns HTTPResponse.Status
@@ -1883,6 +2065,7 @@ func (r GetSimpleExplodeObjectResponse) StatusCode() int {
 type GetSimpleNoExplodeArrayResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	TextPlain200 *string
 }
 
 // Status returns HTTPResponse.Status
@@ -1904,6 +2087,7 @@ func (r GetSimpleNoExplodeArrayResponse) StatusCode() int {
 type GetSimpleNoExplodeObjectResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	TextPlain200 *string
 }
 
 // Status returns HTTPResponse.Status
@@ -1925,6 +2109,7 @@ func (r GetSimpleNoExplodeObjectResponse) StatusCode() int {
 type GetSimplePrimitiveResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	TextPlain200 *string
 }
 
 // Status returns HTTPResponse.Status
@@ -1949,7 +2134,7 @@ func (c *ClientWithResponses) GetContentObjectWithResponse(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetContentObjectResponse(rsp)
+	return ParseGetContentObjectResponseWithDecoders(rsp, c.decoders)
 }
 
 // GetCookieWithResponse request returning *GetCookieResponse
@@ -1958,7 +2143,7 @@ func (c *ClientWithResponses) GetCookieWithResponse(ctx context.Context, params
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetCookieResponse(rsp)
+	return ParseGetCookieResponseWithDecoders(rsp, c.decoders)
 }
 
 // GetHeaderWithResponse request returning *GetHeaderResponse
@@ -1967,7 +2152,7 @@ func (c *ClientWithResponses) GetHeaderWithResponse(ctx context.Context, params
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetHeaderResponse(rsp)
+	return ParseGetHeaderResponseWithDecoders(rsp, c.decoders)
 }
 
 // GetLabelExplodeArrayWithResponse request returning *GetLabelExplodeArrayResponse
@@ -1976,7 +2161,7 @@ func (c *ClientWithResponses) GetLabelExplodeArrayWithResponse(ctx context.Conte
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetLabelExplodeArrayResponse(rsp)
+	return ParseGetLabelExplodeArrayResponseWithDecoders(rsp, c.decoders)
 }
 
 // GetLabelExplodeObjectWithResponse request returning *GetLabelExplodeObjectResponse
@@ -1985,7 +2170,7 @@ func (c *ClientWithResponses) GetLabelExplodeObjectWithResponse(ctx context.Cont
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetLabelExplodeObjectResponse(rsp)
+	return ParseGetLabelExplodeObjectResponseWithDecoders(rsp, c.decoders)
 }
 
 // GetLabelNoExplodeArrayWithResponse request returning *GetLabelNoExplodeArrayResponse
@@ -1994,7 +2179,7 @@ func (c *ClientWithResponses) GetLabelNoExplodeArrayWithResponse(ctx context.Con
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetLabelNoExplodeArrayResponse(rsp)
+	return ParseGetLabelNoExplodeArrayResponseWithDecoders(rsp, c.decoders)
 }
 
 // GetLabelNoExplodeObjectWithResponse request returning *GetLabelNoExplodeObjectResponse
@@ -2003,7 +2188,7 @@ func (c *ClientWithResponses) GetLabelNoExplodeObjectWithResponse(ctx context.Co
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetLabelNoExplodeObjectResponse(rsp)
+	return ParseGetLabelNoExplodeObjectResponseWithDecoders(rsp, c.decoders)
 }
 
 // GetMatrixExplodeArrayWithResponse request returning *GetMatrixExplodeArrayResponse
@@ -2012,7 +2197,7 @@ func (c *ClientWithResponses) GetMatrixExplodeArrayWithResponse(ctx context.Cont
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetMatrixExplodeArrayResponse(rsp)
+	return ParseGetMatrixExplodeArrayResponseWithDecoders(rsp, c.decoders)
 }
 
 // GetMatrixExplodeObjectWithResponse request returning *GetMatrixExplodeObjectResponse
@@ -2021,7 +2206,7 @@ func (c *ClientWithResponses) GetMatrixExplodeObjectWithResponse(ctx context.Con
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetMatrixExplodeObjectResponse(rsp)
+	return ParseGetMatrixExplodeObjectResponseWithDecoders(rsp, c.decoders)
 }
 
 // GetMatrixNoExplodeArrayWithResponse request returning *GetMatrixNoExplodeArrayResponse
@@ -2030,7 +2215,7 @@ func (c *ClientWithResponses) GetMatrixNoExplodeArrayWithResponse(ctx context.Co
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetMatrixNoExplodeArrayResponse(rsp)
+	return ParseGetMatrixNoExplodeArrayResponseWithDecoders(rsp, c.decoders)
 }
 
 // GetMatrixNoExplodeObjectWithResponse request returning *GetMatrixNoExplodeObjectResponse
@@ -2039,7 +2224,7 @@ func (c *ClientWithResponses) GetMatrixNoExplodeObjectWithResponse(ctx context.C
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetMatrixNoExplodeObjectResponse(rsp)
+	return ParseGetMatrixNoExplodeObjectResponseWithDecoders(rsp, c.decoders)
 }
 
 // GetPassThroughWithResponse request returning *GetPassThroughResponse
@@ -2048,7 +2233,7 @@ func (c *ClientWithResponses) GetPassThroughWithResponse(ctx context.Context, pa
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetPassThroughResponse(rsp)
+	return ParseGetPassThroughResponseWithDecoders(rsp, c.decoders)
 }
 
 // GetDeepObjectWithResponse request returning *GetDeepObjectResponse
@@ -2057,7 +2242,7 @@ func (c *ClientWithResponses) GetDeepObjectWithResponse(ctx context.Context, par
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetDeepObjectResponse(rsp)
+	return ParseGetDeepObjectResponseWithDecoders(rsp, c.decoders)
 }
 
 // GetQueryFormWithResponse request returning *GetQueryFormResponse
@@ -2066,7 +2251,7 @@ func (c *ClientWithResponses) GetQueryFormWithResponse(ctx context.Context, para
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetQueryFormResponse(rsp)
+	return ParseGetQueryFormResponseWithDecoders(rsp, c.decoders)
 }
 
 // GetSimpleExplodeArrayWithResponse request returning *GetSimpleExplodeArrayResponse
@@ -2075,7 +2260,7 @@ func (c *ClientWithResponses) GetSimpleExplodeArrayWithResponse(ctx context.Cont
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetSimpleExplodeArrayResponse(rsp)
+	return ParseGetSimpleExplodeArrayResponseWithDecoders(rsp, c.decoders)
 }
 
 // GetSimpleExplodeObjectWithResponse request returning *GetSimpleExplodeObjectResponse
@@ -2084,7 +2269,7 @@ func (c *ClientWithResponses) GetSimpleExplodeObjectWithResponse(ctx context.Con
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetSimpleExplodeObjectResponse(rsp)
+	return ParseGetSimpleExplodeObjectResponseWithDecoders(rsp, c.decoders)
 }
 
 // GetSimpleNoExplodeArrayWithResponse request returning *GetSimpleNoExplodeArrayResponse
@@ -2093,7 +2278,7 @@ func (c *ClientWithResponses) GetSimpleNoExplodeArrayWithResponse(ctx context.Co
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetSimpleNoExplodeArrayResponse(rsp)
+	return ParseGetSimpleNoExplodeArrayResponseWithDecoders(rsp, c.decoders)
 }
 
 // GetSimpleNoExplodeObjectWithResponse request returning *GetSimpleNoExplodeObjectResponse
@@ -2102,7 +2287,7 @@ func (c *ClientWithResponses) GetSimpleNoExplodeObjectWithResponse(ctx context.C
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetSimpleNoExplodeObjectResponse(rsp)
+	return ParseGetSimpleNoExplodeObjectResponseWithDecoders(rsp, c.decoders)
 }
 
 // GetSimplePrimitiveWithResponse request returning *GetSimplePrimitiveResponse
@@ -2111,11 +2296,20 @@ func (c *ClientWithResponses) GetSimplePrimitiveWithResponse(ctx context.Context
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetSimplePrimitiveResponse(rsp)
+	return ParseGetSimplePrimitiveResponseWithDecoders(rsp, c.decoders)
 }
 
 // ParseGetContentObjectResponse parses an HTTP response from a GetContentObjectWithResponse call
 func ParseGetContentObjectResponse(rsp *http.Response) (*GetContentObjectResponse, error) {
+	return ParseGetContentObjectResponseWithDecoders(rsp, nil)
+}
+
+// ParseGetContentObjectResponseWithDecoders parses an HTTP response from a GetContentObjectWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseGetContentObjectResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*GetContentObjectResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
@@ -2127,7 +2321,20 @@ func ParseGetContentObjectResponse(rsp *http.Response) (*GetContentObjectRespons
 		HTTPResponse: rsp,
 	}
 
+	contentType := rsp.Header.Get("Content-Type")
 	switch {
+	case runtime.MediaTypeMatches(contentType, "text/plain") && rsp.StatusCode == 200:
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest string
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.TextPlain200 = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
+		}
+
 	}
 
 	return response, nil
@@ -2135,6 +2342,15 @@ func ParseGetContentObjectResponse(rsp *http.Response) (*GetContentObjectRespons
 
 // ParseGetCookieResponse parses an HTTP response from a GetCookieWithResponse call
 func ParseGetCookieResponse(rsp *http.Response) (*GetCookieResponse, error) {
+	return ParseGetCookieResponseWithDecoders(rsp, nil)
+}
+
+// ParseGetCookieResponseWithDecoders parses an HTTP response from a GetCookieWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseGetCookieResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*GetCookieResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
@@ -2154,6 +2370,15 @@ func ParseGetCookieResponse(rsp *http.Response) (*GetCookieResponse, error) {
 
 // ParseGetHeaderResponse parses an HTTP response from a GetHeaderWithResponse call
 func ParseGetHeaderResponse(rsp *http.Response) (*GetHeaderResponse, error) {
+	return ParseGetHeaderResponseWithDecoders(rsp, nil)
+}
+
+// ParseGetHeaderResponseWithDecoders parses an HTTP response from a GetHeaderWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseGetHeaderResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*GetHeaderResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
@@ -2173,6 +2398,15 @@ func ParseGetHeaderResponse(rsp *http.Response) (*GetHeaderResponse, error) {
 
 // ParseGetLabelExplodeArrayResponse parses an HTTP response from a GetLabelExplodeArrayWithResponse call
 func ParseGetLabelExplodeArrayResponse(rsp *http.Response) (*GetLabelExplodeArrayResponse, error) {
+	return ParseGetLabelExplodeArrayResponseWithDecoders(rsp, nil)
+}
+
+// ParseGetLabelExplodeArrayResponseWithDecoders parses an HTTP response from a GetLabelExplodeArrayWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseGetLabelExplodeArrayResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*GetLabelExplodeArrayResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
@@ -2184,7 +2418,20 @@ func ParseGetLabelExplodeArrayResponse(rsp *http.Response) (*GetLabelExplodeArra
 		HTTPResponse: rsp,
 	}
 
+	contentType := rsp.Header.Get("Content-Type")
 	switch {
+	case runtime.MediaTypeMatches(contentType, "text/plain") && rsp.StatusCode == 200:
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest string
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.TextPlain200 = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
+		}
+
 	}
 
 	return response, nil
@@ -2192,6 +2439,15 @@ func ParseGetLabelExplodeArrayResponse(rsp *http.Response) (*GetLabelExplodeArra
 
 // ParseGetLabelExplodeObjectResponse parses an HTTP response from a GetLabelExplodeObjectWithResponse call
 func ParseGetLabelExplodeObjectResponse(rsp *http.Response) (*GetLabelExplodeObjectResponse, error) {
+	return ParseGetLabelExplodeObjectResponseWithDecoders(rsp, nil)
+}
+
+// ParseGetLabelExplodeObjectResponseWithDecoders parses an HTTP response from a GetLabelExplodeObjectWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseGetLabelExplodeObjectResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*GetLabelExplodeObjectResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
@@ -2203,7 +2459,20 @@ func ParseGetLabelExplodeObjectResponse(rsp *http.Response) (*GetLabelExplodeObj
 		HTTPResponse: rsp,
 	}
 
+	contentType := rsp.Header.Get("Content-Type")
 	switch {
+	case runtime.MediaTypeMatches(contentType, "text/plain") && rsp.StatusCode == 200:
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest string
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.TextPlain200 = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
+		}
+
 	}
 
 	return response, nil
@@ -2211,6 +2480,15 @@ func ParseGetLabelExplodeObjectResponse(rsp *http.Response) (*GetLabelExplodeObj
 
 // ParseGetLabelNoExplodeArrayResponse parses an HTTP response from a GetLabelNoExplodeArrayWithResponse call
 func ParseGetLabelNoExplodeArrayResponse(rsp *http.Response) (*GetLabelNoExplodeArrayResponse, error) {
+	return ParseGetLabelNoExplodeArrayResponseWithDecoders(rsp, nil)
+}
+
+// ParseGetLabelNoExplodeArrayResponseWithDecoders parses an HTTP response from a GetLabelNoExplodeArrayWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseGetLabelNoExplodeArrayResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*GetLabelNoExplodeArrayResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
@@ -2222,7 +2500,20 @@ func ParseGetLabelNoExplodeArrayResponse(rsp *http.Response) (*GetLabelNoExplode
 		HTTPResponse: rsp,
 	}
 
+	contentType := rsp.Header.Get("Content-Type")
 	switch {
+	case runtime.MediaTypeMatches(contentType, "text/plain") && rsp.StatusCode == 200:
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest string
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.TextPlain200 = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
+		}
+
 	}
 
 	return response, nil
@@ -2230,6 +2521,15 @@ func ParseGetLabelNoExplodeArrayResponse(rsp *http.Response) (*GetLabelNoExplode
 
 // ParseGetLabelNoExplodeObjectResponse parses an HTTP response from a GetLabelNoExplodeObjectWithResponse call
 func ParseGetLabelNoExplodeObjectResponse(rsp *http.Response) (*GetLabelNoExplodeObjectResponse, error) {
+	return ParseGetLabelNoExplodeObjectResponseWithDecoders(rsp, nil)
+}
+
+// ParseGetLabelNoExplodeObjectResponseWithDecoders parses an HTTP response from a GetLabelNoExplodeObjectWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseGetLabelNoExplodeObjectResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*GetLabelNoExplodeObjectResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
@@ -2241,7 +2541,20 @@ func ParseGetLabelNoExplodeObjectResponse(rsp *http.Response) (*GetLabelNoExplod
 		HTTPResponse: rsp,
 	}
 
+	contentType := rsp.Header.Get("Content-Type")
 	switch {
+	case runtime.MediaTypeMatches(contentType, "text/plain") && rsp.StatusCode == 200:
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest string
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.TextPlain200 = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
+		}
+
 	}
 
 	return response, nil
@@ -2249,6 +2562,15 @@ func ParseGetLabelNoExplodeObjectResponse(rsp *http.Response) (*GetLabelNoExplod
 
 // ParseGetMatrixExplodeArrayResponse parses an HTTP response from a GetMatrixExplodeArrayWithResponse call
 func ParseGetMatrixExplodeArrayResponse(rsp *http.Response) (*GetMatrixExplodeArrayResponse, error) {
+	return ParseGetMatrixExplodeArrayResponseWithDecoders(rsp, nil)
+}
+
+// ParseGetMatrixExplodeArrayResponseWithDecoders parses an HTTP response from a GetMatrixExplodeArrayWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseGetMatrixExplodeArrayResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*GetMatrixExplodeArrayResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
@@ -2260,7 +2582,20 @@ func ParseGetMatrixExplodeArrayResponse(rsp *http.Response) (*GetMatrixExplodeAr
 		HTTPResponse: rsp,
 	}
 
+	contentType := rsp.Header.Get("Content-Type")
 	switch {
+	case runtime.MediaTypeMatches(contentType, "text/plain") && rsp.StatusCode == 200:
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest string
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.TextPlain200 = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
+		}
+
 	}
 
 	return response, nil
@@ -2268,6 +2603,15 @@ func ParseGetMatrixExplodeArrayResponse(rsp *http.Response) (*GetMatrixExplodeAr
 
 // ParseGetMatrixExplodeObjectResponse parses an HTTP response from a GetMatrixExplodeObjectWithResponse call
 func ParseGetMatrixExplodeObjectResponse(rsp *http.Response) (*GetMatrixExplodeObjectResponse, error) {
+	return ParseGetMatrixExplodeObjectResponseWithDecoders(rsp, nil)
+}
+
+// ParseGetMatrixExplodeObjectResponseWithDecoders parses an HTTP response from a GetMatrixExplodeObjectWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseGetMatrixExplodeObjectResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*GetMatrixExplodeObjectResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
@@ -2279,7 +2623,20 @@ func ParseGetMatrixExplodeObjectResponse(rsp *http.Response) (*GetMatrixExplodeO
 		HTTPResponse: rsp,
 	}
 
+	contentType := rsp.Header.Get("Content-Type")
 	switch {
+	case runtime.MediaTypeMatches(contentType, "text/plain") && rsp.StatusCode == 200:
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest string
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.TextPlain200 = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
+		}
+
 	}
 
 	return response, nil
@@ -2287,6 +2644,15 @@ func ParseGetMatrixExplodeObjectResponse(rsp *http.Response) (*GetMatrixExplodeO
 
 // ParseGetMatrixNoExplodeArrayResponse parses an HTTP response from a GetMatrixNoExplodeArrayWithResponse call
 func ParseGetMatrixNoExplodeArrayResponse(rsp *http.Response) (*GetMatrixNoExplodeArrayResponse, error) {
+	return ParseGetMatrixNoExplodeArrayResponseWithDecoders(rsp, nil)
+}
+
+// ParseGetMatrixNoExplodeArrayResponseWithDecoders parses an HTTP response from a GetMatrixNoExplodeArrayWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseGetMatrixNoExplodeArrayResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*GetMatrixNoExplodeArrayResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
@@ -2298,7 +2664,20 @@ func ParseGetMatrixNoExplodeArrayResponse(rsp *http.Response) (*GetMatrixNoExplo
 		HTTPResponse: rsp,
 	}
 
+	contentType := rsp.Header.Get("Content-Type")
 	switch {
+	case runtime.MediaTypeMatches(contentType, "text/plain") && rsp.StatusCode == 200:
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest string
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.TextPlain200 = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
+		}
+
 	}
 
 	return response, nil
@@ -2306,6 +2685,15 @@ func ParseGetMatrixNoExplodeArrayResponse(rsp *http.Response) (*GetMatrixNoExplo
 
 // ParseGetMatrixNoExplodeObjectResponse parses an HTTP response from a GetMatrixNoExplodeObjectWithResponse call
 func ParseGetMatrixNoExplodeObjectResponse(rsp *http.Response) (*GetMatrixNoExplodeObjectResponse, error) {
+	return ParseGetMatrixNoExplodeObjectResponseWithDecoders(rsp, nil)
+}
+
+// ParseGetMatrixNoExplodeObjectResponseWithDecoders parses an HTTP response from a GetMatrixNoExplodeObjectWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseGetMatrixNoExplodeObjectResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*GetMatrixNoExplodeObjectResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
@@ -2317,7 +2705,20 @@ func ParseGetMatrixNoExplodeObjectResponse(rsp *http.Response) (*GetMatrixNoExpl
 		HTTPResponse: rsp,
 	}
 
+	contentType := rsp.Header.Get("Content-Type")
 	switch {
+	case runtime.MediaTypeMatches(contentType, "text/plain") && rsp.StatusCode == 200:
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest string
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.TextPlain200 = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
+		}
+
 	}
 
 	return response, nil
@@ -2325,6 +2726,15 @@ func ParseGetMatrixNoExplodeObjectResponse(rsp *http.Response) (*GetMatrixNoExpl
 
 // ParseGetPassThroughResponse parses an HTTP response from a GetPassThroughWithResponse call
 func ParseGetPassThroughResponse(rsp *http.Response) (*GetPassThroughResponse, error) {
+	return ParseGetPassThroughResponseWithDecoders(rsp, nil)
+}
+
+// ParseGetPassThroughResponseWithDecoders parses an HTTP response from a GetPassThroughWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseGetPassThroughResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*GetPassThroughResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
@@ -2336,7 +2746,20 @@ func ParseGetPassThroughResponse(rsp *http.Response) (*GetPassThroughResponse, e
 		HTTPResponse: rsp,
 	}
 
+	contentType := rsp.Header.Get("Content-Type")
 	switch {
+	case runtime.MediaTypeMatches(contentType, "text/plain") && rsp.StatusCode == 200:
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest string
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.TextPlain200 = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
+		}
+
 	}
 
 	return response, nil
@@ -2344,6 +2767,15 @@ func ParseGetPassThroughResponse(rsp *http.Response) (*GetPassThroughResponse, e
 
 // ParseGetDeepObjectResponse parses an HTTP response from a GetDeepObjectWithResponse call
 func ParseGetDeepObjectResponse(rsp *http.Response) (*GetDeepObjectResponse, error) {
+	return ParseGetDeepObjectResponseWithDecoders(rsp, nil)
+}
+
+// ParseGetDeepObjectResponseWithDecoders parses an HTTP response from a GetDeepObjectWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseGetDeepObjectResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*GetDeepObjectResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
@@ -2363,6 +2795,15 @@ func ParseGetDeepObjectResponse(rsp *http.Response) (*GetDeepObjectResponse, err
 
 // ParseGetQueryFormResponse parses an HTTP response from a GetQueryFormWithResponse call
 func ParseGetQueryFormResponse(rsp *http.Response) (*GetQueryFormResponse, error) {
+	return ParseGetQueryFormResponseWithDecoders(rsp, nil)
+}
+
+// ParseGetQueryFormResponseWithDecoders parses an HTTP response from a GetQueryFormWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseGetQueryFormResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*GetQueryFormResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
@@ -2374,7 +2815,20 @@ func ParseGetQueryFormResponse(rsp *http.Response) (*GetQueryFormResponse, error
 		HTTPResponse: rsp,
 	}
 
+	contentType := rsp.Header.Get("Content-Type")
 	switch {
+	case runtime.MediaTypeMatches(contentType, "text/plain") && rsp.StatusCode == 200:
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest string
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.TextPlain200 = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
+		}
+
 	}
 
 	return response, nil
@@ -2382,6 +2836,15 @@ func ParseGetQueryFormResponse(rsp *http.Response) (*GetQueryFormResponse, error
 
 // ParseGetSimpleExplodeArrayResponse parses an HTTP response from a GetSimpleExplodeArrayWithResponse call
 func ParseGetSimpleExplodeArrayResponse(rsp *http.Response) (*GetSimpleExplodeArrayResponse, error) {
+	return ParseGetSimpleExplodeArrayResponseWithDecoders(rsp, nil)
+}
+
+// ParseGetSimpleExplodeArrayResponseWithDecoders parses an HTTP response from a GetSimpleExplodeArrayWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseGetSimpleExplodeArrayResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*GetSimpleExplodeArrayResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
@@ -2393,7 +2856,20 @@ func ParseGetSimpleExplodeArrayResponse(rsp *http.Response) (*GetSimpleExplodeAr
 		HTTPResponse: rsp,
 	}
 
+	contentType := rsp.Header.Get("Content-Type")
 	switch {
+	case runtime.MediaTypeMatches(contentType, "text/plain") && rsp.StatusCode == 200:
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest string
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.TextPlain200 = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
+		}
+
 	}
 
 	return response, nil
@@ -2401,6 +2877,15 @@ func ParseGetSimpleExplodeArrayResponse(rsp *http.Response) (*GetSimpleExplodeAr
 
 // ParseGetSimpleExplodeObjectResponse parses an HTTP response from a GetSimpleExplodeObjectWithResponse call
 func ParseGetSimpleExplodeObjectResponse(rsp *http.Response) (*GetSimpleExplodeObjectResponse, error) {
+	return ParseGetSimpleExplodeObjectResponseWithDecoders(rsp, nil)
+}
+
+// ParseGetSimpleExplodeObjectResponseWithDecoders parses an HTTP response from a GetSimpleExplodeObjectWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseGetSimpleExplodeObjectResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*GetSimpleExplodeObjectResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
@@ -2412,7 +2897,20 @@ func ParseGetSimpleExplodeObjectResponse(rsp *http.Response) (*GetSimpleExplodeO
 		HTTPResponse: rsp,
 	}
 
+	contentType := rsp.Header.Get("Content-Type")
 	switch {
+	case runtime.MediaTypeMatches(contentType, "text/plain") && rsp.StatusCode == 200:
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest string
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.TextPlain200 = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
+		}
+
 	}
 
 	return response, nil
@@ -2420,6 +2918,15 @@ func ParseGetSimpleExplodeObjectResponse(rsp *http.Response) (*GetSimpleExplodeO
 
 // ParseGetSimpleNoExplodeArrayResponse parses an HTTP response from a GetSimpleNoExplodeArrayWithResponse call
 func ParseGetSimpleNoExplodeArrayResponse(rsp *http.Response) (*GetSimpleNoExplodeArrayResponse, error) {
+	return ParseGetSimpleNoExplodeArrayResponseWithDecoders(rsp, nil)
+}
+
+// ParseGetSimpleNoExplodeArrayResponseWithDecoders parses an HTTP response from a GetSimpleNoExplodeArrayWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseGetSimpleNoExplodeArrayResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*GetSimpleNoExplodeArrayResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
@@ -2431,7 +2938,20 @@ func ParseGetSimpleNoExplodeArrayResponse(rsp *http.Response) (*GetSimpleNoExplo
 		HTTPResponse: rsp,
 	}
 
+	contentType := rsp.Header.Get("Content-Type")
 	switch {
+	case runtime.MediaTypeMatches(contentType, "text/plain") && rsp.StatusCode == 200:
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest string
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.TextPlain200 = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
+		}
+
 	}
 
 	return response, nil
@@ -2439,6 +2959,15 @@ func ParseGetSimpleNoExplodeArrayResponse(rsp *http.Response) (*GetSimpleNoExplo
 
 // ParseGetSimpleNoExplodeObjectResponse parses an HTTP response from a GetSimpleNoExplodeObjectWithResponse call
 func ParseGetSimpleNoExplodeObjectResponse(rsp *http.Response) (*GetSimpleNoExplodeObjectResponse, error) {
+	return ParseGetSimpleNoExplodeObjectResponseWithDecoders(rsp, nil)
+}
+
+// ParseGetSimpleNoExplodeObjectResponseWithDecoders parses an HTTP response from a GetSimpleNoExplodeObjectWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseGetSimpleNoExplodeObjectResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*GetSimpleNoExplodeObjectResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
@@ -2450,7 +2979,20 @@ func ParseGetSimpleNoExplodeObjectResponse(rsp *http.Response) (*GetSimpleNoExpl
 		HTTPResponse: rsp,
 	}
 
+	contentType := rsp.Header.Get("Content-Type")
 	switch {
+	case runtime.MediaTypeMatches(contentType, "text/plain") && rsp.StatusCode == 200:
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest string
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.TextPlain200 = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
+		}
+
 	}
 
 	return response, nil
@@ -2458,6 +3000,15 @@ func ParseGetSimpleNoExplodeObjectResponse(rsp *http.Response) (*GetSimpleNoExpl
 
 // ParseGetSimplePrimitiveResponse parses an HTTP response from a GetSimplePrimitiveWithResponse call
 func ParseGetSimplePrimitiveResponse(rsp *http.Response) (*GetSimplePrimitiveResponse, error) {
+	return ParseGetSimplePrimitiveResponseWithDecoders(rsp, nil)
+}
+
+// ParseGetSimplePrimitiveResponseWithDecoders parses an HTTP response from a GetSimplePrimitiveWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseGetSimplePrimitiveResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*GetSimplePrimitiveResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
@@ -2469,7 +3020,20 @@ func ParseGetSimplePrimitiveResponse(rsp *http.Response) (*GetSimplePrimitiveRes
 		HTTPResponse: rsp,
 	}
 
+	contentType := rsp.Header.Get("Content-Type")
 	switch {
+	case runtime.MediaTypeMatches(contentType, "text/plain") && rsp.StatusCode == 200:
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest string
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.TextPlain200 = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
+		}
+
 	}
 
 	return response, nil
@@ -3091,54 +3655,54 @@ type EchoRouter interface {
 }
 
 // RegisterHandlers adds each server route to the EchoRouter.
-func RegisterHandlers(router EchoRouter, si ServerInterface) {
+func RegisterHandlers(router EchoRouter, si ServerInterface, pathPrefix string) {
 
 	wrapper := ServerInterfaceWrapper{
 		Handler: si,
 	}
 
-	router.GET("/contentObject/:param", wrapper.GetContentObject)
-	router.GET("/cookie", wrapper.GetCookie)
-	router.GET("/header", wrapper.GetHeader)
-	router.GET("/labelExplodeArray/:param", wrapper.GetLabelExplodeArray)
-	router.GET("/labelExplodeObject/:param", wrapper.GetLabelExplodeObject)
-	router.GET("/labelNoExplodeArray/:param", wrapper.GetLabelNoExplodeArray)
-	router.GET("/labelNoExplodeObject/:param", wrapper.GetLabelNoExplodeObject)
-	router.GET("/matrixExplodeArray/:id", wrapper.GetMatrixExplodeArray)
-	router.GET("/matrixExplodeObject/:id", wrapper.GetMatrixExplodeObject)
-	router.GET("/matrixNoExplodeArray/:id", wrapper.GetMatrixNoExplodeArray)
-	router.GET("/matrixNoExplodeObject/:id", wrapper.GetMatrixNoExplodeObject)
-	router.GET("/passThrough/:param", wrapper.GetPassThrough)
-	router.GET("/queryDeepObject", wrapper.GetDeepObject)
-	router.GET("/queryForm", wrapper.GetQueryForm)
-	router.GET("/simpleExplodeArray/:param", wrapper.GetSimpleExplodeArray)
-	router.GET("/simpleExplodeObject/:param", wrapper.GetSimpleExplodeObject)
-	router.GET("/simpleNoExplodeArray/:param", wrapper.GetSimpleNoExplodeArray)
-	router.GET("/simpleNoExplodeObject/:param", wrapper.GetSimpleNoExplodeObject)
-	router.GET("/simplePrimitive/:param", wrapper.GetSimplePrimitive)
+	router.GET(path.Join(pathPrefix, "/contentObject/:param"), wrapper.GetContentObject)
+	router.GET(path.Join(pathPrefix, "/cookie"), wrapper.GetCookie)
+	router.GET(path.Join(pathPrefix, "/header"), wrapper.GetHeader)
+	router.GET(path.Join(pathPrefix, "/labelExplodeArray/:param"), wrapper.GetLabelExplodeArray)
+	router.GET(path.Join(pathPrefix, "/labelExplodeObject/:param"), wrapper.GetLabelExplodeObject)
+	router.GET(path.Join(pathPrefix, "/labelNoExplodeArray/:param"), wrapper.GetLabelNoExplodeArray)
+	router.GET(path.Join(pathPrefix, "/labelNoExplodeObject/:param"), wrapper.GetLabelNoExplodeObject)
+	router.GET(path.Join(pathPrefix, "/matrixExplodeArray/:id"), wrapper.GetMatrixExplodeArray)
+	router.GET(path.Join(pathPrefix, "/matrixExplodeObject/:id"), wrapper.GetMatrixExplodeObject)
+	router.GET(path.Join(pathPrefix, "/matrixNoExplodeArray/:id"), wrapper.GetMatrixNoExplodeArray)
+	router.GET(path.Join(pathPrefix, "/matrixNoExplodeObject/:id"), wrapper.GetMatrixNoExplodeObject)
+	router.GET(path.Join(pathPrefix, "/passThrough/:param"), wrapper.GetPassThrough)
+	router.GET(path.Join(pathPrefix, "/queryDeepObject"), wrapper.GetDeepObject)
+	router.GET(path.Join(pathPrefix, "/queryForm"), wrapper.GetQueryForm)
+	router.GET(path.Join(pathPrefix, "/simpleExplodeArray/:param"), wrapper.GetSimpleExplodeArray)
+	router.GET(path.Join(pathPrefix, "/simpleExplodeObject/:param"), wrapper.GetSimpleExplodeObject)
+	router.GET(path.Join(pathPrefix, "/simpleNoExplodeArray/:param"), wrapper.GetSimpleNoExplodeArray)
+	router.GET(path.Join(pathPrefix, "/simpleNoExplodeObject/:param"), wrapper.GetSimpleNoExplodeObject)
+	router.GET(path.Join(pathPrefix, "/simplePrimitive/:param"), wrapper.GetSimplePrimitive)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9xZS2/jNhD+K8a0p0JreXdvugXbV4Duo3UOBRY5MNI45lYSuSQdJDD03wuSelIPS7aV",
-	"OHuzpeF8832cGQypPYQs4SzFVEkI9iBQcpZKNH/WNOEx/pM/0k9ClipMlf6p8FH5PCY01f9kuMWEmOdP",
-	"HCEAqQRN7yHLMg8ilKGgXFGWQgBXC2n8LgqsBbv7hqECbWr9GPQPTFs9frYvgz1wwTgKRW1w11ENjaYK",
-	"71FA5sG1vIoSG1T+8o6xGEmqX1bOfha4gQB+8iv+fg7uf67iEfh9RwVGEHwtFnsausK5bbhtxrihQqpP",
-	"JMEOYTwQLO564aAaK6/m6tZoStMN04tjGmK+OakBgo/XN9q7okq7hxuUarFG8YACPHhAIe02vF2ulitt",
-	"yDimhFMI4P1ytXwLHnCitiZ+P99vy8/fcyJIkuk392joarJE76veDfgD1Yf6AuNKkAQVCgnB10b+EM5j",
-	"GprF/jfJnCwa2p5mYuRqQGDCBq+QwSBDXUsldpjdes0cf7da9eGVdr5TCJnB9EPG/qM4rIaxaMnQLAgu",
-	"aEIVfdCG+MhjFiEEGxJLzImFhZuCGng1qTZMJETZInj/DrxWTWTeKEQtTw8gnoyYo0QLIgR5GgtLGrBU",
-	"YSJH4ZdPLFpHPK0whvSeL4xSFlYUzChdWCOgca3MhW4jDklwHOJc5d5kElqDSsNOBiEDp/jTXRybQt4i",
-	"iVAMFfKf1uLUQt4WbvKY/n3zpbZk1pIegH7zW56Fz1Lk7UCutHV3EM9W8j1RvXDht6OyVdAt1hx9oC+C",
-	"V9cO2kRyRwWhvuYQkzuMc71NTvj7pekCvwwOQn+5y9rNo2vHx8ww58lJD6R6MhOiYQjnnIzqmhWz41TR",
-	"+kbIc6g2JmFn1+cT68qqw/o01w0IVK/jHyivSv7NzJog3MHUOkW5l86thChBH53UotFw4X1sLTqm8Gg0",
-	"e05ZdvMJVubUJMWO71UHJJuWTLOJ02pVNBohzhka1WvOqHafmqbaCV3q0rOKEylvtoLt7rdjLpW+VOaD",
-	"V0oTriRf5MLo+w7F06+IvLov7KNcszpw6IwQ+fApwsBWPCPr+ugMcQbwKlGiKua+YdqE8jsTyRD3v0uj",
-	"A9RHnTcd9me7U6p466Uw8bzpRPVsQY07d7qazX/f5CCeA7CkeuhqxGU7z/XqANsjAF/yKO1E375YO6lJ",
-	"2o9DzXljxNFx3Vp2uQduS3E+1RqfaybIdjlH7tkUcifZw8PHumPdBR+651du/MfAddfCizh2z6ZSeak+",
-	"Xp/6JwBHmaOUGJE8c8mgXZsvzTb8nYghgK1SPPD9/DOzQqmWelJMCF8SCtlt9n8AAAD//xeCHE2EIAAA",
+	"H4sIAAAAAAAC/9xZS4/bNhD+K8a0p0KxnOSm2yJ9LdA8Wu+hQLAHrjS2mUoiQ9ILG4b+e0HqTT0s2dba",
+	"m5stzcw338eZAUkdwGcRZzHGSoJ3AIGSs1ii+bOkEQ/xn+yRfuKzWGGs9E+FO+XykNBY/5P+BiNinu85",
+	"ggdSCRqvIUkSBwKUvqBcURaDB3czaeLOcqwZe/qGvgJtmsYx6B+Yttp9Tl96B+CCcRSKpsndBxU0Gitc",
+	"o4DEgXt5F0Q0rrx8YixEEuuXZbCfBa7Ag5/ckr+bgbufy3wEft9SgQF4X3NnR0OXOI+1sPUcV1RI9YlE",
+	"2CKMA4KFbS8sVGPlVEI9Gk1pvGLaOaQ+ZosTGyD4eP+goyuqdHh4QKlmSxTPKMCBZxQyXYa388V8oQ0Z",
+	"x5hwCh68ny/mb8EBTtTG5O9m653ycw+cCBIl+s0aDV1Nluh11asBf6D6UHUwoQSJUKGQ4H2t1Q/hPKS+",
+	"cXa/SWZVUd/y1AsjUwM8kzY4uQwGGapaKrHF5NGp1/i7xaILr7BzrUZIDKbrM/YfxX41jEVDhnpDcEEj",
+	"quizNsQdD1mA4K1IKDEj5udhcmrgVKRaMRERlTbB+3fgNHoicQYhank6APFsxAwlmBEhyH4oLKnBUoWR",
+	"HIRfPEnRWvJppNGn93RpFLKwvGEG6cJqCQ0bZTZ0E7FPgtMQp2r3OhM/NSg1bGXgM7CaP96GoWnkDZIA",
+	"RV8j/5lanNvImzxMltO/b75UXCZt6R7oN79lVfgiTd5M5E5btyfxYi3fkdWVG7+ZVdoF7WJNMQe6Mnh1",
+	"46BJJAuUE+oaDiF5wjDT29SEe5ibKfBL70boL9utOTzaVnzIHuYyNemAVHuzQzQM4ZI7o6pm+d5xrGhd",
+	"W8hLqDakYCfX5xNrq6rj+tT9egSq9vEPVFcF/3pljRDuaGmdo9y1aysiStCdVVo06G+8jw2nUxqPBpPX",
+	"VMpuOsGKmhql2Omz6ohk44ppMnEao4oGA8S5wKB6zRXVnFPjVDtjSt16VXEi5cNGsO16M+RS6Utp3nul",
+	"NOJK8ioXRt+3KPa/IvLyvrCLcsXqyKEzQOT9pwgDW/IM0tAnV4i1AS8LJShz7tpMm1R+ZyLq4/53YXSE",
+	"+qDzpsX+YndKJW/tCiPPm1ZWL5bUsHOnrdn0900W4iUAC6rHrkZsttNcr/awPQHwmkdpK/vmxdpZQzL9",
+	"OFTfbww4Oi4bbrd74E4pTqda7XPNCNlu58g9mUL2Tvb45mPZ4nfDh+7plRv+MXDZ5ngTx+7JVCou1Yfr",
+	"U/0EYClzkhIDimcqGXRo86U5TX8rQvBgoxT3XDf7zKxQqnmAyCPC54RC8pj8PwAXghxNhCAAAA==",
 }
 
 // GetSwagger returns the Swagger specification corresponding to the generated code
@@ -0,0 +1,3 @@
+package readwrite
+
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --package=readwrite -generate types,client,read-write-variants -o readwrite.gen.go readwrite.yaml
@@ -0,0 +1,51 @@
+package readwrite
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadWriteVariants(t *testing.T) {
+	// The server sees the request variant, which has no readOnly properties,
+	// and replies with all the properties; the client only keeps those in the
+	// response variant, which has no writeOnly properties.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"name":"alex","password":"secret","group":{"name":"admins"}}`, string(body))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":1,"name":"alex","password":"secret","group":{"id":2,"name":"admins"}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	user := CreateUserJSONRequestBody{
+		Name:     "alex",
+		Password: "secret",
+		Group:    &GroupRequest{Name: "admins"},
+	}
+	response, err := client.CreateUserWithResponse(context.Background(), user)
+	require.NoError(t, err)
+	require.NotNil(t, response.JSON201)
+	assert.Equal(t, UserResponse{
+		Id:    1,
+		Name:  "alex",
+		Group: &GroupResponse{Id: 2, Name: "admins"},
+	}, *response.JSON201)
+
+	// The base type keeps every property
+	buf, err := json.Marshal(User{Id: 1, Name: "alex", Password: "secret"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":1,"name":"alex","password":"secret"}`, string(buf))
+}
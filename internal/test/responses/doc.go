@@ -0,0 +1,3 @@
+package responses
+
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --generate=types,client --package=responses -o responses.gen.go responses.yaml
@@ -0,0 +1,590 @@
+// Package responses provides primitives to interact the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen DO NOT EDIT.
+package responses
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Owner defines model for Owner.
+type Owner struct {
+	Name *string `json:"name,omitempty"`
+}
+
+// Pet defines model for Pet.
+type Pet struct {
+	Name string  `json:"name"`
+	Tag  *string `json:"tag,omitempty"`
+}
+
+// Problem defines model for Problem.
+type Problem struct {
+	Status *int   `json:"status,omitempty"`
+	Title  string `json:"title"`
+}
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A callback for modifying requests which are generated before sending over
+	// the network.
+	RequestEditor RequestEditorFn
+
+	// The decoders of response bodies, by media type. When nil,
+	// runtime.DefaultResponseDecoders is used.
+	ResponseDecoders *runtime.ResponseDecoders
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = http.DefaultClient
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditor = fn
+		return nil
+	}
+}
+
+// WithResponseDecoder registers the decoder of the response bodies of a media
+// type, such as application/x-protobuf, for this client only. Registering the
+// decoder of application/cbor decodes the media types with a +cbor suffix too.
+func WithResponseDecoder(mediaType string, decoder runtime.ResponseDecoder) ClientOption {
+	return func(c *Client) error {
+		if c.ResponseDecoders == nil {
+			c.ResponseDecoders = runtime.DefaultResponseDecoders.Clone()
+		}
+		c.ResponseDecoders.Register(mediaType, decoder)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// GetPetAge request
+	GetPetAge(ctx context.Context, id string) (*http.Response, error)
+
+	// GetPet request
+	GetPet(ctx context.Context, id string) (*http.Response, error)
+}
+
+func (c *Client) GetPetAge(ctx context.Context, id string) (*http.Response, error) {
+	req, err := NewGetPetAgeRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if c.RequestEditor != nil {
+		err = c.RequestEditor(ctx, req)
+		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
+			return nil, err
+		}
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPet(ctx context.Context, id string) (*http.Response, error) {
+	req, err := NewGetPetRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if c.RequestEditor != nil {
+		err = c.RequestEditor(ctx, req)
+		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
+			return nil, err
+		}
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetPetAgeRequest generates requests for GetPetAge
+func NewGetPetAgeRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParam("simple", false, "id", id)
+	if err != nil {
+		return nil, err
+	}
+
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/ages/%s", pathParam0)
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var req *http.Request
+	req, err = http.NewRequest("GET", queryUrl.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPetRequest generates requests for GetPet
+func NewGetPetRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParam("simple", false, "id", id)
+	if err != nil {
+		return nil, err
+	}
+
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/pets/%s", pathParam0)
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var req *http.Request
+	req, err = http.NewRequest("GET", queryUrl.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+
+	// The decoders of response bodies, from the ResponseDecoders of the
+	// Client.
+	decoders *runtime.ResponseDecoders
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{ClientInterface: client, decoders: client.ResponseDecoders}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetPetAge request
+	GetPetAgeWithResponse(ctx context.Context, id string) (*GetPetAgeResponse, error)
+
+	// GetPet request
+	GetPetWithResponse(ctx context.Context, id string) (*GetPetResponse, error)
+}
+
+type GetPetAgeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	TextPlain200 *int
+	TextPlain202 *Pet
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPetAgeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPetAgeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetPet200ResponseHeaders are the headers of the 200 response of GetPet
+type GetPet200ResponseHeaders struct {
+
+	// The version of the pet
+	ETag                *string   `json:"ETag,omitempty"`
+	Link                *[]string `json:"Link,omitempty"`
+	XOwner              *Owner    `json:"X-Owner,omitempty"`
+	XRateLimitRemaining int       `json:"X-Rate-Limit-Remaining"`
+}
+
+// GetPet4XXResponseHeaders are the headers of the 4XX response of GetPet
+type GetPet4XXResponseHeaders struct {
+	RetryAfter *int `json:"Retry-After,omitempty"`
+}
+
+type GetPetResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Pet
+	ApplicationXCsv200            *Pet
+	TextPlain200                  *string
+	ApplicationProblemJson404     *Problem
+	JSON4XX                       *Problem
+	ApplicationProblemJsonDefault *Problem
+	Headers200                    *GetPet200ResponseHeaders
+	Headers4XX                    *GetPet4XXResponseHeaders
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetPetAgeWithResponse request returning *GetPetAgeResponse
+func (c *ClientWithResponses) GetPetAgeWithResponse(ctx context.Context, id string) (*GetPetAgeResponse, error) {
+	rsp, err := c.GetPetAge(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPetAgeResponseWithDecoders(rsp, c.decoders)
+}
+
+// GetPetWithResponse request returning *GetPetResponse
+func (c *ClientWithResponses) GetPetWithResponse(ctx context.Context, id string) (*GetPetResponse, error) {
+	rsp, err := c.GetPet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPetResponseWithDecoders(rsp, c.decoders)
+}
+
+// ParseGetPetAgeResponse parses an HTTP response from a GetPetAgeWithResponse call
+func ParseGetPetAgeResponse(rsp *http.Response) (*GetPetAgeResponse, error) {
+	return ParseGetPetAgeResponseWithDecoders(rsp, nil)
+}
+
+// ParseGetPetAgeResponseWithDecoders parses an HTTP response from a GetPetAgeWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseGetPetAgeResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*GetPetAgeResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPetAgeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	contentType := rsp.Header.Get("Content-Type")
+	switch {
+	case runtime.MediaTypeMatches(contentType, "text/plain") && rsp.StatusCode == 200:
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest int
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.TextPlain200 = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
+		}
+
+	case runtime.MediaTypeMatches(contentType, "text/plain") && rsp.StatusCode == 202:
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest Pet
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.TextPlain202 = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
+		}
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPetResponse parses an HTTP response from a GetPetWithResponse call
+func ParseGetPetResponse(rsp *http.Response) (*GetPetResponse, error) {
+	return ParseGetPetResponseWithDecoders(rsp, nil)
+}
+
+// ParseGetPetResponseWithDecoders parses an HTTP response from a GetPetWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseGetPetResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*GetPetResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	contentType := rsp.Header.Get("Content-Type")
+	switch {
+	case runtime.MediaTypeMatches(contentType, "application/json") && rsp.StatusCode == 200:
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest Pet
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.JSON200 = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
+		}
+
+	case runtime.MediaTypeMatches(contentType, "application/x-csv") && rsp.StatusCode == 200:
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest Pet
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.ApplicationXCsv200 = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
+		}
+
+	case runtime.MediaTypeMatches(contentType, "text/plain") && rsp.StatusCode == 200:
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest string
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.TextPlain200 = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
+		}
+
+	case runtime.MediaTypeMatches(contentType, "application/problem+json") && rsp.StatusCode == 404:
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest Problem
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.ApplicationProblemJson404 = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
+		}
+
+	case runtime.MediaTypeMatches(contentType, "application/json") && rsp.StatusCode/100 == 4:
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest Problem
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.JSON4XX = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
+		}
+
+	case runtime.MediaTypeMatches(contentType, "application/problem+json"):
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest Problem
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.ApplicationProblemJsonDefault = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
+		}
+
+	}
+
+	switch {
+
+	case rsp.StatusCode == 200:
+		var headers GetPet200ResponseHeaders
+
+		if value := rsp.Header.Get("ETag"); value != "" {
+			var dest string
+
+			if err := runtime.BindStyledParameter("simple", false, "ETag", value, &dest); err != nil {
+				return nil, fmt.Errorf("invalid format for header ETag: %s", err)
+			}
+
+			headers.ETag = &dest
+		}
+
+		if value := rsp.Header.Get("Link"); value != "" {
+			var dest []string
+
+			if err := runtime.BindStyledParameter("simple", false, "Link", value, &dest); err != nil {
+				return nil, fmt.Errorf("invalid format for header Link: %s", err)
+			}
+
+			headers.Link = &dest
+		}
+
+		if value := rsp.Header.Get("X-Owner"); value != "" {
+			var dest Owner
+
+			if err := json.Unmarshal([]byte(value), &dest); err != nil {
+				return nil, fmt.Errorf("error unmarshaling header X-Owner as JSON: %s", err)
+			}
+
+			headers.XOwner = &dest
+		}
+
+		if value := rsp.Header.Get("X-Rate-Limit-Remaining"); value != "" {
+			var dest int
+
+			if err := runtime.BindStyledParameter("simple", false, "X-Rate-Limit-Remaining", value, &dest); err != nil {
+				return nil, fmt.Errorf("invalid format for header X-Rate-Limit-Remaining: %s", err)
+			}
+
+			headers.XRateLimitRemaining = dest
+		} else {
+			return nil, fmt.Errorf("header X-Rate-Limit-Remaining is required, but not found")
+		}
+
+		response.Headers200 = &headers
+
+	case rsp.StatusCode == 404:
+		break // No headers
+
+	case rsp.StatusCode/100 == 4:
+		var headers GetPet4XXResponseHeaders
+
+		if value := rsp.Header.Get("Retry-After"); value != "" {
+			var dest int
+
+			if err := runtime.BindStyledParameter("simple", false, "Retry-After", value, &dest); err != nil {
+				return nil, fmt.Errorf("invalid format for header Retry-After: %s", err)
+			}
+
+			headers.RetryAfter = &dest
+		}
+
+		response.Headers4XX = &headers
+
+	default:
+		break // No headers
+
+	}
+
+	return response, nil
+}
@@ -0,0 +1,199 @@
+package responses
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+)
+
+// newPetServer answers with the status and content type which are given in
+// the last element of the path, as in /pets/404-application;problem+json, the
+// body which it has for that content type, and the given headers.
+func newPetServer(bodies map[string]string, headers http.Header) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var status int
+		var contentType string
+		_, _ = fmt.Sscanf(path.Base(r.URL.Path), "%d-%s", &status, &contentType)
+		contentType = strings.Replace(contentType, ";", "/", 1)
+		for name, values := range headers {
+			w.Header()[name] = values
+		}
+		w.Header().Set("Content-Type", contentType)
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(bodies[contentType]))
+	}))
+}
+
+// decodeCSV decodes a pet from a line of CSV, which is its name and tag.
+func decodeCSV(body []byte, v interface{}) error {
+	pet, ok := v.(*Pet)
+	if !ok {
+		return fmt.Errorf("can't decode CSV into %T", v)
+	}
+	fields := strings.Split(strings.TrimSpace(string(body)), ",")
+	pet.Name = fields[0]
+	if len(fields) > 1 {
+		pet.Tag = &fields[1]
+	}
+	return nil
+}
+
+func TestResponseDecoders(t *testing.T) {
+	server := newPetServer(map[string]string{
+		"application/json":         `{"name": "Tom", "title": "Gone"}`,
+		"application/problem+json": `{"title": "Gone", "status": 404}`,
+		"application/x-csv":        "Tom,cat",
+		"text/plain":               "Tom",
+		"image/png":                "PNG",
+	}, http.Header{"X-Rate-Limit-Remaining": {"42"}})
+	defer server.Close()
+
+	client, err := NewClientWithResponses(server.URL, WithResponseDecoder("application/x-csv", decodeCSV))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	rsp, err := client.GetPetWithResponse(ctx, "200-application;json")
+	require.NoError(t, err)
+	require.NotNil(t, rsp.JSON200)
+	assert.Equal(t, "Tom", rsp.JSON200.Name)
+
+	rsp, err = client.GetPetWithResponse(ctx, "200-application;x-csv")
+	require.NoError(t, err)
+	require.NotNil(t, rsp.ApplicationXCsv200)
+	assert.Equal(t, "Tom", rsp.ApplicationXCsv200.Name)
+	assert.Equal(t, "cat", *rsp.ApplicationXCsv200.Tag)
+	assert.Nil(t, rsp.JSON200)
+
+	rsp, err = client.GetPetWithResponse(ctx, "200-text;plain")
+	require.NoError(t, err)
+	require.NotNil(t, rsp.TextPlain200)
+	assert.Equal(t, "Tom", *rsp.TextPlain200)
+
+	rsp, err = client.GetPetWithResponse(ctx, "404-application;problem+json")
+	require.NoError(t, err)
+	require.NotNil(t, rsp.ApplicationProblemJson404)
+	assert.Equal(t, "Gone", rsp.ApplicationProblemJson404.Title)
+	assert.Nil(t, rsp.JSON4XX)
+
+	// Other 4XX responses, including those with a +json suffix, are decoded
+	// as JSON.
+	rsp, err = client.GetPetWithResponse(ctx, "410-application;json")
+	require.NoError(t, err)
+	require.NotNil(t, rsp.JSON4XX)
+	assert.Equal(t, "Gone", rsp.JSON4XX.Title)
+
+	rsp, err = client.GetPetWithResponse(ctx, "410-application;problem+json")
+	require.NoError(t, err)
+	require.NotNil(t, rsp.JSON4XX)
+	assert.Equal(t, 404, *rsp.JSON4XX.Status)
+
+	rsp, err = client.GetPetWithResponse(ctx, "500-application;problem+json")
+	require.NoError(t, err)
+	require.NotNil(t, rsp.ApplicationProblemJsonDefault)
+	assert.Equal(t, "Gone", rsp.ApplicationProblemJsonDefault.Title)
+
+	// A media range has no decoder, so the body is left as it is.
+	rsp, err = client.GetPetWithResponse(ctx, "500-image;png")
+	require.NoError(t, err)
+	assert.Nil(t, rsp.ApplicationProblemJsonDefault)
+	assert.Equal(t, "PNG", string(rsp.Body))
+
+	// The decoder is only registered for that client.
+	_, found := runtime.DefaultResponseDecoders.Lookup("application/x-csv")
+	assert.False(t, found)
+	defaultClient, err := NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+	rsp, err = defaultClient.GetPetWithResponse(ctx, "200-application;x-csv")
+	require.NoError(t, err)
+	assert.Nil(t, rsp.ApplicationXCsv200)
+	assert.Equal(t, "Tom,cat", string(rsp.Body))
+}
+
+func TestPlainTextResponses(t *testing.T) {
+	server := newPetServer(map[string]string{"text/plain": "42\n"}, nil)
+	defer server.Close()
+
+	client, err := NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	// Plain text is decoded into scalars which aren't strings
+	rsp, err := client.GetPetAgeWithResponse(ctx, "200-text;plain")
+	require.NoError(t, err)
+	require.NotNil(t, rsp.TextPlain200)
+	assert.Equal(t, 42, *rsp.TextPlain200)
+
+	// But it's left in the Body for anything else
+	rsp, err = client.GetPetAgeWithResponse(ctx, "202-text;plain")
+	require.NoError(t, err)
+	assert.Nil(t, rsp.TextPlain202)
+	assert.Equal(t, "42\n", string(rsp.Body))
+}
+
+func TestResponseHeaders(t *testing.T) {
+	server := newPetServer(map[string]string{
+		"application/json": `{"name": "Tom", "title": "Gone"}`,
+	}, http.Header{
+		"X-Rate-Limit-Remaining": {"42"},
+		"Etag":                   {`"v2"`},
+		"Link":                   {"</pets/1>,</pets/3>"},
+		"X-Owner":                {`{"name": "Ann"}`},
+		"Retry-After":            {"120"},
+	})
+	defer server.Close()
+
+	client, err := NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	rsp, err := client.GetPetWithResponse(ctx, "200-application;json")
+	require.NoError(t, err)
+	require.NotNil(t, rsp.Headers200)
+	assert.Equal(t, 42, rsp.Headers200.XRateLimitRemaining)
+	assert.Equal(t, `"v2"`, *rsp.Headers200.ETag)
+	assert.Equal(t, []string{"</pets/1>", "</pets/3>"}, *rsp.Headers200.Link)
+	assert.Equal(t, "Ann", *rsp.Headers200.XOwner.Name)
+	assert.Nil(t, rsp.Headers4XX)
+
+	// The headers of a range of status codes are bound for those which have
+	// no response of their own.
+	rsp, err = client.GetPetWithResponse(ctx, "429-application;json")
+	require.NoError(t, err)
+	require.NotNil(t, rsp.Headers4XX)
+	assert.Equal(t, 120, *rsp.Headers4XX.RetryAfter)
+	assert.Nil(t, rsp.Headers200)
+
+	rsp, err = client.GetPetWithResponse(ctx, "404-application;json")
+	require.NoError(t, err)
+	assert.Nil(t, rsp.Headers4XX)
+
+	// Headers which aren't in the format of their schema fail the response.
+	badServer := newPetServer(nil, http.Header{"X-Rate-Limit-Remaining": {"many"}})
+	defer badServer.Close()
+	client, err = NewClientWithResponses(badServer.URL)
+	require.NoError(t, err)
+	_, err = client.GetPetWithResponse(ctx, "200-text;plain")
+	assert.Error(t, err)
+
+	// As does a required header which is missing, while optional ones may be.
+	missingServer := newPetServer(nil, http.Header{"Etag": {`"v2"`}})
+	defer missingServer.Close()
+	client, err = NewClientWithResponses(missingServer.URL)
+	require.NoError(t, err)
+	_, err = client.GetPetWithResponse(ctx, "200-text;plain")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "X-Rate-Limit-Remaining is required")
+	rsp, err = client.GetPetWithResponse(ctx, "429-text;plain")
+	require.NoError(t, err)
+	require.NotNil(t, rsp.Headers4XX)
+	assert.Nil(t, rsp.Headers4XX.RetryAfter)
+}
@@ -9,16 +9,15 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
-	"encoding/xml"
 	"fmt"
 	"github.com/deepmap/oapi-codegen/pkg/runtime"
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
-	"gopkg.in/yaml.v2"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"path"
 	"strings"
 )
 
@@ -45,7 +44,7 @@ type Issue9Params struct {
 	Foo string `json:"foo"`
 }
 
-// Issue9RequestBody defines body for Issue9 for application/json ContentType.
+// Issue9JSONRequestBody defines body for Issue9 for application/json ContentType.
 type Issue9JSONRequestBody Issue9JSONBody
 
 // RequestEditorFn  is the function signature for the RequestEditor callback function
@@ -71,6 +70,10 @@ type Client struct {
 	// A callback for modifying requests which are generated before sending over
 	// the network.
 	RequestEditor RequestEditorFn
+
+	// The decoders of response bodies, by media type. When nil,
+	// runtime.DefaultResponseDecoders is used.
+	ResponseDecoders *runtime.ResponseDecoders
 }
 
 // ClientOption allows setting custom parameters during construction
@@ -117,6 +120,19 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 	}
 }
 
+// WithResponseDecoder registers the decoder of the response bodies of a media
+// type, such as application/x-protobuf, for this client only. Registering the
+// decoder of application/cbor decodes the media types with a +cbor suffix too.
+func WithResponseDecoder(mediaType string, decoder runtime.ResponseDecoder) ClientOption {
+	return func(c *Client) error {
+		if c.ResponseDecoders == nil {
+			c.ResponseDecoders = runtime.DefaultResponseDecoders.Clone()
+		}
+		c.ResponseDecoders.Register(mediaType, decoder)
+		return nil
+	}
+}
+
 // The interface specification for the client above.
 type ClientInterface interface {
 	// EnsureEverythingIsReferenced request
@@ -146,6 +162,11 @@ func (c *Client) EnsureEverythingIsReferenced(ctx context.Context) (*http.Respon
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -161,6 +182,11 @@ func (c *Client) Issue127(ctx context.Context) (*http.Response, error) {
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -176,6 +202,11 @@ func (c *Client) Issue30(ctx context.Context, pFallthrough string) (*http.Respon
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -191,6 +222,11 @@ func (c *Client) Issue41(ctx context.Context, n1param N5StartsWithNumber) (*http
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -206,6 +242,11 @@ func (c *Client) Issue9WithBody(ctx context.Context, params *Issue9Params, conte
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -221,6 +262,11 @@ func (c *Client) Issue9(ctx context.Context, params *Issue9Params, body Issue9JS
 	if c.RequestEditor != nil {
 		err = c.RequestEditor(ctx, req)
 		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
 			return nil, err
 		}
 	}
@@ -231,7 +277,8 @@ func (c *Client) Issue9(ctx context.Context, params *Issue9Params, body Issue9JS
 func NewEnsureEverythingIsReferencedRequest(server string) (*http.Request, error) {
 	var err error
 
-	queryUrl, err := url.Parse(server)
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
@@ -246,7 +293,8 @@ func NewEnsureEverythingIsReferencedRequest(server string) (*http.Request, error
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	var req *http.Request
+	req, err = http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -258,7 +306,8 @@ func NewEnsureEverythingIsReferencedRequest(server string) (*http.Request, error
 func NewIssue127Request(server string) (*http.Request, error) {
 	var err error
 
-	queryUrl, err := url.Parse(server)
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
@@ -273,7 +322,8 @@ func NewIssue127Request(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	var req *http.Request
+	req, err = http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -292,7 +342,8 @@ func NewIssue30Request(server string, pFallthrough string) (*http.Request, error
 		return nil, err
 	}
 
-	queryUrl, err := url.Parse(server)
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
@@ -307,7 +358,8 @@ func NewIssue30Request(server string, pFallthrough string) (*http.Request, error
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	var req *http.Request
+	req, err = http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -326,7 +378,8 @@ func NewIssue41Request(server string, n1param N5StartsWithNumber) (*http.Request
 		return nil, err
 	}
 
-	queryUrl, err := url.Parse(server)
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
@@ -341,7 +394,8 @@ func NewIssue41Request(server string, n1param N5StartsWithNumber) (*http.Request
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryUrl.String(), nil)
+	var req *http.Request
+	req, err = http.NewRequest("GET", queryUrl.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -364,7 +418,8 @@ func NewIssue9Request(server string, params *Issue9Params, body Issue9JSONReques
 func NewIssue9RequestWithBody(server string, params *Issue9Params, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	queryUrl, err := url.Parse(server)
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
@@ -381,9 +436,16 @@ func NewIssue9RequestWithBody(server string, params *Issue9Params, contentType s
 
 	queryValues := queryUrl.Query()
 
-	if queryFrag, err := runtime.StyleParam("form", true, "foo", params.Foo); err != nil {
+	var queryFrag string
+	var parsed url.Values
+	var queryParamBuf []byte
+	_ = queryFrag
+	_ = parsed
+	_ = queryParamBuf
+
+	if queryFrag, err = runtime.StyleParam("form", true, "foo", params.Foo); err != nil {
 		return nil, err
-	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+	} else if parsed, err = url.ParseQuery(queryFrag); err != nil {
 		return nil, err
 	} else {
 		for k, v := range parsed {
@@ -395,7 +457,8 @@ func NewIssue9RequestWithBody(server string, params *Issue9Params, contentType s
 
 	queryUrl.RawQuery = queryValues.Encode()
 
-	req, err := http.NewRequest("GET", queryUrl.String(), body)
+	var req *http.Request
+	req, err = http.NewRequest("GET", queryUrl.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -407,6 +470,10 @@ func NewIssue9RequestWithBody(server string, params *Issue9Params, contentType s
 // ClientWithResponses builds on ClientInterface to offer response payloads
 type ClientWithResponses struct {
 	ClientInterface
+
+	// The decoders of response bodies, from the ResponseDecoders of the
+	// Client.
+	decoders *runtime.ResponseDecoders
 }
 
 // NewClientWithResponses creates a new ClientWithResponses, which wraps
@@ -416,7 +483,7 @@ func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithRes
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+	return &ClientWithResponses{ClientInterface: client, decoders: client.ResponseDecoders}, nil
 }
 
 // WithBaseURL overrides the baseURL.
@@ -480,12 +547,14 @@ func (r EnsureEverythingIsReferencedResponse) StatusCode() int {
 }
 
 type Issue127Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *GenericObject
-	XML200       *GenericObject
-	YAML200      *GenericObject
-	JSONDefault  *GenericObject
+	Body                []byte
+	HTTPResponse        *http.Response
+	JSON200             *GenericObject
+	XML200              *GenericObject
+	TextMarkdown200     *GenericObject
+	YAML200             *GenericObject
+	JSONDefault         *GenericObject
+	TextMarkdownDefault *GenericObject
 }
 
 // Status returns HTTPResponse.Status
@@ -573,7 +642,7 @@ func (c *ClientWithResponses) EnsureEverythingIsReferencedWithResponse(ctx conte
 	if err != nil {
 		return nil, err
 	}
-	return ParseEnsureEverythingIsReferencedResponse(rsp)
+	return ParseEnsureEverythingIsReferencedResponseWithDecoders(rsp, c.decoders)
 }
 
 // Issue127WithResponse request returning *Issue127Response
@@ -582,7 +651,7 @@ func (c *ClientWithResponses) Issue127WithResponse(ctx context.Context) (*Issue1
 	if err != nil {
 		return nil, err
 	}
-	return ParseIssue127Response(rsp)
+	return ParseIssue127ResponseWithDecoders(rsp, c.decoders)
 }
 
 // Issue30WithResponse request returning *Issue30Response
@@ -591,7 +660,7 @@ func (c *ClientWithResponses) Issue30WithResponse(ctx context.Context, pFallthro
 	if err != nil {
 		return nil, err
 	}
-	return ParseIssue30Response(rsp)
+	return ParseIssue30ResponseWithDecoders(rsp, c.decoders)
 }
 
 // Issue41WithResponse request returning *Issue41Response
@@ -600,7 +669,7 @@ func (c *ClientWithResponses) Issue41WithResponse(ctx context.Context, n1param N
 	if err != nil {
 		return nil, err
 	}
-	return ParseIssue41Response(rsp)
+	return ParseIssue41ResponseWithDecoders(rsp, c.decoders)
 }
 
 // Issue9WithBodyWithResponse request with arbitrary body returning *Issue9Response
@@ -609,7 +678,7 @@ func (c *ClientWithResponses) Issue9WithBodyWithResponse(ctx context.Context, pa
 	if err != nil {
 		return nil, err
 	}
-	return ParseIssue9Response(rsp)
+	return ParseIssue9ResponseWithDecoders(rsp, c.decoders)
 }
 
 func (c *ClientWithResponses) Issue9WithResponse(ctx context.Context, params *Issue9Params, body Issue9JSONRequestBody) (*Issue9Response, error) {
@@ -617,11 +686,20 @@ func (c *ClientWithResponses) Issue9WithResponse(ctx context.Context, params *Is
 	if err != nil {
 		return nil, err
 	}
-	return ParseIssue9Response(rsp)
+	return ParseIssue9ResponseWithDecoders(rsp, c.decoders)
 }
 
 // ParseEnsureEverythingIsReferencedResponse parses an HTTP response from a EnsureEverythingIsReferencedWithResponse call
 func ParseEnsureEverythingIsReferencedResponse(rsp *http.Response) (*EnsureEverythingIsReferencedResponse, error) {
+	return ParseEnsureEverythingIsReferencedResponseWithDecoders(rsp, nil)
+}
+
+// ParseEnsureEverythingIsReferencedResponseWithDecoders parses an HTTP response from a EnsureEverythingIsReferencedWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseEnsureEverythingIsReferencedResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*EnsureEverythingIsReferencedResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
@@ -633,19 +711,25 @@ func ParseEnsureEverythingIsReferencedResponse(rsp *http.Response) (*EnsureEvery
 		HTTPResponse: rsp,
 	}
 
+	contentType := rsp.Header.Get("Content-Type")
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			AnyType1 *AnyType1 `json:"anyType1,omitempty"`
-
-			// This should be an interface{}
-			AnyType2         *AnyType2         `json:"anyType2,omitempty"`
-			CustomStringType *CustomStringType `json:"customStringType,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	case runtime.MediaTypeMatches(contentType, "application/json") && rsp.StatusCode == 200:
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest struct {
+				AnyType1 *AnyType1 `json:"anyType1,omitempty"`
+
+				// This should be an interface{}
+				AnyType2         *AnyType2         `json:"anyType2,omitempty"`
+				CustomStringType *CustomStringType `json:"customStringType,omitempty"`
+			}
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.JSON200 = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
 		}
-		response.JSON200 = &dest
 
 	}
 
@@ -654,6 +738,15 @@ func ParseEnsureEverythingIsReferencedResponse(rsp *http.Response) (*EnsureEvery
 
 // ParseIssue127Response parses an HTTP response from a Issue127WithResponse call
 func ParseIssue127Response(rsp *http.Response) (*Issue127Response, error) {
+	return ParseIssue127ResponseWithDecoders(rsp, nil)
+}
+
+// ParseIssue127ResponseWithDecoders parses an HTTP response from a Issue127WithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseIssue127ResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*Issue127Response, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
@@ -665,40 +758,79 @@ func ParseIssue127Response(rsp *http.Response) (*Issue127Response, error) {
 		HTTPResponse: rsp,
 	}
 
+	contentType := rsp.Header.Get("Content-Type")
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GenericObject
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	case runtime.MediaTypeMatches(contentType, "application/json") && rsp.StatusCode == 200:
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest GenericObject
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.JSON200 = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
 		}
-		response.YAML200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json"):
-		var dest GenericObject
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	case runtime.MediaTypeMatches(contentType, "application/xml") && rsp.StatusCode == 200:
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest GenericObject
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.XML200 = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
 		}
-		response.JSONDefault = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "xml") && rsp.StatusCode == 200:
-		var dest GenericObject
-		if err := xml.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	case runtime.MediaTypeMatches(contentType, "text/markdown") && rsp.StatusCode == 200:
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest GenericObject
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.TextMarkdown200 = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
 		}
-		response.YAML200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "yaml") && rsp.StatusCode == 200:
-		var dest GenericObject
-		if err := yaml.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	case runtime.MediaTypeMatches(contentType, "text/yaml") && rsp.StatusCode == 200:
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest GenericObject
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.YAML200 = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
 		}
-		response.YAML200 = &dest
 
-	case rsp.StatusCode == 200:
-	// Content-type (text/markdown) unsupported
+	case runtime.MediaTypeMatches(contentType, "application/json"):
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest GenericObject
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.JSONDefault = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
+		}
 
-	default:
-		// Content-type (text/markdown) unsupported
+	case runtime.MediaTypeMatches(contentType, "text/markdown"):
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest GenericObject
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.TextMarkdownDefault = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
+		}
 
 	}
 
@@ -707,6 +839,15 @@ func ParseIssue127Response(rsp *http.Response) (*Issue127Response, error) {
 
 // ParseIssue30Response parses an HTTP response from a Issue30WithResponse call
 func ParseIssue30Response(rsp *http.Response) (*Issue30Response, error) {
+	return ParseIssue30ResponseWithDecoders(rsp, nil)
+}
+
+// ParseIssue30ResponseWithDecoders parses an HTTP response from a Issue30WithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseIssue30ResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*Issue30Response, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
@@ -726,6 +867,15 @@ func ParseIssue30Response(rsp *http.Response) (*Issue30Response, error) {
 
 // ParseIssue41Response parses an HTTP response from a Issue41WithResponse call
 func ParseIssue41Response(rsp *http.Response) (*Issue41Response, error) {
+	return ParseIssue41ResponseWithDecoders(rsp, nil)
+}
+
+// ParseIssue41ResponseWithDecoders parses an HTTP response from a Issue41WithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseIssue41ResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*Issue41Response, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
@@ -745,6 +895,15 @@ func ParseIssue41Response(rsp *http.Response) (*Issue41Response, error) {
 
 // ParseIssue9Response parses an HTTP response from a Issue9WithResponse call
 func ParseIssue9Response(rsp *http.Response) (*Issue9Response, error) {
+	return ParseIssue9ResponseWithDecoders(rsp, nil)
+}
+
+// ParseIssue9ResponseWithDecoders parses an HTTP response from a Issue9WithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseIssue9ResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*Issue9Response, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
@@ -870,38 +1029,44 @@ type EchoRouter interface {
 }
 
 // RegisterHandlers adds each server route to the EchoRouter.
-func RegisterHandlers(router EchoRouter, si ServerInterface) {
+func RegisterHandlers(router EchoRouter, si ServerInterface, pathPrefix string) {
 
 	wrapper := ServerInterfaceWrapper{
 		Handler: si,
 	}
 
-	router.GET("/ensure-everything-is-referenced", wrapper.EnsureEverythingIsReferenced)
-	router.GET("/issues/127", wrapper.Issue127)
-	router.GET("/issues/30/:fallthrough", wrapper.Issue30)
-	router.GET("/issues/41/:1param", wrapper.Issue41)
-	router.GET("/issues/9", wrapper.Issue9)
+	router.GET(path.Join(pathPrefix, "/ensure-everything-is-referenced"), wrapper.EnsureEverythingIsReferenced)
+	router.GET(path.Join(pathPrefix, "/issues/127"), wrapper.Issue127)
+	router.GET(path.Join(pathPrefix, "/issues/30/:fallthrough"), wrapper.Issue30)
+	router.GET(path.Join(pathPrefix, "/issues/41/:1param"), wrapper.Issue41)
+	router.GET(path.Join(pathPrefix, "/issues/9"), wrapper.Issue9)
+
+}
 
+// DecodeIssue9JSONRequestBody decodes the application/json body of Issue9 requests. It returns EOF when the request has no body.
+func DecodeIssue9JSONRequestBody(r *http.Request) (Issue9JSONRequestBody, error) {
+	var body Issue9JSONRequestBody
+	err := json.NewDecoder(r.Body).Decode(&body)
+	return body, err
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/7RWwW4bNxD9lQFboBdZK8kpiuytDYLChzZBbKCH2gdqOdIy3h0y5FD2Qth/L4YrWRtL",
-	"chu4OZmWODPvzTy+0VZVrvWOkDiqcqtiVWOr8/Hna9aB41+W6z9Tu8QgHxqMVbCerSNVqpvaRhhCgHSL",
-	"EHMIPFiuQQMNYRPFnUdVKrf8jBWrfqJ+pe6m8zhX5fbw3+JcgdqlxsASQRNYYgwrXeG2l0TvUmTXXnOw",
-	"tL7JVbZq5UKrWZWqyl8e6sd8TcJ+R8Jgqw8DoHL7HGHfT5SllTuBCCNDpSNGWLkAGx2sSxFsjCl/lMiA",
-	"22AAti1O4WODOiJoY0AD72Ml9JY0dbBMa1jZRzTTWxKglhvcV7nGsMnt22CIQ/X5dDadCQHnkbS3qlSX",
-	"09l0ribKa67z3AqkmAJe4AZDx7Wl9YWNFwFXGJAqNHJnjXym2UjGO0sM+GgjR4gOuNYMB5lApUmGUQXU",
-	"jAYsAdc23lL0WIEmA+RYLviQCE3m5TwGLWWujCrV+wzw/RO+q/jpgG6iAkbvKGJms5jN5E/liJEyaO19",
-	"Y6ucrfgcBfletnLyQWqxHaL1QWfqx4ArVaofigOVYif34kmP/WQfs/iPMQuJqU7I8KXYI9mK4I402Gcd",
-	"FoO2ivnil7Oj+0PfI0hTIVFM3rsgk8lNe2SQxBGMo58YfEBsPcPhVv52emJMV1JXqr5yJC814uuHKHTH",
-	"uR7b5jWphHzR6nBv3AO9OlGnX4NG0hhc6dTwd2ze/8T4mfIuZ8V2pZuG6+DSuu6P9fcJo5iVgXvsHlww",
-	"Y9P2AbPDiVGIXeplg3lb7ES3E/QJ7V3OvpYepabpxemCbpExRFX+vVVWAIj7qYmStKpUI7A5w5dkgxgf",
-	"h4STUU+e7YX+bkT6zbzYznOp/uyz+7hHMtp+ltbD/nvafieYvRkc+994DPVfpPDSWI83eN/fHXd0RPrt",
-	"WarvGovEkAHF7BlgqXIhYMVNJ+cmGTR5KQpaWWC5DUtnOtkKt3Tge9Zt3p5py5eEoRvN17lvm+twGSP/",
-	"5kz3Le9v927HnfiQD7rJzMT8j/rZT1R+DjsGKTSqVDWzL4tit7jlp8DUIPpW+6m2qr/r/wkAAP//q2vq",
-	"DYsJAAA=",
+	"H4sIAAAAAAAC/7RVUW/bNhD+K4fbgL04lp10GKq3rSiGPGwtmgB7WPJAi2eLjXRkyaMTwdB/H0g5kRrb",
+	"2YqsT2Es3t33fffxboeVbZ1lYglY7jBUNbUqH3++EuUl/GWk/jO2K/LpR02h8saJsYwlXtcmwBACrFqC",
+	"kEPg3kgNCngIm6F0jrBEu/pMlWA/w1+5u+4cLbHcjf+dnypQ29hoWBEoBsNCfq0q2vUp0bsYxLZX4g1v",
+	"rnOVHa6tb5VgiVX+ONYP+VoK+52YvKk+DIDK3XOEfT9Dw2t7BBEFgUoFCrC2HrbKGxsDmBBi/imyBrsl",
+	"D2JamsPHhlQgUFqDAnmMTaE3rLiDVdzA2jyQnt9wAmqkoccqV+S3Wb4t+TBUX84X80UiYB2xcgZLvJgv",
+	"5kucoVNS574VxCF6OqMt+U5qw5szE848rckTV6TTnQ3JCbGJtbOGBejBBAkQLEitBEabQKU4NaPypIQ0",
+	"GAapTbjh4KgCxRrYSrrgfGTSmZd15FUqc6mxxPcZ4PsnfJfh04huhp6CsxwoszlfLNKfyrIQZ9DKucZU",
+	"OVvxOVgebZtOzqdaYoZoNfoMf/S0xhJ/KEYqxRAXiic/9jNUEzf+h5jzFFMdseFLsQe2TYY78GCffVgM",
+	"3iqW57+cbN0f6o4giQqRQ3TO+tSZLNqDQEocQFv+ScB5otYJjLfy1/mRNl2muqnqK1vykhBfP8REd5rr",
+	"oW1ekyqRL1rl77S951cn6tRr0KQ0mtYqNvIdxfufGD9z3sWi2K1V00jtbdzU/aH/PlFIw0rDHXX31uvp",
+	"0Hae8oRLgyKNS7VqKG+Lven2hj7ivYvF19bj2DR9mnRetSTkA5Z/79AkAGn64QxTWixxAjZn+BKNT4NP",
+	"fKTZRJNne6G/nZB+syx2y1yqP/nsPj4imWw/w5th/z1tvyPM3gwT+994DPVfpPBSWw83eN/fHio6If32",
+	"JNV3jSEWyIBCnhlguLLeUyVNl85N1KTzUkxoKcggw8rqLm2FGx75npw2b0/I8iWS7yb9tfbb+jpcpiC/",
+	"Wd19y/vbv9upEh/yQTWZGfZH9OxnmJ/DnkH0DZZYi7iyKPaLWyjIXBO5Vrm5Mtjf9v8MAKtr6g2LCQAA",
 }
 
 // GetSwagger returns the Swagger specification corresponding to the generated code
@@ -5,6 +5,7 @@ package server
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/deepmap/oapi-codegen/pkg/runtime"
 	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
@@ -116,13 +117,13 @@ type UpdateResource3JSONBody struct {
 	Name *string `json:"name,omitempty"`
 }
 
-// CreateResourceRequestBody defines body for CreateResource for application/json ContentType.
+// CreateResourceJSONRequestBody defines body for CreateResource for application/json ContentType.
 type CreateResourceJSONRequestBody CreateResourceJSONBody
 
-// CreateResource2RequestBody defines body for CreateResource2 for application/json ContentType.
+// CreateResource2JSONRequestBody defines body for CreateResource2 for application/json ContentType.
 type CreateResource2JSONRequestBody CreateResource2JSONBody
 
-// UpdateResource3RequestBody defines body for UpdateResource3 for application/json ContentType.
+// UpdateResource3JSONRequestBody defines body for UpdateResource3 for application/json ContentType.
 type UpdateResource3JSONRequestBody UpdateResource3JSONBody
 
 type ServerInterface interface {
@@ -442,3 +443,24 @@ func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
 
 	return r
 }
+
+// DecodeCreateResourceJSONRequestBody decodes the application/json body of CreateResource requests. It returns EOF when the request has no body.
+func DecodeCreateResourceJSONRequestBody(r *http.Request) (CreateResourceJSONRequestBody, error) {
+	var body CreateResourceJSONRequestBody
+	err := json.NewDecoder(r.Body).Decode(&body)
+	return body, err
+}
+
+// DecodeCreateResource2JSONRequestBody decodes the application/json body of CreateResource2 requests. It returns EOF when the request has no body.
+func DecodeCreateResource2JSONRequestBody(r *http.Request) (CreateResource2JSONRequestBody, error) {
+	var body CreateResource2JSONRequestBody
+	err := json.NewDecoder(r.Body).Decode(&body)
+	return body, err
+}
+
+// DecodeUpdateResource3JSONRequestBody decodes the application/json body of UpdateResource3 requests. It returns EOF when the request has no body.
+func DecodeUpdateResource3JSONRequestBody(r *http.Request) (UpdateResource3JSONRequestBody, error) {
+	var body UpdateResource3JSONRequestBody
+	err := json.NewDecoder(r.Body).Decode(&body)
+	return body, err
+}
@@ -0,0 +1,143 @@
+// Package servertest holds what the tests of the server targets share, which
+// are generated from ../parameters/parameters.yaml: a Recorder for the
+// parameters their handlers are given, and the requests which every target
+// binds the same way. Each target's own tests wrap the Recorder in a server
+// with its handler signatures, and go on to test the way it routes.
+package servertest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Recorder records the parameters which the handlers of a server are given,
+// by operation ID.
+type Recorder struct {
+	params map[string]interface{}
+}
+
+// Record records the parameters of a call of an operation.
+func (r *Recorder) Record(operationID string, params interface{}) {
+	if r.params == nil {
+		r.params = make(map[string]interface{})
+	}
+	r.params[operationID] = params
+}
+
+// Params returns the parameters which the last call of an operation was
+// given, and whether it was called at all.
+func (r *Recorder) Params(operationID string) (interface{}, bool) {
+	params, found := r.params[operationID]
+	return params, found
+}
+
+// Case is a request for one of the operations, with the status which the
+// server should respond with, and the parameters which its handler should be
+// given, as JSON, since each target generates types of its own.
+type Case struct {
+	Name      string
+	Request   *http.Request
+	Status    int
+	Operation string // The operation whose handler is called, if any
+	Params    string
+}
+
+// Cases returns the requests which every server target binds the same way.
+// The handler of GetHeader responds with 202 Accepted, so that the tests see
+// that the responses of handlers get through.
+func Cases() []Case {
+	header := httptest.NewRequest("GET", "/header", nil)
+	header.Header.Set("X-Primitive", "5")
+	cookie := httptest.NewRequest("GET", "/cookie", nil)
+	cookie.AddCookie(&http.Cookie{Name: "p", Value: "7"})
+
+	return []Case{
+		{
+			Name:      "label explode array",
+			Request:   httptest.NewRequest("GET", "/labelExplodeArray/.3.4.5", nil),
+			Status:    http.StatusOK,
+			Operation: "GetLabelExplodeArray",
+			Params:    `[3, 4, 5]`,
+		},
+		{
+			Name:      "simple explode object",
+			Request:   httptest.NewRequest("GET", "/simpleExplodeObject/role=admin,firstName=Alex", nil),
+			Status:    http.StatusOK,
+			Operation: "GetSimpleExplodeObject",
+			Params:    `{"firstName": "Alex", "role": "admin"}`,
+		},
+		{
+			Name:      "pass through",
+			Request:   httptest.NewRequest("GET", "/passThrough/some%20string", nil),
+			Status:    http.StatusOK,
+			Operation: "GetPassThrough",
+			Params:    `"some string"`,
+		},
+		{
+			// The properties of an exploded object are query arguments of
+			// their own, so eo is bound, although none of them are given.
+			Name:      "query form",
+			Request:   httptest.NewRequest("GET", "/queryForm?ea=3&ea=4&a=5,6", nil),
+			Status:    http.StatusOK,
+			Operation: "GetQueryForm",
+			Params:    `{"ea": [3, 4], "a": [5, 6], "eo": {"firstName": "", "role": ""}}`,
+		},
+		{
+			Name:      "header",
+			Request:   header,
+			Status:    http.StatusAccepted,
+			Operation: "GetHeader",
+			Params:    `{"X-Primitive": 5}`,
+		},
+		{
+			Name:      "cookie",
+			Request:   cookie,
+			Status:    http.StatusOK,
+			Operation: "GetCookie",
+			Params:    `{"p": 7}`,
+		},
+		{
+			// Parameters which can't be bound are rejected before the handler
+			// is called.
+			Name:    "invalid path parameter",
+			Request: httptest.NewRequest("GET", "/labelExplodeArray/.3.x", nil),
+			Status:  http.StatusBadRequest,
+		},
+		{
+			Name:    "unknown path",
+			Request: httptest.NewRequest("GET", "/unknown", nil),
+			Status:  http.StatusNotFound,
+		},
+	}
+}
+
+// Run sends each of the Cases to the handler, checking the parameters which
+// the server records into the recorder.
+func Run(t *testing.T, handler http.Handler, recorder *Recorder) {
+	for _, c := range Cases() {
+		t.Run(c.Name, func(t *testing.T) {
+			rr := Do(handler, c.Request)
+			assert.Equal(t, c.Status, rr.Code)
+			if c.Operation == "" {
+				return
+			}
+			params, found := recorder.Params(c.Operation)
+			if assert.True(t, found, "%s wasn't called", c.Operation) {
+				actual, err := json.Marshal(params)
+				assert.NoError(t, err)
+				assert.JSONEq(t, c.Params, string(actual))
+			}
+		})
+	}
+}
+
+// Do sends a request to the handler, and returns its response.
+func Do(handler http.Handler, req *http.Request) *httptest.ResponseRecorder {
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	return rr
+}
@@ -0,0 +1,3 @@
+package stdhttp
+
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --generate=types,std-http --package=stdhttp -o stdhttp.gen.go ../parameters/parameters.yaml
@@ -0,0 +1,697 @@
+// Package stdhttp provides primitives to interact the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen DO NOT EDIT.
+package stdhttp
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"net/http"
+	"net/url"
+)
+
+// ComplexObject defines model for ComplexObject.
+type ComplexObject struct {
+	Id      int    `json:"Id"`
+	IsAdmin bool   `json:"IsAdmin"`
+	Object  Object `json:"Object"`
+}
+
+// Object defines model for Object.
+type Object struct {
+	FirstName string `json:"firstName"`
+	Role      string `json:"role"`
+}
+
+// GetCookieParams defines parameters for GetCookie.
+type GetCookieParams struct {
+
+	// primitive
+	P *int32 `json:"p,omitempty"`
+
+	// primitive
+	Ep *int32 `json:"ep,omitempty"`
+
+	// exploded array
+	Ea *[]int32 `json:"ea,omitempty"`
+
+	// array
+	A *[]int32 `json:"a,omitempty"`
+
+	// exploded object
+	Eo *Object `json:"eo,omitempty"`
+
+	// object
+	O *Object `json:"o,omitempty"`
+
+	// complex object
+	Co *ComplexObject `json:"co,omitempty"`
+}
+
+// GetHeaderParams defines parameters for GetHeader.
+type GetHeaderParams struct {
+
+	// primitive
+	XPrimitive *int32 `json:"X-Primitive,omitempty"`
+
+	// primitive
+	XPrimitiveExploded *int32 `json:"X-Primitive-Exploded,omitempty"`
+
+	// exploded array
+	XArrayExploded *[]int32 `json:"X-Array-Exploded,omitempty"`
+
+	// array
+	XArray *[]int32 `json:"X-Array,omitempty"`
+
+	// exploded object
+	XObjectExploded *Object `json:"X-Object-Exploded,omitempty"`
+
+	// object
+	XObject *Object `json:"X-Object,omitempty"`
+
+	// complex object
+	XComplexObject *ComplexObject `json:"X-Complex-Object,omitempty"`
+}
+
+// GetDeepObjectParams defines parameters for GetDeepObject.
+type GetDeepObjectParams struct {
+
+	// deep object
+	DeepObj ComplexObject `json:"deepObj"`
+}
+
+// GetQueryFormParams defines parameters for GetQueryForm.
+type GetQueryFormParams struct {
+
+	// exploded array
+	Ea *[]int32 `json:"ea,omitempty"`
+
+	// array
+	A *[]int32 `json:"a,omitempty"`
+
+	// exploded object
+	Eo *Object `json:"eo,omitempty"`
+
+	// object
+	O *Object `json:"o,omitempty"`
+
+	// exploded primitive
+	Ep *int32 `json:"ep,omitempty"`
+
+	// primitive
+	P *int32 `json:"p,omitempty"`
+
+	// complex object
+	Co *ComplexObject `json:"co,omitempty"`
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /contentObject/{param})
+	GetContentObject(w http.ResponseWriter, r *http.Request, param ComplexObject)
+
+	// (GET /cookie)
+	GetCookie(w http.ResponseWriter, r *http.Request, params GetCookieParams)
+
+	// (GET /header)
+	GetHeader(w http.ResponseWriter, r *http.Request, params GetHeaderParams)
+
+	// (GET /labelExplodeArray/{.param*})
+	GetLabelExplodeArray(w http.ResponseWriter, r *http.Request, param []int32)
+
+	// (GET /labelExplodeObject/{.param*})
+	GetLabelExplodeObject(w http.ResponseWriter, r *http.Request, param Object)
+
+	// (GET /labelNoExplodeArray/{.param})
+	GetLabelNoExplodeArray(w http.ResponseWriter, r *http.Request, param []int32)
+
+	// (GET /labelNoExplodeObject/{.param})
+	GetLabelNoExplodeObject(w http.ResponseWriter, r *http.Request, param Object)
+
+	// (GET /matrixExplodeArray/{.id*})
+	GetMatrixExplodeArray(w http.ResponseWriter, r *http.Request, id []int32)
+
+	// (GET /matrixExplodeObject/{.id*})
+	GetMatrixExplodeObject(w http.ResponseWriter, r *http.Request, id Object)
+
+	// (GET /matrixNoExplodeArray/{.id})
+	GetMatrixNoExplodeArray(w http.ResponseWriter, r *http.Request, id []int32)
+
+	// (GET /matrixNoExplodeObject/{.id})
+	GetMatrixNoExplodeObject(w http.ResponseWriter, r *http.Request, id Object)
+
+	// (GET /passThrough/{param})
+	GetPassThrough(w http.ResponseWriter, r *http.Request, param string)
+
+	// (GET /queryDeepObject)
+	GetDeepObject(w http.ResponseWriter, r *http.Request, params GetDeepObjectParams)
+
+	// (GET /queryForm)
+	GetQueryForm(w http.ResponseWriter, r *http.Request, params GetQueryFormParams)
+
+	// (GET /simpleExplodeArray/{param*})
+	GetSimpleExplodeArray(w http.ResponseWriter, r *http.Request, param []int32)
+
+	// (GET /simpleExplodeObject/{param*})
+	GetSimpleExplodeObject(w http.ResponseWriter, r *http.Request, param Object)
+
+	// (GET /simpleNoExplodeArray/{param})
+	GetSimpleNoExplodeArray(w http.ResponseWriter, r *http.Request, param []int32)
+
+	// (GET /simpleNoExplodeObject/{param})
+	GetSimpleNoExplodeObject(w http.ResponseWriter, r *http.Request, param Object)
+
+	// (GET /simplePrimitive/{param})
+	GetSimplePrimitive(w http.ResponseWriter, r *http.Request, param int32)
+}
+
+// ServerInterfaceWrapper converts requests to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// GetContentObject converts the request to params.
+func (siw *ServerInterfaceWrapper) GetContentObject(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	// ------------- Path parameter "param" -------------
+	var param ComplexObject
+
+	if err := json.Unmarshal([]byte(pathParams["param"]), &param); err != nil {
+		http.Error(w, "Error unmarshaling parameter 'param' as JSON", http.StatusBadRequest)
+		return
+	}
+
+	siw.Handler.GetContentObject(w, r, param)
+}
+
+// GetCookie converts the request to params.
+func (siw *ServerInterfaceWrapper) GetCookie(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+
+	// Parameter object where we will unmarshal all parameters from the request
+	var params GetCookieParams
+
+	if cookie, err := r.Cookie("p"); err == nil {
+
+		var value int32
+		if err := runtime.BindStyledParameter("simple", false, "p", cookie.Value, &value); err != nil {
+			http.Error(w, fmt.Sprintf("Invalid format for parameter p: %s", err), http.StatusBadRequest)
+			return
+		}
+		params.P = &value
+
+	}
+
+	if cookie, err := r.Cookie("ep"); err == nil {
+
+		var value int32
+		if err := runtime.BindStyledParameter("simple", true, "ep", cookie.Value, &value); err != nil {
+			http.Error(w, fmt.Sprintf("Invalid format for parameter ep: %s", err), http.StatusBadRequest)
+			return
+		}
+		params.Ep = &value
+
+	}
+
+	if cookie, err := r.Cookie("ea"); err == nil {
+
+		var value []int32
+		if err := runtime.BindStyledParameter("simple", true, "ea", cookie.Value, &value); err != nil {
+			http.Error(w, fmt.Sprintf("Invalid format for parameter ea: %s", err), http.StatusBadRequest)
+			return
+		}
+		params.Ea = &value
+
+	}
+
+	if cookie, err := r.Cookie("a"); err == nil {
+
+		var value []int32
+		if err := runtime.BindStyledParameter("simple", false, "a", cookie.Value, &value); err != nil {
+			http.Error(w, fmt.Sprintf("Invalid format for parameter a: %s", err), http.StatusBadRequest)
+			return
+		}
+		params.A = &value
+
+	}
+
+	if cookie, err := r.Cookie("eo"); err == nil {
+
+		var value Object
+		if err := runtime.BindStyledParameter("simple", true, "eo", cookie.Value, &value); err != nil {
+			http.Error(w, fmt.Sprintf("Invalid format for parameter eo: %s", err), http.StatusBadRequest)
+			return
+		}
+		params.Eo = &value
+
+	}
+
+	if cookie, err := r.Cookie("o"); err == nil {
+
+		var value Object
+		if err := runtime.BindStyledParameter("simple", false, "o", cookie.Value, &value); err != nil {
+			http.Error(w, fmt.Sprintf("Invalid format for parameter o: %s", err), http.StatusBadRequest)
+			return
+		}
+		params.O = &value
+
+	}
+
+	if cookie, err := r.Cookie("co"); err == nil {
+
+		var value ComplexObject
+		decoded, err := url.QueryUnescape(cookie.Value)
+		if err != nil {
+			http.Error(w, "Error unescaping cookie parameter 'co'", http.StatusBadRequest)
+			return
+		}
+		if err := json.Unmarshal([]byte(decoded), &value); err != nil {
+			http.Error(w, "Error unmarshaling parameter 'co' as JSON", http.StatusBadRequest)
+			return
+		}
+		params.Co = &value
+
+	}
+
+	siw.Handler.GetCookie(w, r, params)
+}
+
+// GetHeader converts the request to params.
+func (siw *ServerInterfaceWrapper) GetHeader(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+
+	// Parameter object where we will unmarshal all parameters from the request
+	var params GetHeaderParams
+
+	headers := r.Header
+	// ------------- Optional header parameter "X-Primitive" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Primitive")]; found {
+		var XPrimitive int32
+		n := len(valueList)
+		if n != 1 {
+			http.Error(w, fmt.Sprintf("Expected one value for X-Primitive, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		if err := runtime.BindStyledParameter("simple", false, "X-Primitive", valueList[0], &XPrimitive); err != nil {
+			http.Error(w, fmt.Sprintf("Invalid format for parameter X-Primitive: %s", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XPrimitive = &XPrimitive
+	}
+	// ------------- Optional header parameter "X-Primitive-Exploded" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Primitive-Exploded")]; found {
+		var XPrimitiveExploded int32
+		n := len(valueList)
+		if n != 1 {
+			http.Error(w, fmt.Sprintf("Expected one value for X-Primitive-Exploded, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		if err := runtime.BindStyledParameter("simple", true, "X-Primitive-Exploded", valueList[0], &XPrimitiveExploded); err != nil {
+			http.Error(w, fmt.Sprintf("Invalid format for parameter X-Primitive-Exploded: %s", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XPrimitiveExploded = &XPrimitiveExploded
+	}
+	// ------------- Optional header parameter "X-Array-Exploded" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Array-Exploded")]; found {
+		var XArrayExploded []int32
+		n := len(valueList)
+		if n != 1 {
+			http.Error(w, fmt.Sprintf("Expected one value for X-Array-Exploded, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		if err := runtime.BindStyledParameter("simple", true, "X-Array-Exploded", valueList[0], &XArrayExploded); err != nil {
+			http.Error(w, fmt.Sprintf("Invalid format for parameter X-Array-Exploded: %s", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XArrayExploded = &XArrayExploded
+	}
+	// ------------- Optional header parameter "X-Array" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Array")]; found {
+		var XArray []int32
+		n := len(valueList)
+		if n != 1 {
+			http.Error(w, fmt.Sprintf("Expected one value for X-Array, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		if err := runtime.BindStyledParameter("simple", false, "X-Array", valueList[0], &XArray); err != nil {
+			http.Error(w, fmt.Sprintf("Invalid format for parameter X-Array: %s", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XArray = &XArray
+	}
+	// ------------- Optional header parameter "X-Object-Exploded" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Object-Exploded")]; found {
+		var XObjectExploded Object
+		n := len(valueList)
+		if n != 1 {
+			http.Error(w, fmt.Sprintf("Expected one value for X-Object-Exploded, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		if err := runtime.BindStyledParameter("simple", true, "X-Object-Exploded", valueList[0], &XObjectExploded); err != nil {
+			http.Error(w, fmt.Sprintf("Invalid format for parameter X-Object-Exploded: %s", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XObjectExploded = &XObjectExploded
+	}
+	// ------------- Optional header parameter "X-Object" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Object")]; found {
+		var XObject Object
+		n := len(valueList)
+		if n != 1 {
+			http.Error(w, fmt.Sprintf("Expected one value for X-Object, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		if err := runtime.BindStyledParameter("simple", false, "X-Object", valueList[0], &XObject); err != nil {
+			http.Error(w, fmt.Sprintf("Invalid format for parameter X-Object: %s", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XObject = &XObject
+	}
+	// ------------- Optional header parameter "X-Complex-Object" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Complex-Object")]; found {
+		var XComplexObject ComplexObject
+		n := len(valueList)
+		if n != 1 {
+			http.Error(w, fmt.Sprintf("Expected one value for X-Complex-Object, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		if err := json.Unmarshal([]byte(valueList[0]), &XComplexObject); err != nil {
+			http.Error(w, "Error unmarshaling parameter 'X-Complex-Object' as JSON", http.StatusBadRequest)
+			return
+		}
+
+		params.XComplexObject = &XComplexObject
+	}
+
+	siw.Handler.GetHeader(w, r, params)
+}
+
+// GetLabelExplodeArray converts the request to params.
+func (siw *ServerInterfaceWrapper) GetLabelExplodeArray(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	// ------------- Path parameter "param" -------------
+	var param []int32
+
+	if err := runtime.BindStyledParameter("label", true, "param", pathParams["param"], &param); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter param: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	siw.Handler.GetLabelExplodeArray(w, r, param)
+}
+
+// GetLabelExplodeObject converts the request to params.
+func (siw *ServerInterfaceWrapper) GetLabelExplodeObject(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	// ------------- Path parameter "param" -------------
+	var param Object
+
+	if err := runtime.BindStyledParameter("label", true, "param", pathParams["param"], &param); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter param: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	siw.Handler.GetLabelExplodeObject(w, r, param)
+}
+
+// GetLabelNoExplodeArray converts the request to params.
+func (siw *ServerInterfaceWrapper) GetLabelNoExplodeArray(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	// ------------- Path parameter "param" -------------
+	var param []int32
+
+	if err := runtime.BindStyledParameter("label", false, "param", pathParams["param"], &param); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter param: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	siw.Handler.GetLabelNoExplodeArray(w, r, param)
+}
+
+// GetLabelNoExplodeObject converts the request to params.
+func (siw *ServerInterfaceWrapper) GetLabelNoExplodeObject(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	// ------------- Path parameter "param" -------------
+	var param Object
+
+	if err := runtime.BindStyledParameter("label", false, "param", pathParams["param"], &param); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter param: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	siw.Handler.GetLabelNoExplodeObject(w, r, param)
+}
+
+// GetMatrixExplodeArray converts the request to params.
+func (siw *ServerInterfaceWrapper) GetMatrixExplodeArray(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	// ------------- Path parameter "id" -------------
+	var id []int32
+
+	if err := runtime.BindStyledParameter("matrix", true, "id", pathParams["id"], &id); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter id: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	siw.Handler.GetMatrixExplodeArray(w, r, id)
+}
+
+// GetMatrixExplodeObject converts the request to params.
+func (siw *ServerInterfaceWrapper) GetMatrixExplodeObject(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	// ------------- Path parameter "id" -------------
+	var id Object
+
+	if err := runtime.BindStyledParameter("matrix", true, "id", pathParams["id"], &id); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter id: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	siw.Handler.GetMatrixExplodeObject(w, r, id)
+}
+
+// GetMatrixNoExplodeArray converts the request to params.
+func (siw *ServerInterfaceWrapper) GetMatrixNoExplodeArray(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	// ------------- Path parameter "id" -------------
+	var id []int32
+
+	if err := runtime.BindStyledParameter("matrix", false, "id", pathParams["id"], &id); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter id: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	siw.Handler.GetMatrixNoExplodeArray(w, r, id)
+}
+
+// GetMatrixNoExplodeObject converts the request to params.
+func (siw *ServerInterfaceWrapper) GetMatrixNoExplodeObject(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	// ------------- Path parameter "id" -------------
+	var id Object
+
+	if err := runtime.BindStyledParameter("matrix", false, "id", pathParams["id"], &id); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter id: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	siw.Handler.GetMatrixNoExplodeObject(w, r, id)
+}
+
+// GetPassThrough converts the request to params.
+func (siw *ServerInterfaceWrapper) GetPassThrough(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	// ------------- Path parameter "param" -------------
+	var param string
+
+	param = pathParams["param"]
+
+	siw.Handler.GetPassThrough(w, r, param)
+}
+
+// GetDeepObject converts the request to params.
+func (siw *ServerInterfaceWrapper) GetDeepObject(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+
+	// Parameter object where we will unmarshal all parameters from the request
+	var params GetDeepObjectParams
+	// ------------- Required query parameter "deepObj" -------------
+
+	if err := runtime.BindQueryParameter("deepObject", true, true, "deepObj", r.URL.Query(), &params.DeepObj); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter deepObj: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	siw.Handler.GetDeepObject(w, r, params)
+}
+
+// GetQueryForm converts the request to params.
+func (siw *ServerInterfaceWrapper) GetQueryForm(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+
+	// Parameter object where we will unmarshal all parameters from the request
+	var params GetQueryFormParams
+	// ------------- Optional query parameter "ea" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "ea", r.URL.Query(), &params.Ea); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter ea: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "a" -------------
+
+	if err := runtime.BindQueryParameter("form", false, false, "a", r.URL.Query(), &params.A); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter a: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "eo" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "eo", r.URL.Query(), &params.Eo); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter eo: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "o" -------------
+
+	if err := runtime.BindQueryParameter("form", false, false, "o", r.URL.Query(), &params.O); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter o: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "ep" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "ep", r.URL.Query(), &params.Ep); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter ep: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "p" -------------
+
+	if err := runtime.BindQueryParameter("form", false, false, "p", r.URL.Query(), &params.P); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter p: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "co" -------------
+
+	if paramValue := r.URL.Query().Get("co"); paramValue != "" {
+
+		var value ComplexObject
+		if err := json.Unmarshal([]byte(paramValue), &value); err != nil {
+			http.Error(w, "Error unmarshaling parameter 'co' as JSON", http.StatusBadRequest)
+			return
+		}
+		params.Co = &value
+
+	}
+
+	siw.Handler.GetQueryForm(w, r, params)
+}
+
+// GetSimpleExplodeArray converts the request to params.
+func (siw *ServerInterfaceWrapper) GetSimpleExplodeArray(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	// ------------- Path parameter "param" -------------
+	var param []int32
+
+	if err := runtime.BindStyledParameter("simple", true, "param", pathParams["param"], &param); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter param: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	siw.Handler.GetSimpleExplodeArray(w, r, param)
+}
+
+// GetSimpleExplodeObject converts the request to params.
+func (siw *ServerInterfaceWrapper) GetSimpleExplodeObject(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	// ------------- Path parameter "param" -------------
+	var param Object
+
+	if err := runtime.BindStyledParameter("simple", true, "param", pathParams["param"], &param); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter param: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	siw.Handler.GetSimpleExplodeObject(w, r, param)
+}
+
+// GetSimpleNoExplodeArray converts the request to params.
+func (siw *ServerInterfaceWrapper) GetSimpleNoExplodeArray(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	// ------------- Path parameter "param" -------------
+	var param []int32
+
+	if err := runtime.BindStyledParameter("simple", false, "param", pathParams["param"], &param); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter param: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	siw.Handler.GetSimpleNoExplodeArray(w, r, param)
+}
+
+// GetSimpleNoExplodeObject converts the request to params.
+func (siw *ServerInterfaceWrapper) GetSimpleNoExplodeObject(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	// ------------- Path parameter "param" -------------
+	var param Object
+
+	if err := runtime.BindStyledParameter("simple", false, "param", pathParams["param"], &param); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter param: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	siw.Handler.GetSimpleNoExplodeObject(w, r, param)
+}
+
+// GetSimplePrimitive converts the request to params.
+func (siw *ServerInterfaceWrapper) GetSimplePrimitive(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	// ------------- Path parameter "param" -------------
+	var param int32
+
+	if err := runtime.BindStyledParameter("simple", false, "param", pathParams["param"], &param); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter param: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	siw.Handler.GetSimplePrimitive(w, r, param)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerFromMux(si, http.NewServeMux())
+}
+
+// HandlerFromMux registers the routes of the OpenAPI spec on the provided
+// ServeMux, under the literal prefixes of their paths, and returns it.
+func HandlerFromMux(si ServerInterface, m *http.ServeMux) http.Handler {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+	router := runtime.NewRouter(
+		runtime.Route{Method: "GET", Path: "/contentObject/{param}", Handler: wrapper.GetContentObject},
+		runtime.Route{Method: "GET", Path: "/cookie", Handler: wrapper.GetCookie},
+		runtime.Route{Method: "GET", Path: "/header", Handler: wrapper.GetHeader},
+		runtime.Route{Method: "GET", Path: "/labelExplodeArray/{param}", Handler: wrapper.GetLabelExplodeArray},
+		runtime.Route{Method: "GET", Path: "/labelExplodeObject/{param}", Handler: wrapper.GetLabelExplodeObject},
+		runtime.Route{Method: "GET", Path: "/labelNoExplodeArray/{param}", Handler: wrapper.GetLabelNoExplodeArray},
+		runtime.Route{Method: "GET", Path: "/labelNoExplodeObject/{param}", Handler: wrapper.GetLabelNoExplodeObject},
+		runtime.Route{Method: "GET", Path: "/matrixExplodeArray/{id}", Handler: wrapper.GetMatrixExplodeArray},
+		runtime.Route{Method: "GET", Path: "/matrixExplodeObject/{id}", Handler: wrapper.GetMatrixExplodeObject},
+		runtime.Route{Method: "GET", Path: "/matrixNoExplodeArray/{id}", Handler: wrapper.GetMatrixNoExplodeArray},
+		runtime.Route{Method: "GET", Path: "/matrixNoExplodeObject/{id}", Handler: wrapper.GetMatrixNoExplodeObject},
+		runtime.Route{Method: "GET", Path: "/passThrough/{param}", Handler: wrapper.GetPassThrough},
+		runtime.Route{Method: "GET", Path: "/queryDeepObject", Handler: wrapper.GetDeepObject},
+		runtime.Route{Method: "GET", Path: "/queryForm", Handler: wrapper.GetQueryForm},
+		runtime.Route{Method: "GET", Path: "/simpleExplodeArray/{param}", Handler: wrapper.GetSimpleExplodeArray},
+		runtime.Route{Method: "GET", Path: "/simpleExplodeObject/{param}", Handler: wrapper.GetSimpleExplodeObject},
+		runtime.Route{Method: "GET", Path: "/simpleNoExplodeArray/{param}", Handler: wrapper.GetSimpleNoExplodeArray},
+		runtime.Route{Method: "GET", Path: "/simpleNoExplodeObject/{param}", Handler: wrapper.GetSimpleNoExplodeObject},
+		runtime.Route{Method: "GET", Path: "/simplePrimitive/{param}", Handler: wrapper.GetSimplePrimitive},
+	)
+	for _, pattern := range router.Patterns() {
+		m.Handle(pattern, router)
+	}
+
+	return m
+}
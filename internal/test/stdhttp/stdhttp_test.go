@@ -0,0 +1,94 @@
+package stdhttp
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/deepmap/oapi-codegen/internal/test/servertest"
+)
+
+type testServer struct {
+	ServerInterface
+	servertest.Recorder
+}
+
+func (t *testServer) GetLabelExplodeArray(w http.ResponseWriter, r *http.Request, param []int32) {
+	t.Record("GetLabelExplodeArray", param)
+}
+
+func (t *testServer) GetSimpleExplodeObject(w http.ResponseWriter, r *http.Request, param Object) {
+	t.Record("GetSimpleExplodeObject", param)
+}
+
+func (t *testServer) GetPassThrough(w http.ResponseWriter, r *http.Request, param string) {
+	t.Record("GetPassThrough", param)
+}
+
+func (t *testServer) GetCookie(w http.ResponseWriter, r *http.Request, params GetCookieParams) {
+	t.Record("GetCookie", params)
+}
+
+func (t *testServer) GetHeader(w http.ResponseWriter, r *http.Request, params GetHeaderParams) {
+	t.Record("GetHeader", params)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (t *testServer) GetQueryForm(w http.ResponseWriter, r *http.Request, params GetQueryFormParams) {
+	t.Record("GetQueryForm", params)
+}
+
+func TestStdHTTPServer(t *testing.T) {
+	var ts testServer
+	h := Handler(&ts)
+	servertest.Run(t, h, &ts.Recorder)
+
+	// The router tells paths which match, but not with the method of the
+	// request, from those which don't match at all.
+	rr := servertest.Do(h, httptest.NewRequest("POST", "/passThrough/x", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rr.Code)
+	assert.Equal(t, "GET", rr.Header().Get("Allow"))
+}
+
+func TestStdHTTPServerMuxPatterns(t *testing.T) {
+	// The routes are registered on the mux under the literal prefixes of
+	// their paths, so the mux's own, more specific, patterns win.
+	mux := http.NewServeMux()
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/passThrough/special", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+	var ts testServer
+	h := HandlerFromMux(&ts, mux)
+
+	rr := servertest.Do(h, httptest.NewRequest("GET", "/health", nil))
+	assert.Equal(t, http.StatusNoContent, rr.Code)
+
+	rr = servertest.Do(h, httptest.NewRequest("GET", "/passThrough/special", nil))
+	assert.Equal(t, http.StatusTeapot, rr.Code)
+	_, called := ts.Params("GetPassThrough")
+	assert.False(t, called)
+
+	rr = servertest.Do(h, httptest.NewRequest("GET", "/passThrough/x", nil))
+	assert.Equal(t, http.StatusOK, rr.Code)
+	param, _ := ts.Params("GetPassThrough")
+	assert.Equal(t, "x", param)
+
+	// A subtree pattern, such as /passThrough/, covers paths with more
+	// segments, which the router then turns away.
+	rr = servertest.Do(h, httptest.NewRequest("GET", "/passThrough/x/y", nil))
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+
+	// The mux redirects the path of the subtree itself to the subtree, while
+	// paths without parameters are exact patterns.
+	rr = servertest.Do(h, httptest.NewRequest("GET", "/passThrough", nil))
+	assert.Equal(t, http.StatusMovedPermanently, rr.Code)
+	assert.Equal(t, "/passThrough/", rr.Header().Get("Location"))
+
+	rr = servertest.Do(h, httptest.NewRequest("GET", "/header/x", nil))
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
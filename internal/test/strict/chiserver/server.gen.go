@@ -0,0 +1,488 @@
+// Package chiserver provides primitives to interact the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen DO NOT EDIT.
+package chiserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/deepmap/oapi-codegen/internal/test/strict/api"
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"github.com/go-chi/chi"
+	"io"
+	"net/http"
+)
+
+type ServerInterface interface {
+	//  (GET /pets)
+	FindPets(w http.ResponseWriter, r *http.Request)
+	//  (POST /pets)
+	AddPet(w http.ResponseWriter, r *http.Request)
+	//  (GET /pets/{id})
+	GetPet(w http.ResponseWriter, r *http.Request)
+	//  (PUT /pets/{id})
+	UpdatePet(w http.ResponseWriter, r *http.Request)
+	//  (GET /pets/{id}/name)
+	GetPetName(w http.ResponseWriter, r *http.Request)
+}
+
+// ParamsForFindPets operation parameters from context
+func ParamsForFindPets(ctx context.Context) *api.FindPetsParams {
+	return ctx.Value("FindPetsParams").(*api.FindPetsParams)
+}
+
+// FindPets operation middleware
+func FindPetsCtx(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		var err error
+
+		// Parameter object where we will unmarshal all parameters from the context
+		var params api.FindPetsParams
+
+		// ------------- Optional query parameter "kind" -------------
+		if paramValue := r.URL.Query().Get("kind"); paramValue != "" {
+
+		}
+
+		err = runtime.BindQueryParameter("form", true, false, "kind", r.URL.Query(), &params.Kind)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Invalid format for parameter kind: %s", err), http.StatusBadRequest)
+			return
+		}
+
+		ctx = context.WithValue(ctx, "FindPetsParams", &params)
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// AddPet operation middleware
+func AddPetCtx(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// GetPet operation middleware
+func GetPetCtx(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		var err error
+
+		// ------------- Path parameter "id" -------------
+		var id int
+
+		err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Invalid format for parameter id: %s", err), http.StatusBadRequest)
+			return
+		}
+
+		ctx = context.WithValue(ctx, "id", id)
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// UpdatePet operation middleware
+func UpdatePetCtx(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		var err error
+
+		// ------------- Path parameter "id" -------------
+		var id int
+
+		err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Invalid format for parameter id: %s", err), http.StatusBadRequest)
+			return
+		}
+
+		ctx = context.WithValue(ctx, "id", id)
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// GetPetName operation middleware
+func GetPetNameCtx(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		var err error
+
+		// ------------- Path parameter "id" -------------
+		var id int
+
+		err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Invalid format for parameter id: %s", err), http.StatusBadRequest)
+			return
+		}
+
+		ctx = context.WithValue(ctx, "id", id)
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerFromMux(si, chi.NewRouter())
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	r.Group(func(r chi.Router) {
+		r.Use(FindPetsCtx)
+		r.Get("/pets", si.FindPets)
+	})
+	r.Group(func(r chi.Router) {
+		r.Use(AddPetCtx)
+		r.Post("/pets", si.AddPet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Use(GetPetCtx)
+		r.Get("/pets/{id}", si.GetPet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Use(UpdatePetCtx)
+		r.Put("/pets/{id}", si.UpdatePet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Use(GetPetNameCtx)
+		r.Get("/pets/{id}/name", si.GetPetName)
+	})
+
+	return r
+}
+
+// DecodeAddPetJSONRequestBody decodes the application/json body of AddPet requests. It returns EOF when the request has no body.
+func DecodeAddPetJSONRequestBody(r *http.Request) (api.AddPetJSONRequestBody, error) {
+	var body api.AddPetJSONRequestBody
+	err := json.NewDecoder(r.Body).Decode(&body)
+	return body, err
+}
+
+// DecodeUpdatePetJSONRequestBody decodes the application/json body of UpdatePet requests. It returns EOF when the request has no body.
+func DecodeUpdatePetJSONRequestBody(r *http.Request) (api.UpdatePetJSONRequestBody, error) {
+	var body api.UpdatePetJSONRequestBody
+	err := json.NewDecoder(r.Body).Decode(&body)
+	return body, err
+}
+
+// FindPetsRequestObject holds the parameters and body of FindPets requests.
+type FindPetsRequestObject struct {
+	Params api.FindPetsParams
+}
+
+// FindPetsResponseObject is one of the responses which FindPets may return.
+type FindPetsResponseObject interface {
+	VisitFindPetsResponse(w http.ResponseWriter) error
+}
+
+// FindPets200JSONResponse is the 200 response of FindPets, for its application/json content type.
+type FindPets200JSONResponse []api.Pet
+
+func (response FindPets200JSONResponse) VisitFindPetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	return json.NewEncoder(w).Encode(([]api.Pet)(response))
+}
+
+// AddPetRequestObject holds the parameters and body of AddPet requests.
+type AddPetRequestObject struct {
+	Body *api.AddPetJSONRequestBody
+}
+
+// AddPetResponseObject is one of the responses which AddPet may return.
+type AddPetResponseObject interface {
+	VisitAddPetResponse(w http.ResponseWriter) error
+}
+
+// AddPet201JSONResponse is the 201 response of AddPet, for its application/json content type.
+type AddPet201JSONResponse api.Pet
+
+func (response AddPet201JSONResponse) VisitAddPetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+	return json.NewEncoder(w).Encode((api.Pet)(response))
+}
+
+// AddPetDefaultJSONResponse is the default response of AddPet, for its application/json content type.
+type AddPetDefaultJSONResponse struct {
+	Body       api.Error
+	StatusCode int
+}
+
+func (response AddPetDefaultJSONResponse) VisitAddPetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+// GetPetRequestObject holds the parameters and body of GetPet requests.
+type GetPetRequestObject struct {
+	Id int
+}
+
+// GetPetResponseObject is one of the responses which GetPet may return.
+type GetPetResponseObject interface {
+	VisitGetPetResponse(w http.ResponseWriter) error
+}
+
+// GetPet200JSONResponse is the 200 response of GetPet, for its application/json content type.
+type GetPet200JSONResponse api.Pet
+
+func (response GetPet200JSONResponse) VisitGetPetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	return json.NewEncoder(w).Encode((api.Pet)(response))
+}
+
+// GetPet404Response is the 404 response of GetPet.
+type GetPet404Response struct {
+}
+
+func (response GetPet404Response) VisitGetPetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+// GetPet410ApplicationProblemJsonResponse is the 410 response of GetPet, for its application/problem+json content type.
+type GetPet410ApplicationProblemJsonResponse api.Error
+
+func (response GetPet410ApplicationProblemJsonResponse) VisitGetPetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(410)
+	return json.NewEncoder(w).Encode((api.Error)(response))
+}
+
+// UpdatePetRequestObject holds the parameters and body of UpdatePet requests.
+type UpdatePetRequestObject struct {
+	Id   int
+	Body *api.UpdatePetJSONRequestBody
+}
+
+// UpdatePetResponseObject is one of the responses which UpdatePet may return.
+type UpdatePetResponseObject interface {
+	VisitUpdatePetResponse(w http.ResponseWriter) error
+}
+
+// UpdatePet204Response is the 204 response of UpdatePet.
+type UpdatePet204Response struct {
+}
+
+func (response UpdatePet204Response) VisitUpdatePetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+// GetPetNameRequestObject holds the parameters and body of GetPetName requests.
+type GetPetNameRequestObject struct {
+	Id int
+}
+
+// GetPetNameResponseObject is one of the responses which GetPetName may return.
+type GetPetNameResponseObject interface {
+	VisitGetPetNameResponse(w http.ResponseWriter) error
+}
+
+// GetPetName200TextPlainResponse is the 200 response of GetPetName, for its text/plain content type.
+type GetPetName200TextPlainResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetPetName200TextPlainResponse) VisitGetPetNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/plain")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+	if response.Body == nil {
+		return nil
+	}
+	if closer, ok := response.Body.(io.Closer); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+// GetPetName4XXJSONResponse is the 4XX response of GetPetName, for its application/json content type.
+type GetPetName4XXJSONResponse struct {
+	Body       api.Error
+	StatusCode int
+}
+
+func (response GetPetName4XXJSONResponse) VisitGetPetNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+// StrictServerInterface represents all server handlers, which take the
+// request objects, and return the response objects, of the operations.
+type StrictServerInterface interface {
+
+	// (GET /pets)
+	FindPets(ctx context.Context, request FindPetsRequestObject) (FindPetsResponseObject, error)
+
+	// (POST /pets)
+	AddPet(ctx context.Context, request AddPetRequestObject) (AddPetResponseObject, error)
+
+	// (GET /pets/{id})
+	GetPet(ctx context.Context, request GetPetRequestObject) (GetPetResponseObject, error)
+
+	// (PUT /pets/{id})
+	UpdatePet(ctx context.Context, request UpdatePetRequestObject) (UpdatePetResponseObject, error)
+
+	// (GET /pets/{id}/name)
+	GetPetName(ctx context.Context, request GetPetNameRequestObject) (GetPetNameResponseObject, error)
+}
+
+// StrictHTTPServerOptions holds the functions which respond to the errors of
+// a strict handler: RequestErrorHandlerFunc to request bodies which can't be
+// decoded, and ResponseErrorHandlerFunc to the errors which the
+// StrictServerInterface returns, or which writing its responses runs into.
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type strictHandler struct {
+	ssi     StrictServerInterface
+	options StrictHTTPServerOptions
+}
+
+// NewStrictHandler returns a ServerInterface which decodes requests into the
+// request objects of ssi, and writes the response objects which it returns.
+// Requests which can't be decoded are answered with 400 Bad Request, and the
+// errors of ssi with 500 Internal Server Error.
+func NewStrictHandler(ssi StrictServerInterface) ServerInterface {
+	return NewStrictHandlerWithOptions(ssi, StrictHTTPServerOptions{})
+}
+
+// NewStrictHandlerWithOptions is NewStrictHandler, with the errors handled by
+// the given functions, where they're set.
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, options StrictHTTPServerOptions) ServerInterface {
+	if options.RequestErrorHandlerFunc == nil {
+		options.RequestErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	if options.ResponseErrorHandlerFunc == nil {
+		options.ResponseErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	}
+	return &strictHandler{ssi: ssi, options: options}
+}
+
+// FindPets operation.
+func (sh *strictHandler) FindPets(w http.ResponseWriter, r *http.Request) {
+	var request FindPetsRequestObject
+	request.Params = *ParamsForFindPets(r.Context())
+
+	response, err := sh.ssi.FindPets(r.Context(), request)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response from FindPets")
+	}
+	if err == nil {
+		err = response.VisitFindPetsResponse(w)
+	}
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	}
+}
+
+// AddPet operation.
+func (sh *strictHandler) AddPet(w http.ResponseWriter, r *http.Request) {
+	var request AddPetRequestObject
+
+	body, err := DecodeAddPetJSONRequestBody(r)
+	if err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("invalid request body: %s", err))
+		return
+	}
+	request.Body = &body
+
+	response, err := sh.ssi.AddPet(r.Context(), request)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response from AddPet")
+	}
+	if err == nil {
+		err = response.VisitAddPetResponse(w)
+	}
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	}
+}
+
+// GetPet operation.
+func (sh *strictHandler) GetPet(w http.ResponseWriter, r *http.Request) {
+	var request GetPetRequestObject
+	request.Id = r.Context().Value("id").(int)
+
+	response, err := sh.ssi.GetPet(r.Context(), request)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response from GetPet")
+	}
+	if err == nil {
+		err = response.VisitGetPetResponse(w)
+	}
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	}
+}
+
+// UpdatePet operation.
+func (sh *strictHandler) UpdatePet(w http.ResponseWriter, r *http.Request) {
+	var request UpdatePetRequestObject
+	request.Id = r.Context().Value("id").(int)
+
+	body, err := DecodeUpdatePetJSONRequestBody(r)
+	if err == nil {
+		request.Body = &body
+	} else if err != io.EOF {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("invalid request body: %s", err))
+		return
+	}
+
+	response, err := sh.ssi.UpdatePet(r.Context(), request)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response from UpdatePet")
+	}
+	if err == nil {
+		err = response.VisitUpdatePetResponse(w)
+	}
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	}
+}
+
+// GetPetName operation.
+func (sh *strictHandler) GetPetName(w http.ResponseWriter, r *http.Request) {
+	var request GetPetNameRequestObject
+	request.Id = r.Context().Value("id").(int)
+
+	response, err := sh.ssi.GetPetName(r.Context(), request)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response from GetPetName")
+	}
+	if err == nil {
+		err = response.VisitGetPetNameResponse(w)
+	}
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	}
+}
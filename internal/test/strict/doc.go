@@ -0,0 +1,6 @@
+package strict
+
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --package=api -generate types -o api/api.gen.go strict.yaml
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --package=echoserver -generate server,strict-server -types-package github.com/deepmap/oapi-codegen/internal/test/strict/api -o echoserver/server.gen.go strict.yaml
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --package=chiserver -generate chi-server,strict-server -types-package github.com/deepmap/oapi-codegen/internal/test/strict/api -o chiserver/server.gen.go strict.yaml
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --package=stdhttpserver -generate std-http,strict-server -types-package github.com/deepmap/oapi-codegen/internal/test/strict/api -o stdhttpserver/server.gen.go strict.yaml
@@ -0,0 +1,426 @@
+// Package echoserver provides primitives to interact the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen DO NOT EDIT.
+package echoserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/deepmap/oapi-codegen/internal/test/strict/api"
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"github.com/labstack/echo/v4"
+	"io"
+	"net/http"
+	"path"
+)
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /pets)
+	FindPets(ctx echo.Context, params api.FindPetsParams) error
+
+	// (POST /pets)
+	AddPet(ctx echo.Context) error
+
+	// (GET /pets/{id})
+	GetPet(ctx echo.Context, id int) error
+
+	// (PUT /pets/{id})
+	UpdatePet(ctx echo.Context, id int) error
+
+	// (GET /pets/{id}/name)
+	GetPetName(ctx echo.Context, id int) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// FindPets converts echo context to params.
+func (w *ServerInterfaceWrapper) FindPets(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params api.FindPetsParams
+	// ------------- Optional query parameter "kind" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "kind", ctx.QueryParams(), &params.Kind)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kind: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.FindPets(ctx, params)
+	return err
+}
+
+// AddPet converts echo context to params.
+func (w *ServerInterfaceWrapper) AddPet(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AddPet(ctx)
+	return err
+}
+
+// GetPet converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameter("simple", false, "id", ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetPet(ctx, id)
+	return err
+}
+
+// UpdatePet converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdatePet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameter("simple", false, "id", ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UpdatePet(ctx, id)
+	return err
+}
+
+// GetPetName converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPetName(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameter("simple", false, "id", ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetPetName(ctx, id)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface, pathPrefix string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.GET(path.Join(pathPrefix, "/pets"), wrapper.FindPets)
+	router.POST(path.Join(pathPrefix, "/pets"), wrapper.AddPet)
+	router.GET(path.Join(pathPrefix, "/pets/:id"), wrapper.GetPet)
+	router.PUT(path.Join(pathPrefix, "/pets/:id"), wrapper.UpdatePet)
+	router.GET(path.Join(pathPrefix, "/pets/:id/name"), wrapper.GetPetName)
+
+}
+
+// DecodeAddPetJSONRequestBody decodes the application/json body of AddPet requests. It returns EOF when the request has no body.
+func DecodeAddPetJSONRequestBody(r *http.Request) (api.AddPetJSONRequestBody, error) {
+	var body api.AddPetJSONRequestBody
+	err := json.NewDecoder(r.Body).Decode(&body)
+	return body, err
+}
+
+// DecodeUpdatePetJSONRequestBody decodes the application/json body of UpdatePet requests. It returns EOF when the request has no body.
+func DecodeUpdatePetJSONRequestBody(r *http.Request) (api.UpdatePetJSONRequestBody, error) {
+	var body api.UpdatePetJSONRequestBody
+	err := json.NewDecoder(r.Body).Decode(&body)
+	return body, err
+}
+
+// FindPetsRequestObject holds the parameters and body of FindPets requests.
+type FindPetsRequestObject struct {
+	Params api.FindPetsParams
+}
+
+// FindPetsResponseObject is one of the responses which FindPets may return.
+type FindPetsResponseObject interface {
+	VisitFindPetsResponse(w http.ResponseWriter) error
+}
+
+// FindPets200JSONResponse is the 200 response of FindPets, for its application/json content type.
+type FindPets200JSONResponse []api.Pet
+
+func (response FindPets200JSONResponse) VisitFindPetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	return json.NewEncoder(w).Encode(([]api.Pet)(response))
+}
+
+// AddPetRequestObject holds the parameters and body of AddPet requests.
+type AddPetRequestObject struct {
+	Body *api.AddPetJSONRequestBody
+}
+
+// AddPetResponseObject is one of the responses which AddPet may return.
+type AddPetResponseObject interface {
+	VisitAddPetResponse(w http.ResponseWriter) error
+}
+
+// AddPet201JSONResponse is the 201 response of AddPet, for its application/json content type.
+type AddPet201JSONResponse api.Pet
+
+func (response AddPet201JSONResponse) VisitAddPetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+	return json.NewEncoder(w).Encode((api.Pet)(response))
+}
+
+// AddPetDefaultJSONResponse is the default response of AddPet, for its application/json content type.
+type AddPetDefaultJSONResponse struct {
+	Body       api.Error
+	StatusCode int
+}
+
+func (response AddPetDefaultJSONResponse) VisitAddPetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+// GetPetRequestObject holds the parameters and body of GetPet requests.
+type GetPetRequestObject struct {
+	Id int
+}
+
+// GetPetResponseObject is one of the responses which GetPet may return.
+type GetPetResponseObject interface {
+	VisitGetPetResponse(w http.ResponseWriter) error
+}
+
+// GetPet200JSONResponse is the 200 response of GetPet, for its application/json content type.
+type GetPet200JSONResponse api.Pet
+
+func (response GetPet200JSONResponse) VisitGetPetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	return json.NewEncoder(w).Encode((api.Pet)(response))
+}
+
+// GetPet404Response is the 404 response of GetPet.
+type GetPet404Response struct {
+}
+
+func (response GetPet404Response) VisitGetPetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+// GetPet410ApplicationProblemJsonResponse is the 410 response of GetPet, for its application/problem+json content type.
+type GetPet410ApplicationProblemJsonResponse api.Error
+
+func (response GetPet410ApplicationProblemJsonResponse) VisitGetPetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(410)
+	return json.NewEncoder(w).Encode((api.Error)(response))
+}
+
+// UpdatePetRequestObject holds the parameters and body of UpdatePet requests.
+type UpdatePetRequestObject struct {
+	Id   int
+	Body *api.UpdatePetJSONRequestBody
+}
+
+// UpdatePetResponseObject is one of the responses which UpdatePet may return.
+type UpdatePetResponseObject interface {
+	VisitUpdatePetResponse(w http.ResponseWriter) error
+}
+
+// UpdatePet204Response is the 204 response of UpdatePet.
+type UpdatePet204Response struct {
+}
+
+func (response UpdatePet204Response) VisitUpdatePetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+// GetPetNameRequestObject holds the parameters and body of GetPetName requests.
+type GetPetNameRequestObject struct {
+	Id int
+}
+
+// GetPetNameResponseObject is one of the responses which GetPetName may return.
+type GetPetNameResponseObject interface {
+	VisitGetPetNameResponse(w http.ResponseWriter) error
+}
+
+// GetPetName200TextPlainResponse is the 200 response of GetPetName, for its text/plain content type.
+type GetPetName200TextPlainResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetPetName200TextPlainResponse) VisitGetPetNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/plain")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+	if response.Body == nil {
+		return nil
+	}
+	if closer, ok := response.Body.(io.Closer); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+// GetPetName4XXJSONResponse is the 4XX response of GetPetName, for its application/json content type.
+type GetPetName4XXJSONResponse struct {
+	Body       api.Error
+	StatusCode int
+}
+
+func (response GetPetName4XXJSONResponse) VisitGetPetNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+// StrictServerInterface represents all server handlers, which take the
+// request objects, and return the response objects, of the operations.
+type StrictServerInterface interface {
+
+	// (GET /pets)
+	FindPets(ctx context.Context, request FindPetsRequestObject) (FindPetsResponseObject, error)
+
+	// (POST /pets)
+	AddPet(ctx context.Context, request AddPetRequestObject) (AddPetResponseObject, error)
+
+	// (GET /pets/{id})
+	GetPet(ctx context.Context, request GetPetRequestObject) (GetPetResponseObject, error)
+
+	// (PUT /pets/{id})
+	UpdatePet(ctx context.Context, request UpdatePetRequestObject) (UpdatePetResponseObject, error)
+
+	// (GET /pets/{id}/name)
+	GetPetName(ctx context.Context, request GetPetNameRequestObject) (GetPetNameResponseObject, error)
+}
+type strictHandler struct {
+	ssi StrictServerInterface
+}
+
+// NewStrictHandler returns a ServerInterface which decodes requests into the
+// request objects of ssi, and writes the response objects which it returns.
+func NewStrictHandler(ssi StrictServerInterface) ServerInterface {
+	return &strictHandler{ssi: ssi}
+}
+
+// FindPets operation.
+func (sh *strictHandler) FindPets(ctx echo.Context, params api.FindPetsParams) error {
+	var request FindPetsRequestObject
+	request.Params = params
+
+	response, err := sh.ssi.FindPets(ctx.Request().Context(), request)
+	if err != nil {
+		return err
+	}
+	if response == nil {
+		return fmt.Errorf("no response from FindPets")
+	}
+	return response.VisitFindPetsResponse(ctx.Response())
+}
+
+// AddPet operation.
+func (sh *strictHandler) AddPet(ctx echo.Context) error {
+	var request AddPetRequestObject
+
+	body, err := DecodeAddPetJSONRequestBody(ctx.Request())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid request body: %s", err))
+	}
+	request.Body = &body
+
+	response, err := sh.ssi.AddPet(ctx.Request().Context(), request)
+	if err != nil {
+		return err
+	}
+	if response == nil {
+		return fmt.Errorf("no response from AddPet")
+	}
+	return response.VisitAddPetResponse(ctx.Response())
+}
+
+// GetPet operation.
+func (sh *strictHandler) GetPet(ctx echo.Context, id int) error {
+	var request GetPetRequestObject
+	request.Id = id
+
+	response, err := sh.ssi.GetPet(ctx.Request().Context(), request)
+	if err != nil {
+		return err
+	}
+	if response == nil {
+		return fmt.Errorf("no response from GetPet")
+	}
+	return response.VisitGetPetResponse(ctx.Response())
+}
+
+// UpdatePet operation.
+func (sh *strictHandler) UpdatePet(ctx echo.Context, id int) error {
+	var request UpdatePetRequestObject
+	request.Id = id
+
+	body, err := DecodeUpdatePetJSONRequestBody(ctx.Request())
+	if err == nil {
+		request.Body = &body
+	} else if err != io.EOF {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid request body: %s", err))
+	}
+
+	response, err := sh.ssi.UpdatePet(ctx.Request().Context(), request)
+	if err != nil {
+		return err
+	}
+	if response == nil {
+		return fmt.Errorf("no response from UpdatePet")
+	}
+	return response.VisitUpdatePetResponse(ctx.Response())
+}
+
+// GetPetName operation.
+func (sh *strictHandler) GetPetName(ctx echo.Context, id int) error {
+	var request GetPetNameRequestObject
+	request.Id = id
+
+	response, err := sh.ssi.GetPetName(ctx.Request().Context(), request)
+	if err != nil {
+		return err
+	}
+	if response == nil {
+		return fmt.Errorf("no response from GetPetName")
+	}
+	return response.VisitGetPetNameResponse(ctx.Response())
+}
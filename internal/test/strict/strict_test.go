@@ -0,0 +1,264 @@
+package strict
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/deepmap/oapi-codegen/internal/test/strict/api"
+	"github.com/deepmap/oapi-codegen/internal/test/strict/chiserver"
+	"github.com/deepmap/oapi-codegen/internal/test/strict/echoserver"
+	"github.com/deepmap/oapi-codegen/internal/test/strict/stdhttpserver"
+)
+
+// petStore holds the pets which each of the strict servers below serve.
+type petStore struct {
+	pets []api.Pet
+}
+
+func newPetStore() *petStore {
+	return &petStore{pets: []api.Pet{
+		{Id: 1, NewPet: api.NewPet{Name: "Tom", Kind: "cat"}},
+		{Id: 2, NewPet: api.NewPet{Name: "Rex", Kind: "dog"}},
+	}}
+}
+
+func (s *petStore) find(kind *string) []api.Pet {
+	pets := []api.Pet{}
+	for _, pet := range s.pets {
+		if kind == nil || pet.Kind == *kind {
+			pets = append(pets, pet)
+		}
+	}
+	return pets
+}
+
+func (s *petStore) get(id int) *api.Pet {
+	for i := range s.pets {
+		if s.pets[i].Id == id {
+			return &s.pets[i]
+		}
+	}
+	return nil
+}
+
+var errBoom = errors.New("boom")
+
+type echoPets struct {
+	*petStore
+}
+
+func (s echoPets) FindPets(ctx context.Context, request echoserver.FindPetsRequestObject) (echoserver.FindPetsResponseObject, error) {
+	return echoserver.FindPets200JSONResponse(s.find(request.Params.Kind)), nil
+}
+
+func (s echoPets) AddPet(ctx context.Context, request echoserver.AddPetRequestObject) (echoserver.AddPetResponseObject, error) {
+	if request.Body.Name == "" {
+		return echoserver.AddPetDefaultJSONResponse{StatusCode: http.StatusUnprocessableEntity, Body: api.Error{Message: "no name"}}, nil
+	}
+	pet := api.Pet{Id: len(s.pets) + 1, NewPet: api.NewPet(*request.Body)}
+	s.pets = append(s.pets, pet)
+	return echoserver.AddPet201JSONResponse(pet), nil
+}
+
+func (s echoPets) GetPet(ctx context.Context, request echoserver.GetPetRequestObject) (echoserver.GetPetResponseObject, error) {
+	if pet := s.get(request.Id); pet != nil {
+		return echoserver.GetPet200JSONResponse(*pet), nil
+	}
+	if request.Id < 0 {
+		return echoserver.GetPet410ApplicationProblemJsonResponse{Message: "gone"}, nil
+	}
+	return echoserver.GetPet404Response{}, nil
+}
+
+func (s echoPets) UpdatePet(ctx context.Context, request echoserver.UpdatePetRequestObject) (echoserver.UpdatePetResponseObject, error) {
+	if request.Body != nil {
+		s.get(request.Id).NewPet = api.NewPet(*request.Body)
+	}
+	return echoserver.UpdatePet204Response{}, nil
+}
+
+func (s echoPets) GetPetName(ctx context.Context, request echoserver.GetPetNameRequestObject) (echoserver.GetPetNameResponseObject, error) {
+	if request.Id == 0 {
+		return nil, errBoom
+	}
+	if pet := s.get(request.Id); pet != nil {
+		return echoserver.GetPetName200TextPlainResponse{Body: strings.NewReader(pet.Name)}, nil
+	}
+	return echoserver.GetPetName4XXJSONResponse{StatusCode: http.StatusNotFound, Body: api.Error{Message: "no such pet"}}, nil
+}
+
+type chiPets struct {
+	*petStore
+}
+
+func (s chiPets) FindPets(ctx context.Context, request chiserver.FindPetsRequestObject) (chiserver.FindPetsResponseObject, error) {
+	return chiserver.FindPets200JSONResponse(s.find(request.Params.Kind)), nil
+}
+
+func (s chiPets) AddPet(ctx context.Context, request chiserver.AddPetRequestObject) (chiserver.AddPetResponseObject, error) {
+	if request.Body.Name == "" {
+		return chiserver.AddPetDefaultJSONResponse{StatusCode: http.StatusUnprocessableEntity, Body: api.Error{Message: "no name"}}, nil
+	}
+	pet := api.Pet{Id: len(s.pets) + 1, NewPet: api.NewPet(*request.Body)}
+	s.pets = append(s.pets, pet)
+	return chiserver.AddPet201JSONResponse(pet), nil
+}
+
+func (s chiPets) GetPet(ctx context.Context, request chiserver.GetPetRequestObject) (chiserver.GetPetResponseObject, error) {
+	if pet := s.get(request.Id); pet != nil {
+		return chiserver.GetPet200JSONResponse(*pet), nil
+	}
+	if request.Id < 0 {
+		return chiserver.GetPet410ApplicationProblemJsonResponse{Message: "gone"}, nil
+	}
+	return chiserver.GetPet404Response{}, nil
+}
+
+func (s chiPets) UpdatePet(ctx context.Context, request chiserver.UpdatePetRequestObject) (chiserver.UpdatePetResponseObject, error) {
+	if request.Body != nil {
+		s.get(request.Id).NewPet = api.NewPet(*request.Body)
+	}
+	return chiserver.UpdatePet204Response{}, nil
+}
+
+func (s chiPets) GetPetName(ctx context.Context, request chiserver.GetPetNameRequestObject) (chiserver.GetPetNameResponseObject, error) {
+	if request.Id == 0 {
+		return nil, errBoom
+	}
+	if pet := s.get(request.Id); pet != nil {
+		return chiserver.GetPetName200TextPlainResponse{Body: strings.NewReader(pet.Name)}, nil
+	}
+	return chiserver.GetPetName4XXJSONResponse{StatusCode: http.StatusNotFound, Body: api.Error{Message: "no such pet"}}, nil
+}
+
+type stdHTTPPets struct {
+	*petStore
+}
+
+func (s stdHTTPPets) FindPets(ctx context.Context, request stdhttpserver.FindPetsRequestObject) (stdhttpserver.FindPetsResponseObject, error) {
+	return stdhttpserver.FindPets200JSONResponse(s.find(request.Params.Kind)), nil
+}
+
+func (s stdHTTPPets) AddPet(ctx context.Context, request stdhttpserver.AddPetRequestObject) (stdhttpserver.AddPetResponseObject, error) {
+	if request.Body.Name == "" {
+		return stdhttpserver.AddPetDefaultJSONResponse{StatusCode: http.StatusUnprocessableEntity, Body: api.Error{Message: "no name"}}, nil
+	}
+	pet := api.Pet{Id: len(s.pets) + 1, NewPet: api.NewPet(*request.Body)}
+	s.pets = append(s.pets, pet)
+	return stdhttpserver.AddPet201JSONResponse(pet), nil
+}
+
+func (s stdHTTPPets) GetPet(ctx context.Context, request stdhttpserver.GetPetRequestObject) (stdhttpserver.GetPetResponseObject, error) {
+	if pet := s.get(request.Id); pet != nil {
+		return stdhttpserver.GetPet200JSONResponse(*pet), nil
+	}
+	if request.Id < 0 {
+		return stdhttpserver.GetPet410ApplicationProblemJsonResponse{Message: "gone"}, nil
+	}
+	return stdhttpserver.GetPet404Response{}, nil
+}
+
+func (s stdHTTPPets) UpdatePet(ctx context.Context, request stdhttpserver.UpdatePetRequestObject) (stdhttpserver.UpdatePetResponseObject, error) {
+	if request.Body != nil {
+		s.get(request.Id).NewPet = api.NewPet(*request.Body)
+	}
+	return stdhttpserver.UpdatePet204Response{}, nil
+}
+
+func (s stdHTTPPets) GetPetName(ctx context.Context, request stdhttpserver.GetPetNameRequestObject) (stdhttpserver.GetPetNameResponseObject, error) {
+	if request.Id == 0 {
+		return nil, errBoom
+	}
+	if pet := s.get(request.Id); pet != nil {
+		return stdhttpserver.GetPetName200TextPlainResponse{Body: strings.NewReader(pet.Name)}, nil
+	}
+	return stdhttpserver.GetPetName4XXJSONResponse{StatusCode: http.StatusNotFound, Body: api.Error{Message: "no such pet"}}, nil
+}
+
+func TestStrictServers(t *testing.T) {
+	e := echo.New()
+	echoserver.RegisterHandlers(e, echoserver.NewStrictHandler(echoPets{newPetStore()}), "")
+
+	handlers := map[string]http.Handler{
+		"echo":     e,
+		"chi":      chiserver.Handler(chiserver.NewStrictHandler(chiPets{newPetStore()})),
+		"std-http": stdhttpserver.Handler(stdhttpserver.NewStrictHandler(stdHTTPPets{newPetStore()})),
+	}
+
+	// The requests are made in order, as the ones which update the pets
+	// affect the responses to later ones.
+	tests := []struct {
+		method      string
+		path        string
+		body        string
+		code        int
+		contentType string
+		response    string
+	}{
+		{"GET", "/pets?kind=dog", "", http.StatusOK, "application/json", `[{"name":"Rex","kind":"dog","id":2}]`},
+		{"GET", "/pets/1", "", http.StatusOK, "application/json", `{"name":"Tom","kind":"cat","id":1}`},
+		{"GET", "/pets/7", "", http.StatusNotFound, "", ""},
+		{"GET", "/pets/-1", "", http.StatusGone, "application/problem+json", `{"message":"gone"}`},
+		{"POST", "/pets", `{"name":"Kit","kind":"cat"}`, http.StatusCreated, "application/json", `{"name":"Kit","kind":"cat","id":3}`},
+		{"POST", "/pets", `{"kind":"cat"}`, http.StatusUnprocessableEntity, "application/json", `{"message":"no name"}`},
+		{"POST", "/pets", `{"name":`, http.StatusBadRequest, "", ""},
+		{"PUT", "/pets/1", "", http.StatusNoContent, "", ""},
+		{"PUT", "/pets/1", `{"name":"Tim","kind":"cat"}`, http.StatusNoContent, "", ""},
+		{"GET", "/pets/1/name", "", http.StatusOK, "text/plain", "Tim"},
+		{"GET", "/pets/7/name", "", http.StatusNotFound, "application/json", `{"message":"no such pet"}`},
+		{"GET", "/pets/0/name", "", http.StatusInternalServerError, "", ""},
+	}
+
+	for name, handler := range handlers {
+		for _, test := range tests {
+			req := httptest.NewRequest(test.method, test.path, strings.NewReader(test.body))
+			if test.body != "" {
+				req.Header.Set("Content-Type", "application/json")
+			}
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, test.code, rr.Code, "%s: %s %s", name, test.method, test.path)
+			if test.contentType != "" {
+				assert.Equal(t, test.contentType, rr.Header().Get("Content-Type"), "%s: %s %s", name, test.method, test.path)
+			}
+			if strings.HasSuffix(test.contentType, "json") {
+				assert.JSONEq(t, test.response, rr.Body.String(), "%s: %s %s", name, test.method, test.path)
+			} else if test.response != "" {
+				assert.Equal(t, test.response, rr.Body.String(), "%s: %s %s", name, test.method, test.path)
+			}
+		}
+	}
+}
+
+func TestStrictHandlerOptions(t *testing.T) {
+	// The errors are answered by the handlers in the options
+	var requestErr, responseErr error
+	h := stdhttpserver.Handler(stdhttpserver.NewStrictHandlerWithOptions(stdHTTPPets{newPetStore()}, stdhttpserver.StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			requestErr = err
+			w.WriteHeader(http.StatusTeapot)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			responseErr = err
+			w.WriteHeader(http.StatusServiceUnavailable)
+		},
+	}))
+
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest("POST", "/pets", strings.NewReader("{")))
+	assert.Equal(t, http.StatusTeapot, rr.Code)
+	assert.Error(t, requestErr)
+
+	rr = httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest("GET", "/pets/0/name", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+	assert.Equal(t, errBoom, responseErr)
+}
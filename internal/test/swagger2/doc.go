@@ -0,0 +1,3 @@
+package swagger2
+
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --package=swagger2 -generate types,client,server -o swagger2.gen.go swagger2.yaml
@@ -0,0 +1,119 @@
+package swagger2
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type petServer struct {
+	pets    []Pet
+	photo   string
+	caption string
+}
+
+func (s *petServer) FindPets(ctx echo.Context, params FindPetsParams) error {
+	var pets []Pet
+	for _, pet := range s.pets {
+		for _, tag := range *params.Tags {
+			if pet.Tag != nil && *pet.Tag == tag {
+				pets = append(pets, pet)
+			}
+		}
+	}
+	return ctx.JSON(http.StatusOK, pets)
+}
+
+func (s *petServer) AddPet(ctx echo.Context) error {
+	var body AddPetJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	pet := Pet{NewPet: NewPet(body), Id: int64(len(s.pets) + 1)}
+	s.pets = append(s.pets, pet)
+	return ctx.JSON(http.StatusOK, pet)
+}
+
+func (s *petServer) FindPetById(ctx echo.Context, petId PetId) error {
+	for _, pet := range s.pets {
+		if pet.Id == int64(petId) {
+			return ctx.JSON(http.StatusOK, pet)
+		}
+	}
+	return ctx.JSON(http.StatusNotFound, Error{Code: 404, Message: "no such pet"})
+}
+
+func (s *petServer) UploadPhoto(ctx echo.Context, petId PetId) error {
+	s.caption = ctx.FormValue("caption")
+	file, err := ctx.FormFile("photo")
+	if err != nil {
+		return err
+	}
+	f, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	data, err := ioutil.ReadAll(f)
+	if err != nil {
+		return err
+	}
+	s.photo = string(data)
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (s *petServer) SetTags(ctx echo.Context, petId PetId, params SetTagsParams) error {
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func TestSwagger2(t *testing.T) {
+	e := echo.New()
+	pets := &petServer{}
+	RegisterHandlers(e, pets, "")
+	server := httptest.NewServer(e)
+	defer server.Close()
+
+	client, err := NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	tag := "dog"
+	added, err := client.AddPetWithResponse(ctx, AddPetJSONRequestBody{Name: "Rex", Tag: &tag})
+	require.NoError(t, err)
+	require.NotNil(t, added.JSON200)
+	assert.Equal(t, int64(1), added.JSON200.Id)
+
+	// collectionFormat: multi becomes an exploded query parameter
+	found, err := client.FindPetsWithResponse(ctx, &FindPetsParams{Tags: &[]string{"cat", "dog"}})
+	require.NoError(t, err)
+	require.NotNil(t, found.JSON200)
+	assert.Equal(t, []Pet{*added.JSON200}, *found.JSON200)
+
+	missing, err := client.FindPetByIdWithResponse(ctx, 2)
+	require.NoError(t, err)
+	require.NotNil(t, missing.JSONDefault)
+	assert.Equal(t, "no such pet", missing.JSONDefault.Message)
+
+	// formData parameters become a multipart body
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	require.NoError(t, writer.WriteField("caption", "Rex at the beach"))
+	part, err := writer.CreateFormFile("photo", "rex.jpg")
+	require.NoError(t, err)
+	_, err = part.Write([]byte("photo of rex"))
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+	uploaded, err := client.UploadPhotoWithBodyWithResponse(ctx, 1, writer.FormDataContentType(), &body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, uploaded.StatusCode())
+	assert.Equal(t, "photo of rex", pets.photo)
+	assert.Equal(t, "Rex at the beach", pets.caption)
+}
@@ -0,0 +1,3 @@
+package validation
+
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --package=validation -generate types,client,server,nullable-types,validation -o validation.gen.go validation.yaml
@@ -0,0 +1,976 @@
+// Package validation provides primitives to interact the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen DO NOT EDIT.
+package validation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+)
+
+// Animal defines model for Animal.
+type Animal struct {
+	union json.RawMessage
+}
+
+// Cat defines model for Cat.
+type Cat struct {
+	Kind  string `json:"kind"`
+	Lives int    `json:"lives"`
+}
+
+// Dog defines model for Dog.
+type Dog struct {
+	Kind string `json:"kind"`
+	Name Name   `json:"name"`
+}
+
+// Name defines model for Name.
+type Name string
+
+// Owner defines model for Owner.
+type Owner struct {
+	Address *struct {
+		Zip *string `json:"zip,omitempty"`
+	} `json:"address,omitempty"`
+	Name string `json:"name"`
+}
+
+// Pet defines model for Pet.
+type Pet struct {
+	Animal   *Animal                      `json:"animal,omitempty"`
+	Color    *PetColor                    `json:"color,omitempty"`
+	Friends  *[]Owner                     `json:"friends,omitempty"`
+	Id       int64                        `json:"id"`
+	Labels   *Pet_Labels                  `json:"labels,omitempty"`
+	Name     Name                         `json:"name"`
+	Nickname openapi_types.NullableString `json:"nickname,omitempty"`
+	Owner    *Owner                       `json:"owner,omitempty"`
+	Tags     []string                     `json:"tags"`
+	Weight   *float64                     `json:"weight,omitempty"`
+}
+
+// PetColor defines model for Pet.color.
+type PetColor string
+
+// Pet_Labels defines model for Pet.labels.
+type Pet_Labels struct {
+	AdditionalProperties map[string]string `json:"-"`
+}
+
+// ListPetsParams defines parameters for ListPets.
+type ListPetsParams struct {
+	Limit *int    `json:"limit,omitempty"`
+	Tag   string  `json:"tag"`
+	Sort  *string `json:"sort,omitempty"`
+}
+
+// AddPetJSONBody defines parameters for AddPet.
+type AddPetJSONBody Pet
+
+// AddPetJSONRequestBody defines body for AddPet for application/json ContentType.
+type AddPetJSONRequestBody AddPetJSONBody
+
+// Validate checks the AddPetJSONRequestBody as the AddPetJSONBody it's defined as.
+func (t AddPetJSONRequestBody) Validate() error {
+	return runtime.ValidateValue(AddPetJSONBody(t))
+}
+
+// Validate checks the value of ListPetsParams against the constraints of its schema.
+func (t ListPetsParams) Validate() error {
+	var errs runtime.ValidationErrors
+	if t.Limit != nil {
+		errs.Minimum("limit", float64(*t.Limit), 1, false)
+		errs.Maximum("limit", float64(*t.Limit), 100, false)
+	}
+	errs.Pattern("tag", t.Tag, "^[a-z]+$")
+	if t.Sort != nil {
+		switch *t.Sort {
+		case "name", "age":
+		default:
+			errs.Add("sort", "must be one of the values of the enum")
+		}
+	}
+	return errs.ErrorOrNil()
+}
+
+// Validate checks the value of AddPetJSONBody against the constraints of its schema.
+func (t AddPetJSONBody) Validate() error {
+	var errs runtime.ValidationErrors
+	errs.Append("", runtime.ValidateValue(Pet(t)))
+	return errs.ErrorOrNil()
+}
+
+// Getter for additional properties for Pet_Labels. Returns the specified
+// element and whether it was found
+func (a Pet_Labels) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for Pet_Labels
+func (a *Pet_Labels) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for Pet_Labels to handle AdditionalProperties
+func (a *Pet_Labels) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return errors.Wrap(err, fmt.Sprintf("error unmarshaling field %s", fieldName))
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for Pet_Labels to handle AdditionalProperties
+func (a Pet_Labels) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("error marshaling '%s'", fieldName))
+		}
+	}
+	return json.Marshal(object)
+}
+
+// AsCat returns the union data inside the Animal as a Cat
+func (t Animal) AsCat() (Cat, error) {
+	var body Cat
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromCat overwrites any union data inside the Animal as the provided Cat
+func (t *Animal) FromCat(v Cat) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeCat performs a merge with any union data inside the Animal, using the provided Cat
+func (t *Animal) MergeCat(v Cat) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsDog returns the union data inside the Animal as a Dog
+func (t Animal) AsDog() (Dog, error) {
+	var body Dog
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromDog overwrites any union data inside the Animal as the provided Dog
+func (t *Animal) FromDog(v Dog) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeDog performs a merge with any union data inside the Animal, using the provided Dog
+func (t *Animal) MergeDog(v Dog) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// Discriminator returns the value of the "kind" property of the Animal, which
+// selects the type of the union data
+func (t Animal) Discriminator() (string, error) {
+	var object map[string]json.RawMessage
+	err := json.Unmarshal(t.union, &object)
+	if err != nil {
+		return "", err
+	}
+	var discriminator string
+	if raw, found := object["kind"]; found {
+		err = json.Unmarshal(raw, &discriminator)
+	}
+	return discriminator, err
+}
+
+// ValueByDiscriminator returns the union data inside the Animal as the
+// type selected by its discriminator
+func (t Animal) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := t.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "Cat":
+		var body Cat
+		err := json.Unmarshal(t.union, &body)
+		return body, err
+	case "Dog":
+		var body Dog
+		err := json.Unmarshal(t.union, &body)
+		return body, err
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+// MarshalJSON returns the raw union data of Animal
+func (t Animal) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+// UnmarshalJSON stores the raw union data of Animal, which is decoded by
+// the As* accessors.
+func (t *Animal) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// List of PetColor
+const (
+	PetColorBlack PetColor = "black"
+	PetColorWhite PetColor = "white"
+)
+
+// The names which the constants of PetColor had before they were named
+// in CamelCase
+const (
+	// Deprecated: Use PetColorBlack instead.
+	PetColor_black = PetColorBlack
+	// Deprecated: Use PetColorWhite instead.
+	PetColor_white = PetColorWhite
+)
+
+// AllPetColorValues returns all the possible values of PetColor
+func AllPetColorValues() []PetColor {
+	return []PetColor{
+		PetColorBlack,
+		PetColorWhite,
+	}
+}
+
+// Valid returns whether the PetColor is one of the values of the enum
+func (e PetColor) Valid() bool {
+	switch e {
+	case PetColorBlack:
+		return true
+	case PetColorWhite:
+		return true
+	default:
+		return false
+	}
+}
+
+// Validate checks the value of Animal against the constraints of its schema.
+func (t Animal) Validate() error {
+	var errs runtime.ValidationErrors
+	if v, err := t.ValueByDiscriminator(); err != nil {
+		errs.Add("", err.Error())
+	} else {
+		errs.Append("", runtime.ValidateValue(v))
+	}
+	return errs.ErrorOrNil()
+}
+
+// Validate checks the value of Cat against the constraints of its schema.
+func (t Cat) Validate() error {
+	var errs runtime.ValidationErrors
+	errs.Maximum("lives", float64(t.Lives), 9, false)
+	return errs.ErrorOrNil()
+}
+
+// Validate checks the value of Dog against the constraints of its schema.
+func (t Dog) Validate() error {
+	var errs runtime.ValidationErrors
+	errs.Append("name", runtime.ValidateValue(t.Name))
+	return errs.ErrorOrNil()
+}
+
+// Validate checks the value of Name against the constraints of its schema.
+func (t Name) Validate() error {
+	var errs runtime.ValidationErrors
+	errs.MinLength("", string(t), 1)
+	errs.MaxLength("", string(t), 20)
+	return errs.ErrorOrNil()
+}
+
+// Validate checks the value of Owner against the constraints of its schema.
+func (t Owner) Validate() error {
+	var errs runtime.ValidationErrors
+	if t.Address != nil {
+		if t.Address.Zip != nil {
+			errs.Pattern("address.zip", *t.Address.Zip, "^[0-9]{5}$")
+		}
+	}
+	errs.MinLength("name", t.Name, 2)
+	return errs.ErrorOrNil()
+}
+
+// Validate checks the value of Pet against the constraints of its schema.
+func (t Pet) Validate() error {
+	var errs runtime.ValidationErrors
+	if t.Animal != nil {
+		errs.Append("animal", runtime.ValidateValue(*t.Animal))
+	}
+	if t.Color != nil {
+		errs.Append("color", runtime.ValidateValue(*t.Color))
+	}
+	if t.Friends != nil {
+		for i0, item0 := range *t.Friends {
+			errs.Append(runtime.IndexPath("friends", i0), runtime.ValidateValue(item0))
+		}
+	}
+	errs.Minimum("id", float64(t.Id), 1, false)
+	if t.Labels != nil {
+		errs.Append("labels", runtime.ValidateValue(*t.Labels))
+	}
+	errs.Append("name", runtime.ValidateValue(t.Name))
+	if n0, err := t.Nickname.Get(); err == nil {
+		errs.MaxLength("nickname", n0, 5)
+	}
+	if t.Owner != nil {
+		errs.Append("owner", runtime.ValidateValue(*t.Owner))
+	}
+	if t.Tags == nil {
+		errs.Required("tags")
+	} else {
+		errs.MinItems("tags", len(t.Tags), 1)
+		errs.MaxItems("tags", len(t.Tags), 3)
+		errs.UniqueItems("tags", t.Tags)
+		for i0, item0 := range t.Tags {
+			errs.MaxLength(runtime.IndexPath("tags", i0), item0, 10)
+		}
+	}
+	if t.Weight != nil {
+		errs.Minimum("weight", float64(*t.Weight), 0, true)
+		errs.MultipleOf("weight", float64(*t.Weight), 0.5)
+	}
+	return errs.ErrorOrNil()
+}
+
+// Validate checks the value of PetColor against the constraints of its schema.
+func (t PetColor) Validate() error {
+	var errs runtime.ValidationErrors
+	if !t.Valid() {
+		errs.Add("", "must be one of the values of the enum")
+	}
+	return errs.ErrorOrNil()
+}
+
+// Validate checks the value of Pet_Labels against the constraints of its schema.
+func (t Pet_Labels) Validate() error {
+	var errs runtime.ValidationErrors
+	for k0, v0 := range t.AdditionalProperties {
+		errs.MaxLength(runtime.JoinPath("", k0), v0, 3)
+	}
+	return errs.ErrorOrNil()
+}
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A callback for modifying requests which are generated before sending over
+	// the network.
+	RequestEditor RequestEditorFn
+
+	// The decoders of response bodies, by media type. When nil,
+	// runtime.DefaultResponseDecoders is used.
+	ResponseDecoders *runtime.ResponseDecoders
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = http.DefaultClient
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditor = fn
+		return nil
+	}
+}
+
+// WithResponseDecoder registers the decoder of the response bodies of a media
+// type, such as application/x-protobuf, for this client only. Registering the
+// decoder of application/cbor decodes the media types with a +cbor suffix too.
+func WithResponseDecoder(mediaType string, decoder runtime.ResponseDecoder) ClientOption {
+	return func(c *Client) error {
+		if c.ResponseDecoders == nil {
+			c.ResponseDecoders = runtime.DefaultResponseDecoders.Clone()
+		}
+		c.ResponseDecoders.Register(mediaType, decoder)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// ListPets request
+	ListPets(ctx context.Context, params *ListPetsParams) (*http.Response, error)
+
+	// AddPet request  with any body
+	AddPetWithBody(ctx context.Context, contentType string, body io.Reader) (*http.Response, error)
+
+	AddPet(ctx context.Context, body AddPetJSONRequestBody) (*http.Response, error)
+}
+
+func (c *Client) ListPets(ctx context.Context, params *ListPetsParams) (*http.Response, error) {
+	req, err := NewListPetsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if c.RequestEditor != nil {
+		err = c.RequestEditor(ctx, req)
+		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
+			return nil, err
+		}
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddPetWithBody(ctx context.Context, contentType string, body io.Reader) (*http.Response, error) {
+	req, err := NewAddPetRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if c.RequestEditor != nil {
+		err = c.RequestEditor(ctx, req)
+		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
+			return nil, err
+		}
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddPet(ctx context.Context, body AddPetJSONRequestBody) (*http.Response, error) {
+	req, err := NewAddPetRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if c.RequestEditor != nil {
+		err = c.RequestEditor(ctx, req)
+		if err != nil {
+			// The request isn't sent, so its body is closed here rather than
+			// by the Doer, which stops the writing of a streamed body.
+			if req.Body != nil {
+				req.Body.Close()
+			}
+			return nil, err
+		}
+	}
+	return c.Client.Do(req)
+}
+
+// NewListPetsRequest generates requests for ListPets
+func NewListPetsRequest(server string, params *ListPetsParams) (*http.Request, error) {
+	var err error
+
+	err = params.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/pets")
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryUrl.Query()
+
+	var queryFrag string
+	var parsed url.Values
+	var queryParamBuf []byte
+	_ = queryFrag
+	_ = parsed
+	_ = queryParamBuf
+
+	if params.Limit != nil {
+
+		if queryFrag, err = runtime.StyleParam("form", true, "limit", *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err = url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if queryFrag, err = runtime.StyleParam("form", true, "tag", params.Tag); err != nil {
+		return nil, err
+	} else if parsed, err = url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	if params.Sort != nil {
+
+		if queryFrag, err = runtime.StyleParam("form", true, "sort", *params.Sort); err != nil {
+			return nil, err
+		} else if parsed, err = url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryUrl.RawQuery = queryValues.Encode()
+
+	var req *http.Request
+	req, err = http.NewRequest("GET", queryUrl.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddPetRequest calls the generic AddPet builder with application/json body
+func NewAddPetRequest(server string, body AddPetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	if err := runtime.ValidateValue(body); err != nil {
+		return nil, err
+	}
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddPetRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAddPetRequestWithBody generates requests for AddPet with any type of body
+func NewAddPetRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var queryUrl *url.URL
+	queryUrl, err = url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := fmt.Sprintf("/pets")
+	if basePath[0] == '/' {
+		basePath = basePath[1:]
+	}
+
+	queryUrl, err = queryUrl.Parse(basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var req *http.Request
+	req, err = http.NewRequest("POST", queryUrl.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+	return req, nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+
+	// The decoders of response bodies, from the ResponseDecoders of the
+	// Client.
+	decoders *runtime.ResponseDecoders
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{ClientInterface: client, decoders: client.ResponseDecoders}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ListPets request
+	ListPetsWithResponse(ctx context.Context, params *ListPetsParams) (*ListPetsResponse, error)
+
+	// AddPet request  with any body
+	AddPetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader) (*AddPetResponse, error)
+
+	AddPetWithResponse(ctx context.Context, body AddPetJSONRequestBody) (*AddPetResponse, error)
+}
+
+type ListPetsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Pet
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPetsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPetsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddPetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r AddPetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddPetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ListPetsWithResponse request returning *ListPetsResponse
+func (c *ClientWithResponses) ListPetsWithResponse(ctx context.Context, params *ListPetsParams) (*ListPetsResponse, error) {
+	rsp, err := c.ListPets(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListPetsResponseWithDecoders(rsp, c.decoders)
+}
+
+// AddPetWithBodyWithResponse request with arbitrary body returning *AddPetResponse
+func (c *ClientWithResponses) AddPetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader) (*AddPetResponse, error) {
+	rsp, err := c.AddPetWithBody(ctx, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddPetResponseWithDecoders(rsp, c.decoders)
+}
+
+func (c *ClientWithResponses) AddPetWithResponse(ctx context.Context, body AddPetJSONRequestBody) (*AddPetResponse, error) {
+	rsp, err := c.AddPet(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddPetResponseWithDecoders(rsp, c.decoders)
+}
+
+// ParseListPetsResponse parses an HTTP response from a ListPetsWithResponse call
+func ParseListPetsResponse(rsp *http.Response) (*ListPetsResponse, error) {
+	return ParseListPetsResponseWithDecoders(rsp, nil)
+}
+
+// ParseListPetsResponseWithDecoders parses an HTTP response from a ListPetsWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseListPetsResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*ListPetsResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListPetsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	contentType := rsp.Header.Get("Content-Type")
+	switch {
+	case runtime.MediaTypeMatches(contentType, "application/json") && rsp.StatusCode == 200:
+		if decode, found := decoders.Lookup(contentType); found {
+			var dest []Pet
+			switch err := decode(bodyBytes, &dest); err {
+			case nil:
+				response.JSON200 = &dest
+			case runtime.ErrUnsupportedDestination:
+			default:
+				return nil, err
+			}
+		}
+
+	}
+
+	return response, nil
+}
+
+// ParseAddPetResponse parses an HTTP response from a AddPetWithResponse call
+func ParseAddPetResponse(rsp *http.Response) (*AddPetResponse, error) {
+	return ParseAddPetResponseWithDecoders(rsp, nil)
+}
+
+// ParseAddPetResponseWithDecoders parses an HTTP response from a AddPetWithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func ParseAddPetResponseWithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*AddPetResponse, error) {
+	if decoders == nil {
+		decoders = runtime.DefaultResponseDecoders
+	}
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddPetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case rsp.StatusCode == 204:
+		break // No content-type
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /pets)
+	ListPets(ctx echo.Context, params ListPetsParams) error
+
+	// (POST /pets)
+	AddPet(ctx echo.Context) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// ListPets converts echo context to params.
+func (w *ServerInterfaceWrapper) ListPets(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPetsParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Required query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "tag", ctx.QueryParams(), &params.Tag)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tag: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	err = params.Validate()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid parameters: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ListPets(ctx, params)
+	return err
+}
+
+// AddPet converts echo context to params.
+func (w *ServerInterfaceWrapper) AddPet(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AddPet(ctx)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface, pathPrefix string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.GET(path.Join(pathPrefix, "/pets"), wrapper.ListPets)
+	router.POST(path.Join(pathPrefix, "/pets"), wrapper.AddPet)
+
+}
+
+// DecodeAddPetJSONRequestBody decodes the application/json body of AddPet requests. It returns EOF when the request has no body.
+func DecodeAddPetJSONRequestBody(r *http.Request) (AddPetJSONRequestBody, error) {
+	var body AddPetJSONRequestBody
+	err := json.NewDecoder(r.Body).Decode(&body)
+	return body, err
+}
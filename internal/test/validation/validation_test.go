@@ -0,0 +1,139 @@
+package validation
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	var pet Pet
+	err := json.Unmarshal([]byte(`{"id":1,"name":"Fido","tags":["dog"],"color":"black"}`), &pet)
+	require.NoError(t, err)
+	assert.NoError(t, pet.Validate())
+
+	weight := 2.25
+	color := PetColor("pink")
+	zip := "123"
+	pet = Pet{
+		Id:       0,
+		Name:     "",
+		Tags:     []string{"a", "a", "b", "averyverylongtag"},
+		Weight:   &weight,
+		Nickname: types.NewNullableString("toolong"),
+		Color:    &color,
+		Owner: &Owner{Name: "B", Address: &struct {
+			Zip *string `json:"zip,omitempty"`
+		}{Zip: &zip}},
+		Friends: &[]Owner{{Name: "Al"}, {Name: "C"}},
+		Labels:  &Pet_Labels{AdditionalProperties: map[string]string{"size": "large"}},
+	}
+	err = pet.Validate()
+	require.Error(t, err)
+	assert.Equal(t, runtime.ValidationErrors{
+		{Field: "color", Message: "must be one of the values of the enum"},
+		{Field: "friends[1].name", Message: "length must be at least 2"},
+		{Field: "id", Message: "must be greater than or equal to 1"},
+		{Field: "labels.size", Message: "length must be at most 3"},
+		{Field: "name", Message: "length must be at least 1"},
+		{Field: "nickname", Message: "length must be at most 5"},
+		{Field: "owner.address.zip", Message: "must match the pattern ^[0-9]{5}$"},
+		{Field: "owner.name", Message: "length must be at least 2"},
+		{Field: "tags", Message: "must have at most 3 items"},
+		{Field: "tags", Message: "items must be unique, but items 0 and 1 are equal"},
+		{Field: "tags[3]", Message: "length must be at most 10"},
+		{Field: "weight", Message: "must be a multiple of 0.5"},
+	}, err)
+
+	// Missing required properties are reported when they're nil
+	pet = Pet{Id: 1, Name: "Fido"}
+	assert.Equal(t, runtime.ValidationErrors{{Field: "tags", Message: "is required"}}, pet.Validate())
+}
+
+func TestValidateUnion(t *testing.T) {
+	// Unions validate the type which their discriminator selects
+	var animal Animal
+	require.NoError(t, json.Unmarshal([]byte(`{"kind":"Cat","lives":9}`), &animal))
+	assert.NoError(t, animal.Validate())
+
+	require.NoError(t, json.Unmarshal([]byte(`{"kind":"Cat","lives":10}`), &animal))
+	assert.Equal(t, runtime.ValidationErrors{{Field: "lives", Message: "must be less than or equal to 9"}}, animal.Validate())
+
+	pet := Pet{Id: 1, Name: "Fido", Tags: []string{"dog"}, Animal: &animal}
+	assert.Equal(t, runtime.ValidationErrors{{Field: "animal.lives", Message: "must be less than or equal to 9"}}, pet.Validate())
+
+	require.NoError(t, json.Unmarshal([]byte(`{"kind":"Dog","name":""}`), &animal))
+	assert.Equal(t, runtime.ValidationErrors{{Field: "name", Message: "length must be at least 1"}}, animal.Validate())
+
+	require.NoError(t, json.Unmarshal([]byte(`{"kind":"Cow"}`), &animal))
+	assert.Equal(t, runtime.ValidationErrors{{Field: "", Message: "unknown discriminator value: Cow"}}, animal.Validate())
+}
+
+func TestClientValidation(t *testing.T) {
+	_, err := NewAddPetRequest("http://localhost", AddPetJSONRequestBody{Id: 1, Name: "Fido"})
+	assert.Equal(t, runtime.ValidationErrors{{Field: "tags", Message: "is required"}}, err)
+
+	limit := 101
+	_, err = NewListPetsRequest("http://localhost", &ListPetsParams{Limit: &limit, Tag: "dogs"})
+	assert.Equal(t, runtime.ValidationErrors{{Field: "limit", Message: "must be less than or equal to 100"}}, err)
+
+	_, err = NewListPetsRequest("http://localhost", &ListPetsParams{Tag: "dogs"})
+	assert.NoError(t, err)
+
+	// Inline enums of parameters are checked, although they have no type
+	sort := "size"
+	_, err = NewListPetsRequest("http://localhost", &ListPetsParams{Tag: "dogs", Sort: &sort})
+	assert.Equal(t, runtime.ValidationErrors{{Field: "sort", Message: "must be one of the values of the enum"}}, err)
+}
+
+type server struct{}
+
+func (server) ListPets(ctx echo.Context, params ListPetsParams) error {
+	return ctx.JSON(http.StatusOK, []Pet{})
+}
+
+func (server) AddPet(ctx echo.Context) error {
+	var pet Pet
+	if err := ctx.Bind(&pet); err != nil {
+		return err
+	}
+	if err := pet.Validate(); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func TestServerValidation(t *testing.T) {
+	e := echo.New()
+	RegisterHandlers(e, server{}, "")
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/pets?tag=dogs&limit=5", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/pets?tag=Dogs", nil))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "tag: must match the pattern ^[a-z]+$")
+
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/pets?tag=dogs&sort=size", nil))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "sort: must be one of the values of the enum")
+
+	// Handlers validate the bodies they decode
+	req := httptest.NewRequest(http.MethodPost, "/pets", strings.NewReader(`{"id":1,"name":"Fido","tags":[]}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "tags: must have at least 1 items")
+}
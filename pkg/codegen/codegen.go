@@ -32,16 +32,111 @@ import (
 
 // Options defines the optional code to generate.
 type Options struct {
-	GenerateChiServer  bool              // GenerateChiServer specifies whether to generate chi server boilerplate
-	GenerateEchoServer bool              // GenerateEchoServer specifies whether to generate echo server boilerplate
-	GenerateClient     bool              // GenerateClient specifies whether to generate client boilerplate
-	GenerateTypes      bool              // GenerateTypes specifies whether to generate type definitions
-	EmbedSpec          bool              // Whether to embed the swagger spec in the generated code
-	SkipFmt            bool              // Whether to skip go fmt on the generated code
-	SkipPrune          bool              // Whether to skip pruning unused components on the generated code
-	IncludeTags        []string          // Only include operations that have one of these tags. Ignored when empty.
-	ExcludeTags        []string          // Exclude operations that have one of these tags. Ignored when empty.
-	UserTemplates      map[string]string // Override built-in templates from user-provided files
+	GenerateChiServer     bool              // GenerateChiServer specifies whether to generate chi server boilerplate
+	GenerateEchoServer    bool              // GenerateEchoServer specifies whether to generate echo server boilerplate
+	GenerateGinServer     bool              // GenerateGinServer specifies whether to generate gin server boilerplate
+	GenerateGorillaServer bool              // GenerateGorillaServer specifies whether to generate gorilla/mux server boilerplate
+	GenerateStdHTTPServer bool              // GenerateStdHTTPServer specifies whether to generate net/http server boilerplate, with no router dependency
+	GenerateStrictServer  bool              // GenerateStrictServer specifies whether to generate the strict server interface, and its handler for the server
+	GenerateClient        bool              // GenerateClient specifies whether to generate client boilerplate
+	GenerateTypes         bool              // GenerateTypes specifies whether to generate type definitions
+	EmbedSpec             bool              // Whether to embed the swagger spec in the generated code
+	SkipFmt               bool              // Whether to skip go fmt on the generated code
+	SkipPrune             bool              // Whether to skip pruning unused components on the generated code
+	IncludeTags           []string          // Only include operations that have one of these tags. Ignored when empty.
+	ExcludeTags           []string          // Exclude operations that have one of these tags. Ignored when empty.
+	UserTemplates         map[string]string // Override built-in templates from user-provided files
+	NullableTypes         bool              // Whether to use tri-state Nullable types for nullable properties
+	ReadWriteVariants     bool              // Whether to generate Request and Response variants of schemas with readOnly or writeOnly properties
+	PlainStringFormats    bool              // Whether to use plain strings for the uuid, email, uri, ipv4, ipv6 and binary formats
+	TypeMapping           map[string]string // Overrides the Go type for an OpenAPI "type" or "type/format", eg, "string/decimal"
+	GenerateValidation    bool              // Whether to generate Validate methods which check schema constraints
+	TypesPackage          string            // Import path of the package the types were generated into, when they're generated separately
+	ImportMapping         map[string]string // Maps the documents of external references, eg, "common.yaml", to the Go packages of their types
+	SharedPackage         string            // Import path of the package the SharedSchemas were generated into
+	SharedSchemas         []string          // Component schemas which are generated into the SharedPackage, rather than this one
+}
+
+// This holds the options and state of generating the code for one spec, which
+// are needed deep within type generation. Each call of Generate makes its own
+// generator, so that calls can't see each other's state, and may run
+// concurrently.
+type generator struct {
+	options       Options
+	nullableTypes map[string]string // Nullable wrapper type names, mapped to the type they wrap
+
+	readWriteSchemas map[string]bool // Types of the schemas which have Request and Response variants
+	readWriteVariant string          // The variant being generated, if any
+
+	schemaGoNames map[string]string   // Component schema names, mapped to the Go names given by x-go-name
+	goTypeImports map[string]goImport // Imports needed by x-go-type overrides and the type mapping, keyed by path
+	typeMapping   map[string]string   // Go types for OpenAPI types and formats, from Options.TypeMapping
+	typesPackage  string              // Name of the package holding the generated types, if it's not this one
+	importMapping map[string]string   // Documents of external references, mapped to the names of their Go packages
+	sharedSchemas map[string]string   // Component schemas generated into the shared package, mapped to its name
+}
+
+// Returns a generator with the default options, for the exported functions
+// which generate code on their own, outside of Generate.
+func defaultGenerator() *generator {
+	return &generator{
+		nullableTypes:    make(map[string]string),
+		readWriteSchemas: make(map[string]bool),
+		schemaGoNames:    make(map[string]string),
+		goTypeImports:    make(map[string]goImport),
+		typeMapping:      make(map[string]string),
+		importMapping:    make(map[string]string),
+		sharedSchemas:    make(map[string]string),
+	}
+}
+
+// Returns a generator for the given options and spec.
+func newGenerator(swagger *openapi3.Swagger, opts Options) (*generator, error) {
+	g := defaultGenerator()
+	g.options = opts
+	typeMapping, err := g.parseTypeMapping(opts.TypeMapping)
+	if err != nil {
+		return nil, errors.Wrap(err, "error in type mapping")
+	}
+	g.typeMapping = typeMapping
+	if opts.TypesPackage != "" {
+		if opts.GenerateTypes {
+			return nil, errors.New("types can't be generated along with a types package, since they belong in it")
+		}
+		g.typesPackage = packageNameFromPath(opts.TypesPackage)
+		g.goTypeImports[opts.TypesPackage] = goImport{
+			lookFor:     regexp.QuoteMeta(g.typesPackage) + "\\.",
+			packageName: opts.TypesPackage,
+		}
+	}
+	g.importMapping = g.parseImportMapping(opts.ImportMapping)
+	if len(opts.SharedSchemas) != 0 {
+		if opts.SharedPackage == "" {
+			return nil, errors.New("shared schemas need the import path of their shared package")
+		}
+		sharedPackage := g.importTypesPackage(opts.SharedPackage)
+		for _, name := range opts.SharedSchemas {
+			g.sharedSchemas[name] = sharedPackage
+		}
+	}
+	schemaGoNames, err := findSchemaGoNames(swagger.Components.Schemas)
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading schema names")
+	}
+	g.schemaGoNames = schemaGoNames
+	if opts.ReadWriteVariants {
+		g.readWriteSchemas = g.findReadWriteSchemas(swagger.Components.Schemas)
+	}
+	return g, nil
+}
+
+// Returns a copy of the generator which generates the given variant of the
+// schemas which have Request and Response variants, while generating a
+// request or response.
+func (g *generator) withVariant(variant string) *generator {
+	variantGenerator := *g
+	variantGenerator.readWriteVariant = variant
+	return &variantGenerator
 }
 
 type goImport struct {
@@ -68,11 +163,14 @@ var (
 		{lookFor: "echo\\.", packageName: "github.com/labstack/echo/v4"},
 		{lookFor: "errors\\.", packageName: "github.com/pkg/errors"},
 		{lookFor: "fmt\\.", packageName: "fmt"},
+		{lookFor: "gin\\.", packageName: "github.com/gin-gonic/gin"},
 		{lookFor: "gzip\\.", packageName: "compress/gzip"},
 		{lookFor: "http\\.", packageName: "net/http"},
 		{lookFor: "io\\.", packageName: "io"},
 		{lookFor: "ioutil\\.", packageName: "io/ioutil"},
 		{lookFor: "json\\.", packageName: "encoding/json"},
+		{lookFor: "multipart\\.", packageName: "mime/multipart"},
+		{lookFor: "mux\\.(NewRouter|Router|Vars)", packageName: "github.com/gorilla/mux"},
 		{lookFor: "openapi3\\.", packageName: "github.com/getkin/kin-openapi/openapi3"},
 		{lookFor: "openapi_types\\.", alias: "openapi_types", packageName: "github.com/deepmap/oapi-codegen/pkg/types"},
 		{lookFor: "path\\.", packageName: "path"},
@@ -86,22 +184,92 @@ var (
 	}
 )
 
+// The names of the files written by GenerateFiles, each of which holds the
+// code for one concern.
+const (
+	TypesFile  = "types.gen.go"
+	ClientFile = "client.gen.go"
+	ServerFile = "server.gen.go"
+	SpecFile   = "spec.gen.go"
+)
+
+// This is the code for one of the generated files, before its imports are
+// worked out.
+type generatedFile struct {
+	name  string
+	parts []string
+}
+
+func (f generatedFile) isEmpty() bool {
+	return strings.TrimSpace(strings.Join(f.parts, "")) == ""
+}
+
 // Uses the Go templating engine to generate all of our server wrappers from
 // the descriptions we've built up above from the schema objects.
 // opts defines
 func Generate(swagger *openapi3.Swagger, packageName string, opts Options) (string, error) {
+	g, err := newGenerator(swagger, opts)
+	if err != nil {
+		return "", err
+	}
+	t, files, err := g.generateCode(swagger)
+	if err != nil {
+		return "", err
+	}
+
+	var parts []string
+	for _, f := range files {
+		parts = append(parts, f.parts...)
+	}
+	return g.generateFile(t, packageName, parts, true)
+}
+
+// GenerateFiles generates the same code as Generate, but split by concern
+// into the files of a single package; types, client, servers and the
+// embedded spec. It returns the code of each file which isn't empty, keyed
+// by its name, eg, TypesFile.
+func GenerateFiles(swagger *openapi3.Swagger, packageName string, opts Options) (map[string]string, error) {
+	g, err := newGenerator(swagger, opts)
+	if err != nil {
+		return nil, err
+	}
+	t, files, err := g.generateCode(swagger)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]string)
+	for _, f := range files {
+		if f.isEmpty() {
+			continue
+		}
+		// Only the first file documents the package.
+		code, err := g.generateFile(t, packageName, f.parts, len(result) == 0)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("error generating %s", f.name))
+		}
+		result[f.name] = code
+	}
+	return result, nil
+}
+
+// This generates the code for everything requested in the options, grouped
+// by the file it belongs in.
+func (g *generator) generateCode(swagger *openapi3.Swagger) (*template.Template, []generatedFile, error) {
+	opts := g.options
 	filterOperationsByTag(swagger, opts)
 	if !opts.SkipPrune {
 		pruneUnusedComponents(swagger)
 	}
 
-	// This creates the golang templates text package
-	t := template.New("oapi-codegen").Funcs(TemplateFunctions)
+	// This creates the golang templates text package, with the functions
+	// which depend on our state bound to this generator
+	t := template.New("oapi-codegen").Funcs(TemplateFunctions).Funcs(g.templateFunctions())
 	// This parses all of our own template files into the template object
 	// above
 	t, err := templates.Parse(t)
 	if err != nil {
-		return "", errors.Wrap(err, "error parsing oapi-codegen templates")
+		return nil, nil, errors.Wrap(err, "error parsing oapi-codegen templates")
 	}
 
 	// Override built-in templates with user-provided versions
@@ -109,21 +277,21 @@ func Generate(swagger *openapi3.Swagger, packageName string, opts Options) (stri
 		if _, ok := opts.UserTemplates[tpl.Name()]; ok {
 			utpl := t.New(tpl.Name())
 			if _, err := utpl.Parse(opts.UserTemplates[tpl.Name()]); err != nil {
-				return "", errors.Wrapf(err, "error parsing user-provided template %q", tpl.Name())
+				return nil, nil, errors.Wrapf(err, "error parsing user-provided template %q", tpl.Name())
 			}
 		}
 	}
 
-	ops, err := OperationDefinitions(swagger)
+	ops, err := g.operationDefinitions(swagger)
 	if err != nil {
-		return "", errors.Wrap(err, "error creating operation definitions")
+		return nil, nil, errors.Wrap(err, "error creating operation definitions")
 	}
 
 	var typeDefinitions string
 	if opts.GenerateTypes {
-		typeDefinitions, err = GenerateTypeDefinitions(t, swagger, ops)
+		typeDefinitions, err = g.generateTypeDefinitions(t, swagger, ops)
 		if err != nil {
-			return "", errors.Wrap(err, "error generating type definitions")
+			return nil, nil, errors.Wrap(err, "error generating type definitions")
 		}
 	}
 
@@ -131,7 +299,7 @@ func Generate(swagger *openapi3.Swagger, packageName string, opts Options) (stri
 	if opts.GenerateEchoServer {
 		echoServerOut, err = GenerateEchoServer(t, ops)
 		if err != nil {
-			return "", errors.Wrap(err, "error generating Go handlers for Paths")
+			return nil, nil, errors.Wrap(err, "error generating Go handlers for Paths")
 		}
 	}
 
@@ -139,7 +307,48 @@ func Generate(swagger *openapi3.Swagger, packageName string, opts Options) (stri
 	if opts.GenerateChiServer {
 		chiServerOut, err = GenerateChiServer(t, ops)
 		if err != nil {
-			return "", errors.Wrap(err, "error generating Go handlers for Paths")
+			return nil, nil, errors.Wrap(err, "error generating Go handlers for Paths")
+		}
+	}
+
+	var ginServerOut string
+	if opts.GenerateGinServer {
+		ginServerOut, err = GenerateGinServer(t, ops)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error generating Go handlers for Paths")
+		}
+	}
+
+	var gorillaServerOut string
+	if opts.GenerateGorillaServer {
+		gorillaServerOut, err = GenerateGorillaServer(t, ops)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error generating Go handlers for Paths")
+		}
+	}
+
+	var stdHTTPServerOut string
+	if opts.GenerateStdHTTPServer {
+		stdHTTPServerOut, err = GenerateStdHTTPServer(t, ops)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error generating Go handlers for Paths")
+		}
+	}
+
+	var bodyDecodersOut string
+	if opts.GenerateEchoServer || opts.GenerateChiServer || opts.GenerateGinServer ||
+		opts.GenerateGorillaServer || opts.GenerateStdHTTPServer {
+		bodyDecodersOut, err = GenerateRequestBodyDecoders(t, ops)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error generating request body decoders")
+		}
+	}
+
+	var strictServerOut string
+	if opts.GenerateStrictServer {
+		strictServerOut, err = GenerateStrictServer(t, ops, opts)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error generating strict server")
 		}
 	}
 
@@ -147,7 +356,7 @@ func Generate(swagger *openapi3.Swagger, packageName string, opts Options) (stri
 	if opts.GenerateClient {
 		clientOut, err = GenerateClient(t, ops)
 		if err != nil {
-			return "", errors.Wrap(err, "error generating client")
+			return nil, nil, errors.Wrap(err, "error generating client")
 		}
 	}
 
@@ -155,7 +364,7 @@ func Generate(swagger *openapi3.Swagger, packageName string, opts Options) (stri
 	if opts.GenerateClient {
 		clientWithResponsesOut, err = GenerateClientWithResponses(t, ops)
 		if err != nil {
-			return "", errors.Wrap(err, "error generating client with responses")
+			return nil, nil, errors.Wrap(err, "error generating client with responses")
 		}
 	}
 
@@ -163,10 +372,64 @@ func Generate(swagger *openapi3.Swagger, packageName string, opts Options) (stri
 	if opts.EmbedSpec {
 		inlinedSpec, err = GenerateInlinedSpec(t, swagger)
 		if err != nil {
-			return "", errors.Wrap(err, "error generating Go handlers for Paths")
+			return nil, nil, errors.Wrap(err, "error generating Go handlers for Paths")
+		}
+	}
+
+	files := []generatedFile{
+		{name: TypesFile, parts: []string{typeDefinitions}},
+		{name: ClientFile, parts: []string{clientOut, clientWithResponsesOut}},
+		{name: ServerFile, parts: []string{echoServerOut, chiServerOut, ginServerOut, gorillaServerOut, stdHTTPServerOut, bodyDecodersOut, strictServerOut}},
+		{name: SpecFile, parts: []string{inlinedSpec}},
+	}
+	return t, files, nil
+}
+
+// This works out the package names for the import mapping, registering the
+// imports they need. Packages with the same name are given aliases, so that
+// they can be told apart.
+func (g *generator) parseImportMapping(importMapping map[string]string) map[string]string {
+	result := make(map[string]string)
+	for _, document := range SortedStringKeys(importMapping) {
+		result[normalizeRefDocument(document)] = g.importTypesPackage(importMapping[document])
+	}
+	return result
+}
+
+// This registers the import of a package holding generated types, and returns
+// the name which it's referred to by. When another of the packages we import
+// has the same name, it's given an alias with a numeric suffix.
+func (g *generator) importTypesPackage(importPath string) string {
+	if imp, found := g.goTypeImports[importPath]; found {
+		if imp.alias != "" {
+			return imp.alias
+		}
+		return packageNameFromPath(importPath)
+	}
+
+	usedNames := make(map[string]bool)
+	for path, imp := range g.goTypeImports {
+		if imp.alias != "" {
+			usedNames[imp.alias] = true
+		} else {
+			usedNames[packageNameFromPath(path)] = true
 		}
 	}
 
+	packageName := packageNameFromPath(importPath)
+	imp := goImport{packageName: importPath}
+	for i := 2; usedNames[packageName]; i++ {
+		packageName = fmt.Sprintf("%s%d", packageNameFromPath(importPath), i)
+		imp.alias = packageName
+	}
+	imp.lookFor = regexp.QuoteMeta(packageName) + "\\."
+	g.goTypeImports[importPath] = imp
+	return packageName
+}
+
+// This assembles the code for one file, working out the imports it needs,
+// and formatting it.
+func (g *generator) generateFile(t *template.Template, packageName string, parts []string, packageDoc bool) (string, error) {
 	// Imports needed for the generated code to compile
 	var imports []string
 
@@ -174,7 +437,7 @@ func Generate(swagger *openapi3.Swagger, packageName string, opts Options) (stri
 	w := bufio.NewWriter(&buf)
 
 	// Based on module prefixes, figure out which optional imports are required.
-	for _, str := range []string{typeDefinitions, chiServerOut, echoServerOut, clientOut, clientWithResponsesOut, inlinedSpec} {
+	for _, str := range parts {
 		for _, goImport := range allGoImports {
 			match, err := regexp.MatchString(fmt.Sprintf("[^a-zA-Z0-9_]%s", goImport.lookFor), str)
 			if err != nil {
@@ -184,9 +447,18 @@ func Generate(swagger *openapi3.Swagger, packageName string, opts Options) (stri
 				imports = append(imports, goImport.String())
 			}
 		}
+		for _, goImport := range g.goTypeImports {
+			match, err := regexp.MatchString(fmt.Sprintf("[^a-zA-Z0-9_]%s", goImport.lookFor), str)
+			if err != nil {
+				return "", errors.Wrap(err, "error figuring out imports")
+			}
+			if match {
+				imports = append(imports, goImport.String())
+			}
+		}
 	}
 
-	importsOut, err := GenerateImports(t, imports, packageName)
+	importsOut, err := generateImports(t, imports, packageName, packageDoc)
 	if err != nil {
 		return "", errors.Wrap(err, "error generating imports")
 	}
@@ -196,41 +468,10 @@ func Generate(swagger *openapi3.Swagger, packageName string, opts Options) (stri
 		return "", errors.Wrap(err, "error writing imports")
 	}
 
-	_, err = w.WriteString(typeDefinitions)
-	if err != nil {
-		return "", errors.Wrap(err, "error writing type definitions")
-
-	}
-
-	if opts.GenerateClient {
-		_, err = w.WriteString(clientOut)
-		if err != nil {
-			return "", errors.Wrap(err, "error writing client")
-		}
-		_, err = w.WriteString(clientWithResponsesOut)
-		if err != nil {
-			return "", errors.Wrap(err, "error writing client")
-		}
-	}
-
-	if opts.GenerateEchoServer {
-		_, err = w.WriteString(echoServerOut)
-		if err != nil {
-			return "", errors.Wrap(err, "error writing server path handlers")
-		}
-	}
-
-	if opts.GenerateChiServer {
-		_, err = w.WriteString(chiServerOut)
-		if err != nil {
-			return "", errors.Wrap(err, "error writing server path handlers")
-		}
-	}
-
-	if opts.EmbedSpec {
-		_, err = w.WriteString(inlinedSpec)
+	for _, part := range parts {
+		_, err = w.WriteString(part)
 		if err != nil {
-			return "", errors.Wrap(err, "error writing inlined spec")
+			return "", errors.Wrap(err, "error writing generated code")
 		}
 	}
 
@@ -244,7 +485,7 @@ func Generate(swagger *openapi3.Swagger, packageName string, opts Options) (stri
 
 	// The generation code produces unindented horrors. Use the Go formatter
 	// to make it all pretty.
-	if opts.SkipFmt {
+	if g.options.SkipFmt {
 		return goCode, nil
 	}
 	outBytes, err := format.Source([]byte(goCode))
@@ -256,24 +497,28 @@ func Generate(swagger *openapi3.Swagger, packageName string, opts Options) (stri
 }
 
 func GenerateTypeDefinitions(t *template.Template, swagger *openapi3.Swagger, ops []OperationDefinition) (string, error) {
-	schemaTypes, err := GenerateTypesForSchemas(t, swagger.Components.Schemas)
+	return defaultGenerator().generateTypeDefinitions(t, swagger, ops)
+}
+
+func (g *generator) generateTypeDefinitions(t *template.Template, swagger *openapi3.Swagger, ops []OperationDefinition) (string, error) {
+	schemaTypes, err := g.generateTypesForSchemas(swagger.Components.Schemas)
 	if err != nil {
 		return "", errors.Wrap(err, "error generating Go types for component schemas")
 	}
 
-	paramTypes, err := GenerateTypesForParameters(t, swagger.Components.Parameters)
+	paramTypes, err := g.generateTypesForParameters(swagger.Components.Parameters)
 	if err != nil {
 		return "", errors.Wrap(err, "error generating Go types for component parameters")
 	}
 	allTypes := append(schemaTypes, paramTypes...)
 
-	responseTypes, err := GenerateTypesForResponses(t, swagger.Components.Responses)
+	responseTypes, err := g.generateTypesForResponses(swagger.Components.Responses)
 	if err != nil {
 		return "", errors.Wrap(err, "error generating Go types for component responses")
 	}
 	allTypes = append(allTypes, responseTypes...)
 
-	bodyTypes, err := GenerateTypesForRequestBodies(t, swagger.Components.RequestBodies)
+	bodyTypes, err := g.generateTypesForRequestBodies(swagger.Components.RequestBodies)
 	if err != nil {
 		return "", errors.Wrap(err, "error generating Go types for component request bodies")
 	}
@@ -294,30 +539,89 @@ func GenerateTypeDefinitions(t *template.Template, swagger *openapi3.Swagger, op
 		return "", errors.Wrap(err, "error generating allOf boilerplate")
 	}
 
-	typeDefinitions := strings.Join([]string{typesOut, paramTypesOut, allOfBoilerplate}, "")
+	unionBoilerplate, err := GenerateUnionBoilerplate(t, allTypes)
+	if err != nil {
+		return "", errors.Wrap(err, "error generating union boilerplate")
+	}
+
+	enumBoilerplate, err := GenerateEnumBoilerplate(t, allTypes)
+	if err != nil {
+		return "", errors.Wrap(err, "error generating enum boilerplate")
+	}
+
+	nullableBoilerplate, err := GenerateNullableBoilerplate(t, g.nullableTypes)
+	if err != nil {
+		return "", errors.Wrap(err, "error generating nullable boilerplate")
+	}
+
+	validationBoilerplate, err := GenerateValidationBoilerplate(t, allTypes)
+	if err != nil {
+		return "", errors.Wrap(err, "error generating validation boilerplate")
+	}
+
+	typeDefinitions := strings.Join([]string{typesOut, paramTypesOut, allOfBoilerplate, unionBoilerplate, enumBoilerplate, nullableBoilerplate, validationBoilerplate}, "")
 	return typeDefinitions, nil
 }
 
 // Generates type definitions for any custom types defined in the
 // components/schemas section of the Swagger spec.
 func GenerateTypesForSchemas(t *template.Template, schemas map[string]*openapi3.SchemaRef) ([]TypeDefinition, error) {
+	return defaultGenerator().generateTypesForSchemas(schemas)
+}
+
+func (g *generator) generateTypesForSchemas(schemas map[string]*openapi3.SchemaRef) ([]TypeDefinition, error) {
 	types := make([]TypeDefinition, 0)
 	// We're going to define Go types for every object under components/schemas
 	for _, schemaName := range SortedSchemaKeys(schemas) {
 		schemaRef := schemas[schemaName]
+		// Shared schemas are defined in their own package
+		if _, shared := g.sharedSchemas[schemaName]; shared {
+			continue
+		}
 
-		goSchema, err := GenerateGoSchema(schemaRef, []string{schemaName})
+		goSchema, err := g.generateGoSchema(schemaRef, []string{schemaName})
 		if err != nil {
 			return nil, errors.Wrap(err, fmt.Sprintf("error converting Schema %s to Go type", schemaName))
 		}
 
 		types = append(types, TypeDefinition{
 			JsonName: schemaName,
-			TypeName: SchemaNameToTypeName(schemaName),
+			TypeName: g.schemaTypeName(schemaName),
 			Schema:   goSchema,
 		})
 
 		types = append(types, goSchema.GetAdditionalTypeDefs()...)
+
+		if g.readWriteSchemas[g.schemaTypeName(schemaName)] {
+			variants, err := g.generateReadWriteVariants(schemaName, schemaRef)
+			if err != nil {
+				return nil, err
+			}
+			types = append(types, variants...)
+		}
+	}
+	return types, nil
+}
+
+// Generates the Request and Response variants of a schema with readOnly or
+// writeOnly properties, which leave out the properties which don't belong
+// in each direction.
+func (g *generator) generateReadWriteVariants(schemaName string, schemaRef *openapi3.SchemaRef) ([]TypeDefinition, error) {
+	var types []TypeDefinition
+	for _, variant := range []string{requestVariant, responseVariant} {
+		typeName := g.schemaTypeName(schemaName) + variant
+
+		goSchema, err := g.withVariant(variant).generateGoSchema(schemaRef, []string{typeName})
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("error converting Schema %s to Go type", typeName))
+		}
+
+		types = append(types, TypeDefinition{
+			JsonName: schemaName,
+			TypeName: typeName,
+			Schema:   goSchema,
+		})
+		types = append(types, goSchema.GetAdditionalTypeDefs()...)
 	}
 	return types, nil
 }
@@ -325,11 +629,15 @@ func GenerateTypesForSchemas(t *template.Template, schemas map[string]*openapi3.
 // Generates type definitions for any custom types defined in the
 // components/parameters section of the Swagger spec.
 func GenerateTypesForParameters(t *template.Template, params map[string]*openapi3.ParameterRef) ([]TypeDefinition, error) {
+	return defaultGenerator().generateTypesForParameters(params)
+}
+
+func (g *generator) generateTypesForParameters(params map[string]*openapi3.ParameterRef) ([]TypeDefinition, error) {
 	var types []TypeDefinition
 	for _, paramName := range SortedParameterKeys(params) {
 		paramOrRef := params[paramName]
 
-		goType, err := paramToGoType(paramOrRef.Value, nil)
+		goType, err := g.paramToGoType(paramOrRef.Value, nil)
 		if err != nil {
 			return nil, errors.Wrap(err, fmt.Sprintf("error generating Go type for schema in parameter %s", paramName))
 		}
@@ -342,7 +650,7 @@ func GenerateTypesForParameters(t *template.Template, params map[string]*openapi
 
 		if paramOrRef.Ref != "" {
 			// Generate a reference type for referenced parameters
-			refType, err := RefPathToGoType(paramOrRef.Ref)
+			refType, err := g.refPathToGoType(paramOrRef.Ref)
 			if err != nil {
 				return nil, errors.Wrap(err, fmt.Sprintf("error generating Go type for (%s) in parameter %s", paramOrRef.Ref, paramName))
 			}
@@ -357,6 +665,10 @@ func GenerateTypesForParameters(t *template.Template, params map[string]*openapi
 // Generates type definitions for any custom types defined in the
 // components/responses section of the Swagger spec.
 func GenerateTypesForResponses(t *template.Template, responses openapi3.Responses) ([]TypeDefinition, error) {
+	return defaultGenerator().generateTypesForResponses(responses)
+}
+
+func (g *generator) generateTypesForResponses(responses openapi3.Responses) ([]TypeDefinition, error) {
 	var types []TypeDefinition
 
 	for _, responseName := range SortedResponsesKeys(responses) {
@@ -368,7 +680,7 @@ func GenerateTypesForResponses(t *template.Template, responses openapi3.Response
 		response := responseOrRef.Value
 		jsonResponse, found := response.Content["application/json"]
 		if found {
-			goType, err := GenerateGoSchema(jsonResponse.Schema, []string{responseName})
+			goType, err := g.withVariant(responseVariant).generateGoSchema(jsonResponse.Schema, []string{responseName})
 			if err != nil {
 				return nil, errors.Wrap(err, fmt.Sprintf("error generating Go type for schema in response %s", responseName))
 			}
@@ -381,7 +693,7 @@ func GenerateTypesForResponses(t *template.Template, responses openapi3.Response
 
 			if responseOrRef.Ref != "" {
 				// Generate a reference type for referenced parameters
-				refType, err := RefPathToGoType(responseOrRef.Ref)
+				refType, err := g.refPathToGoType(responseOrRef.Ref)
 				if err != nil {
 					return nil, errors.Wrap(err, fmt.Sprintf("error generating Go type for (%s) in parameter %s", responseOrRef.Ref, responseName))
 				}
@@ -396,6 +708,10 @@ func GenerateTypesForResponses(t *template.Template, responses openapi3.Response
 // Generates type definitions for any custom types defined in the
 // components/requestBodies section of the Swagger spec.
 func GenerateTypesForRequestBodies(t *template.Template, bodies map[string]*openapi3.RequestBodyRef) ([]TypeDefinition, error) {
+	return defaultGenerator().generateTypesForRequestBodies(bodies)
+}
+
+func (g *generator) generateTypesForRequestBodies(bodies map[string]*openapi3.RequestBodyRef) ([]TypeDefinition, error) {
 	var types []TypeDefinition
 
 	for _, bodyName := range SortedRequestBodyKeys(bodies) {
@@ -406,7 +722,7 @@ func GenerateTypesForRequestBodies(t *template.Template, bodies map[string]*open
 		response := bodyOrRef.Value
 		jsonBody, found := response.Content["application/json"]
 		if found {
-			goType, err := GenerateGoSchema(jsonBody.Schema, []string{bodyName})
+			goType, err := g.withVariant(requestVariant).generateGoSchema(jsonBody.Schema, []string{bodyName})
 			if err != nil {
 				return nil, errors.Wrap(err, fmt.Sprintf("error generating Go type for schema in body %s", bodyName))
 			}
@@ -419,7 +735,7 @@ func GenerateTypesForRequestBodies(t *template.Template, bodies map[string]*open
 
 			if bodyOrRef.Ref != "" {
 				// Generate a reference type for referenced bodies
-				refType, err := RefPathToGoType(bodyOrRef.Ref)
+				refType, err := g.refPathToGoType(bodyOrRef.Ref)
 				if err != nil {
 					return nil, errors.Wrap(err, fmt.Sprintf("error generating Go type for (%s) in body %s", bodyOrRef.Ref, bodyName))
 				}
@@ -456,6 +772,13 @@ func GenerateTypes(t *template.Template, types []TypeDefinition) (string, error)
 
 // Generate our import statements and package definition.
 func GenerateImports(t *template.Template, imports []string, packageName string) (string, error) {
+	return generateImports(t, imports, packageName, true)
+}
+
+// This generates the import statements and package definition of one of
+// several files in a package, which is only documented when packageDoc is
+// set.
+func generateImports(t *template.Template, imports []string, packageName string, packageDoc bool) (string, error) {
 	sort.Strings(imports)
 
 	var buf bytes.Buffer
@@ -463,9 +786,11 @@ func GenerateImports(t *template.Template, imports []string, packageName string)
 	context := struct {
 		Imports     []string
 		PackageName string
+		PackageDoc  bool
 	}{
 		Imports:     imports,
 		PackageName: packageName,
+		PackageDoc:  packageDoc,
 	}
 	err := t.ExecuteTemplate(w, "imports.tmpl", context)
 	if err != nil {
@@ -508,6 +833,140 @@ func GenerateAdditionalPropertyBoilerplate(t *template.Template, typeDefs []Type
 	return buf.String(), nil
 }
 
+// Generate the accessors and JSON-ification code for oneOf/anyOf union types
+func GenerateUnionBoilerplate(t *template.Template, typeDefs []TypeDefinition) (string, error) {
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+
+	var filteredTypes []TypeDefinition
+	for _, t := range typeDefs {
+		if len(t.Schema.UnionElements) != 0 {
+			filteredTypes = append(filteredTypes, t)
+		}
+	}
+
+	context := struct {
+		Types []TypeDefinition
+	}{
+		Types: filteredTypes,
+	}
+
+	err := t.ExecuteTemplate(w, "union.tmpl", context)
+	if err != nil {
+		return "", errors.Wrap(err, "error generating union code")
+	}
+	err = w.Flush()
+	if err != nil {
+		return "", errors.Wrap(err, "error flushing output buffer for unions")
+	}
+	return buf.String(), nil
+}
+
+// Generates the constants and helper methods for types which are enums.
+func GenerateEnumBoilerplate(t *template.Template, typeDefs []TypeDefinition) (string, error) {
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+
+	var filteredTypes []TypeDefinition
+	for _, t := range typeDefs {
+		if len(t.Schema.EnumValues) != 0 {
+			filteredTypes = append(filteredTypes, t)
+		}
+	}
+
+	context := struct {
+		Types []TypeDefinition
+	}{
+		Types: filteredTypes,
+	}
+
+	err := t.ExecuteTemplate(w, "enums.tmpl", context)
+	if err != nil {
+		return "", errors.Wrap(err, "error generating enum code")
+	}
+	err = w.Flush()
+	if err != nil {
+		return "", errors.Wrap(err, "error flushing output buffer for enums")
+	}
+	return buf.String(), nil
+}
+
+// Generates the Validate methods of the given types, when validation is
+// enabled. Aliases and interfaces are skipped, since they can't have methods of
+// their own.
+func GenerateValidationBoilerplate(t *template.Template, typeDefs []TypeDefinition) (string, error) {
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+
+	var filteredTypes []TypeDefinition
+	for _, t := range typeDefs {
+		if !t.Schema.DefineViaAlias && t.Schema.TypeDecl() != "interface{}" {
+			filteredTypes = append(filteredTypes, t)
+		}
+	}
+
+	context := struct {
+		Types []TypeDefinition
+	}{
+		Types: filteredTypes,
+	}
+
+	err := t.ExecuteTemplate(w, "validate.tmpl", context)
+	if err != nil {
+		return "", errors.Wrap(err, "error generating validation code")
+	}
+	err = w.Flush()
+	if err != nil {
+		return "", errors.Wrap(err, "error flushing output buffer for validation")
+	}
+	return buf.String(), nil
+}
+
+// Generates the Nullable wrappers for the generated types which are used by
+// nullable properties, which are given by the names of the wrappers, mapped to
+// the types they wrap.
+func GenerateNullableBoilerplate(t *template.Template, wrappedTypes map[string]string) (string, error) {
+	return GenerateNullableTypes(t, wrappedTypes, "openapi_types.")
+}
+
+// Generates Nullable wrappers like GenerateNullableBoilerplate, in a package
+// where the errors of pkg/types are qualified by typesPrefix, which is empty
+// for the Nullable types of pkg/types itself.
+func GenerateNullableTypes(t *template.Template, wrappedTypes map[string]string, typesPrefix string) (string, error) {
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+
+	type nullableType struct {
+		TypeName    string
+		ElementType string
+	}
+	var nullableTypes []nullableType
+	for _, typeName := range SortedStringKeys(wrappedTypes) {
+		nullableTypes = append(nullableTypes, nullableType{
+			TypeName:    typeName,
+			ElementType: wrappedTypes[typeName],
+		})
+	}
+
+	context := struct {
+		TypesPrefix string
+		Types       []nullableType
+	}{
+		TypesPrefix: typesPrefix,
+		Types:       nullableTypes,
+	}
+
+	err := t.ExecuteTemplate(w, "nullable.tmpl", context)
+	if err != nil {
+		return "", errors.Wrap(err, "error generating nullable code")
+	}
+	err = w.Flush()
+	if err != nil {
+		return "", errors.Wrap(err, "error flushing output buffer for nullable types")
+	}
+	return buf.String(), nil
+}
+
 // SanitizeCode runs sanitizers across the generated Go code to ensure the
 // generated code will be able to compile.
 func SanitizeCode(goCode string) string {
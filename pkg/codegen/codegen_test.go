@@ -5,6 +5,7 @@ import (
 	"go/format"
 	"io/ioutil"
 	"net/http"
+	"sync"
 	"testing"
 
 	examplePetstoreClient "github.com/deepmap/oapi-codegen/examples/petstore-expanded"
@@ -59,6 +60,118 @@ func TestExamplePetStoreCodeGeneration(t *testing.T) {
 	assert.Len(t, problems, 0)
 }
 
+func TestExamplePetStoreGenerateFiles(t *testing.T) {
+	opts := Options{
+		GenerateClient:     true,
+		GenerateEchoServer: true,
+		GenerateTypes:      true,
+		EmbedSpec:          true,
+	}
+
+	swagger, err := examplePetstore.GetSwagger()
+	assert.NoError(t, err)
+
+	files, err := GenerateFiles(swagger, "api", opts)
+	assert.NoError(t, err)
+	assert.Len(t, files, 4)
+
+	// Each file has the imports it needs, and no others
+	assert.Contains(t, files[TypesFile], "type Pet struct")
+	assert.NotContains(t, files[TypesFile], "import")
+	assert.Contains(t, files[ClientFile], "func NewClient(")
+	assert.Contains(t, files[ClientFile], `"net/url"`)
+	assert.NotContains(t, files[ClientFile], `"compress/gzip"`)
+	assert.Contains(t, files[ServerFile], "type ServerInterface interface")
+	assert.Contains(t, files[ServerFile], `"github.com/labstack/echo/v4"`)
+	assert.Contains(t, files[SpecFile], "func GetSwagger()")
+	assert.Contains(t, files[SpecFile], `"compress/gzip"`)
+
+	// Only the first file documents the package
+	assert.Contains(t, files[TypesFile], "// Package api provides primitives")
+	for name, code := range files {
+		assert.Contains(t, code, "// Code generated by github.com/deepmap/oapi-codegen DO NOT EDIT.", name)
+		if name != TypesFile {
+			assert.NotContains(t, code, "// Package api", name)
+		}
+
+		linter := new(lint.Linter)
+		problems, err := linter.Lint(name, []byte(code))
+		assert.NoError(t, err)
+		assert.Len(t, problems, 0, name)
+	}
+
+	// Empty files are left out
+	opts.GenerateEchoServer = false
+	opts.EmbedSpec = false
+	files, err = GenerateFiles(swagger, "api", opts)
+	assert.NoError(t, err)
+	assert.Len(t, files, 2)
+}
+
+func TestExamplePetStoreTypesPackage(t *testing.T) {
+	swagger, err := examplePetstore.GetSwagger()
+	assert.NoError(t, err)
+
+	opts := Options{
+		GenerateClient: true,
+		TypesPackage:   "github.com/deepmap/oapi-codegen/examples/petstore-expanded/v2/models",
+	}
+	code, err := Generate(swagger, "client", opts)
+	assert.NoError(t, err)
+
+	// The types are qualified with the name of their package, skipping the
+	// major version in its path
+	assert.Contains(t, code, `"github.com/deepmap/oapi-codegen/examples/petstore-expanded/v2/models"`)
+	assert.Contains(t, code, "params *models.FindPetsParams")
+	assert.Contains(t, code, "body models.AddPetJSONRequestBody")
+	assert.Contains(t, code, "JSON200      *[]models.Pet")
+	assert.NotContains(t, code, "type Pet struct")
+
+	// The types can't be generated into both packages
+	opts.GenerateTypes = true
+	_, err = Generate(swagger, "client", opts)
+	assert.Error(t, err)
+}
+
+func TestGenerateConcurrently(t *testing.T) {
+	optionSets := []Options{
+		{GenerateClient: true, TypesPackage: "github.com/deepmap/oapi-codegen/examples/petstore-expanded/models"},
+		{GenerateTypes: true, GenerateClient: true, GenerateValidation: true},
+		{GenerateTypes: true, NullableTypes: true, ReadWriteVariants: true},
+	}
+
+	// Each call of Generate has its own state, so the code generated with
+	// one set of options is the same whatever the others generate, whether
+	// it's generated before them, after them, or alongside them.
+	expected := make([]string, len(optionSets))
+	for i, opts := range optionSets {
+		swagger, err := examplePetstore.GetSwagger()
+		assert.NoError(t, err)
+		expected[i], err = Generate(swagger, "api", opts)
+		assert.NoError(t, err)
+	}
+	assert.NotContains(t, expected[0], "Validate()")
+	assert.NotContains(t, expected[1], "models.")
+
+	var wg sync.WaitGroup
+	results := make([]string, 4*len(optionSets))
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			swagger, err := examplePetstore.GetSwagger()
+			if err == nil {
+				results[i], err = Generate(swagger, "api", optionSets[i%len(optionSets)])
+			}
+			assert.NoError(t, err)
+		}(i)
+	}
+	wg.Wait()
+	for i, code := range results {
+		assert.Equal(t, expected[i%len(optionSets)], code)
+	}
+}
+
 func TestExamplePetStoreCodeGenerationWithUserTemplates(t *testing.T) {
 
 	userTemplates := map[string]string{"typedef.tmpl": "//blah"}
@@ -0,0 +1,147 @@
+package codegen
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/pkg/errors"
+)
+
+const (
+	// extGoType overrides the Go type of a schema, eg, "decimal.Decimal"
+	extGoType = "x-go-type"
+	// extGoTypeImport is the package which provides the type in x-go-type,
+	// either as a path, or as an object with a path and a package name.
+	extGoTypeImport = "x-go-type-import"
+	// extGoName overrides the Go name of a property, or of a component schema
+	extGoName = "x-go-name"
+)
+
+var (
+	majorVersionRE = regexp.MustCompile(`^v[0-9]+$`)
+	gopkgVersionRE = regexp.MustCompile(`\.v[0-9]+$`)
+)
+
+// This decodes the value of an extension into dst, returning whether the
+// extension was present. The loader leaves extension values as raw JSON, but
+// we also handle values which were set programmatically.
+func extensionValue(extensions map[string]interface{}, name string, dst interface{}) (bool, error) {
+	value, found := extensions[name]
+	if !found {
+		return false, nil
+	}
+
+	raw, ok := value.(json.RawMessage)
+	if !ok {
+		var err error
+		raw, err = json.Marshal(value)
+		if err != nil {
+			return false, errors.Wrap(err, fmt.Sprintf("error reading %s", name))
+		}
+	}
+	err := json.Unmarshal(raw, dst)
+	if err != nil {
+		return false, errors.Wrap(err, fmt.Sprintf("invalid value for %s", name))
+	}
+	return true, nil
+}
+
+// Returns the string value of an extension, or "" when it's not present.
+func extensionString(extensions map[string]interface{}, name string) (string, error) {
+	var value string
+	_, err := extensionValue(extensions, name, &value)
+	return value, err
+}
+
+// This reads x-go-type-import, which is either an import path, or an object
+// with a path and the name of the package, for packages whose name doesn't
+// match their path.
+func extensionGoTypeImport(extensions map[string]interface{}) (*goImport, error) {
+	var value interface{}
+	found, err := extensionValue(extensions, extGoTypeImport, &value)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	var importPath, name string
+	switch v := value.(type) {
+	case string:
+		importPath = v
+	case map[string]interface{}:
+		importPath, _ = v["path"].(string)
+		name, _ = v["name"].(string)
+	}
+	if importPath == "" {
+		return nil, fmt.Errorf("%s must be an import path, or an object with a path", extGoTypeImport)
+	}
+
+	packageName := name
+	if packageName == "" {
+		packageName = packageNameFromPath(importPath)
+	}
+
+	imp := goImport{
+		lookFor:     regexp.QuoteMeta(packageName) + "\\.",
+		packageName: importPath,
+	}
+	if name != "" {
+		imp.alias = name
+	}
+	return &imp, nil
+}
+
+// This guesses the name of a package from its import path; it's the last
+// element of the path, skipping any major version suffix, whether it's a
+// separate element, or a gopkg.in style one, eg, "gopkg.in/yaml.v2".
+func packageNameFromPath(importPath string) string {
+	packageName := path.Base(importPath)
+	if majorVersionRE.MatchString(packageName) {
+		packageName = path.Base(path.Dir(importPath))
+	}
+	return gopkgVersionRE.ReplaceAllString(packageName, "")
+}
+
+// This generates the schema for a type overridden with x-go-type, and records
+// the import it needs, if any. It returns nil when there's no override.
+func (g *generator) goTypeOverride(schema *openapi3.Schema) (*Schema, error) {
+	goType, err := extensionString(schema.Extensions, extGoType)
+	if err != nil || goType == "" {
+		return nil, err
+	}
+
+	imp, err := extensionGoTypeImport(schema.Extensions)
+	if err != nil {
+		return nil, err
+	}
+	if imp != nil {
+		g.goTypeImports[imp.packageName] = *imp
+	}
+
+	return &Schema{
+		GoType:         goType,
+		DefineViaAlias: true,
+	}, nil
+}
+
+// This collects the Go names given to component schemas with x-go-name, so
+// that references to them can use the same name.
+func findSchemaGoNames(schemas map[string]*openapi3.SchemaRef) (map[string]string, error) {
+	result := make(map[string]string)
+	for name, schemaRef := range schemas {
+		if schemaRef.Ref != "" || schemaRef.Value == nil {
+			continue
+		}
+		goName, err := extensionString(schemaRef.Value.Extensions, extGoName)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("error reading Go name of schema %s", name))
+		}
+		if strings.TrimSpace(goName) != "" {
+			result[name] = goName
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,34 @@
+package codegen
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtensionGoTypeImport(t *testing.T) {
+	// The package name comes from the path, skipping major versions
+	imp, err := extensionGoTypeImport(map[string]interface{}{
+		extGoTypeImport: json.RawMessage(`"github.com/labstack/echo/v4"`),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, `"github.com/labstack/echo/v4"`, imp.String())
+	assert.Equal(t, `echo\.`, imp.lookFor)
+
+	// Or it can be given explicitly, and is then used as an alias
+	imp, err = extensionGoTypeImport(map[string]interface{}{
+		extGoTypeImport: map[string]interface{}{"path": "github.com/shopspring/decimal", "name": "dec"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, `dec "github.com/shopspring/decimal"`, imp.String())
+
+	imp, err = extensionGoTypeImport(map[string]interface{}{})
+	assert.NoError(t, err)
+	assert.Nil(t, imp)
+
+	_, err = extensionGoTypeImport(map[string]interface{}{
+		extGoTypeImport: json.RawMessage(`{"name":"dec"}`),
+	})
+	assert.Error(t, err)
+}
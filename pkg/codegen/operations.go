@@ -17,6 +17,7 @@ import (
 	"bufio"
 	"bytes"
 	"fmt"
+	"sort"
 	"strings"
 	"text/template"
 	"unicode"
@@ -123,6 +124,13 @@ func (pd ParameterDefinition) GoName() string {
 	return ToCamelCase(pd.ParamName)
 }
 
+// Returns the name of the struct field which holds the parameter, the same
+// way as in the params object, such as in the request objects of the strict
+// server.
+func (pd ParameterDefinition) GoFieldName() string {
+	return SchemaNameToTypeName(pd.ParamName)
+}
+
 func (pd ParameterDefinition) IndirectOptional() bool {
 	return !pd.Required && !pd.Schema.SkipOptionalPointer
 }
@@ -142,11 +150,15 @@ func (p ParameterDefinitions) FindByName(name string) *ParameterDefinition {
 // descriptors into a flat list. This makes it a lot easier to traverse the
 // data in the template engine.
 func DescribeParameters(params openapi3.Parameters, path []string) ([]ParameterDefinition, error) {
+	return defaultGenerator().describeParameters(params, path)
+}
+
+func (g *generator) describeParameters(params openapi3.Parameters, path []string) ([]ParameterDefinition, error) {
 	outParams := make([]ParameterDefinition, 0)
 	for _, paramOrRef := range params {
 		param := paramOrRef.Value
 
-		goType, err := paramToGoType(param, append(path, param.Name))
+		goType, err := g.paramToGoType(param, append(path, param.Name))
 		if err != nil {
 			return nil, fmt.Errorf("error generating type for param (%s): %s",
 				param.Name, err)
@@ -164,7 +176,7 @@ func DescribeParameters(params openapi3.Parameters, path []string) ([]ParameterD
 		// name as the type. $ref: "#/components/schemas/custom_type" becomes
 		// "CustomType".
 		if paramOrRef.Ref != "" {
-			goType, err := RefPathToGoType(paramOrRef.Ref)
+			goType, err := g.refPathToGoType(paramOrRef.Ref)
 			if err != nil {
 				return nil, fmt.Errorf("error dereferencing (%s) for param (%s): %s",
 					paramOrRef.Ref, param.Name, err)
@@ -209,6 +221,17 @@ type OperationDefinition struct {
 	Method              string                  // GET, POST, DELETE, etc.
 	Path                string                  // The Swagger path for the operation, like /resource/{id}
 	Spec                *openapi3.Operation
+
+	gen *generator // The generator which described the operation, which its types depend on
+}
+
+// Returns the generator which described the operation, or one with the
+// default options, for operations which were described some other way.
+func (o *OperationDefinition) generator() *generator {
+	if o.gen == nil {
+		return defaultGenerator()
+	}
+	return o.gen
 }
 
 // Returns the list of all parameters except Path parameters. Path parameters
@@ -259,6 +282,7 @@ func (o *OperationDefinition) SummaryAsComment() string {
 // response object for automatic deserialization of responses in the generated
 // Client code. See "client-with-responses.tmpl".
 func (o *OperationDefinition) GetResponseTypeDefinitions() ([]TypeDefinition, error) {
+	g := o.generator().withVariant(responseVariant)
 	var tds []TypeDefinition
 
 	responses := o.Spec.Responses
@@ -273,11 +297,6 @@ func (o *OperationDefinition) GetResponseTypeDefinitions() ([]TypeDefinition, er
 				contentType := responseRef.Value.Content[contentTypeName]
 				// We can only generate a type if we have a schema:
 				if contentType.Schema != nil {
-					responseSchema, err := GenerateGoSchema(contentType.Schema, []string{responseName})
-					if err != nil {
-						return nil, errors.Wrap(err, fmt.Sprintf("Unable to determine Go type for %s.%s", o.OperationId, contentTypeName))
-					}
-
 					var typeName string
 					switch {
 					case StringInArray(contentTypeName, contentTypesJSON):
@@ -288,17 +307,34 @@ func (o *OperationDefinition) GetResponseTypeDefinitions() ([]TypeDefinition, er
 					// XML:
 					case StringInArray(contentTypeName, contentTypesXML):
 						typeName = fmt.Sprintf("XML%s", ToCamelCase(responseName))
-					default:
+					// A range, such as image/*, has no decoder of its own:
+					case strings.HasSuffix(contentTypeName, "/*"):
 						continue
+					// Everything else is decoded by the decoder which the
+					// client has for it, if any.
+					default:
+						typeName = mediaTypeToCamelCase(contentTypeName) + ToCamelCase(responseName)
+					}
+
+					responsePath := []string{o.OperationId, typeName}
+					responseSchema, err := g.generateGoSchema(contentType.Schema, responsePath)
+					if err != nil {
+						return nil, errors.Wrap(err, fmt.Sprintf("Unable to determine Go type for %s.%s", o.OperationId, contentTypeName))
+					}
+
+					// Inline unions need a named type for their accessors.
+					if len(responseSchema.UnionElements) != 0 && responseSchema.RefType == "" {
+						responseSchema = g.defineNamedType(responseSchema, responsePath)
 					}
 
 					td := TypeDefinition{
 						TypeName:     typeName,
 						Schema:       responseSchema,
 						ResponseName: responseName,
+						ContentType:  contentTypeName,
 					}
 					if contentType.Schema.Ref != "" {
-						refType, err := RefPathToGoType(contentType.Schema.Ref)
+						refType, err := g.refPathToGoType(contentType.Schema.Ref)
 						if err != nil {
 							return nil, errors.Wrap(err, "error dereferencing response Ref")
 						}
@@ -312,6 +348,262 @@ func (o *OperationDefinition) GetResponseTypeDefinitions() ([]TypeDefinition, er
 	return tds, nil
 }
 
+// This describes one of the responses of an operation, for one of its content
+// types, which the strict server returns as a type of its own.
+type ResponseDefinition struct {
+	TypeName    string // The name of the response type, such as FindPetById200JSONResponse
+	StatusCode  string // The status code from the spec, such as 200, 4XX or default
+	ContentType string // The content type of the body, empty when there's no body
+	NameTag     string // JSON for application/json bodies, or a tag made from their content type
+	Schema      Schema // The schema of a JSON body
+
+	// Methods can't be declared on a type whose underlying type is an
+	// interface or a pointer, so when that's the case for a JSON body, the
+	// response type wraps it in a Body field rather than being defined as it.
+	methodless bool
+}
+
+// Returns whether the response is for a single status code, rather than for a
+// range of them, or the default, in which case the status code is a field of
+// the response.
+func (r ResponseDefinition) HasFixedStatusCode() bool {
+	if len(r.StatusCode) != 3 {
+		return false
+	}
+	for _, c := range r.StatusCode {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns whether the body is encoded as JSON from a value of the schema,
+// which is the case for media types with a +json suffix, such as
+// application/problem+json, too. The other bodies are streamed from an
+// io.Reader.
+func (r ResponseDefinition) IsJSON() bool {
+	return isJSONMediaType(r.ContentType)
+}
+
+// Returns whether the response sets its Content-Type header, which it can't
+// when it has no body, or when its content type is a wildcard, such as */*.
+func (r ResponseDefinition) SetsContentType() bool {
+	return r.ContentType != "" && !strings.Contains(r.ContentType, "*")
+}
+
+// Returns whether the response type is a struct with a Body field, rather than
+// being defined as the type of its JSON body.
+func (r ResponseDefinition) HasBodyField() bool {
+	return !r.IsJSON() || r.methodless || !r.HasFixedStatusCode()
+}
+
+// Produces the list of responses of an Operation, one for each of the content
+// types of each status code, for the response types of the strict server. See
+// "strict-interface.tmpl".
+func (o *OperationDefinition) GetResponseDefinitions() ([]ResponseDefinition, error) {
+	// The JSON bodies share their schemas with the client's responses.
+	typeDefinitions, err := o.GetResponseTypeDefinitions()
+	if err != nil {
+		return nil, err
+	}
+	jsonSchemas := make(map[string]Schema)
+	for _, td := range typeDefinitions {
+		if isJSONMediaType(td.ContentType) {
+			jsonSchemas[td.ResponseName+" "+td.ContentType] = td.Schema
+		}
+	}
+
+	var rds []ResponseDefinition
+	seen := make(map[string]bool)
+	add := func(rd ResponseDefinition) {
+		rd.TypeName = o.OperationId + ToCamelCase(rd.StatusCode) + rd.NameTag + "Response"
+		if !seen[rd.TypeName] {
+			seen[rd.TypeName] = true
+			rds = append(rds, rd)
+		}
+	}
+
+	responses := o.Spec.Responses
+	for _, responseName := range SortedResponsesKeys(responses) {
+		responseRef := responses[responseName]
+		if responseRef.Value == nil {
+			continue
+		}
+		if len(responseRef.Value.Content) == 0 {
+			add(ResponseDefinition{StatusCode: responseName})
+			continue
+		}
+		for _, contentTypeName := range SortedContentKeys(responseRef.Value.Content) {
+			contentType := responseRef.Value.Content[contentTypeName]
+			if schema, found := jsonSchemas[responseName+" "+contentTypeName]; found {
+				methodless, err := o.generator().isMethodless(contentType.Schema)
+				if err != nil {
+					return nil, errors.Wrap(err, fmt.Sprintf("error checking the type of %s.%s", o.OperationId, contentTypeName))
+				}
+				nameTag := "JSON"
+				if !StringInArray(contentTypeName, contentTypesJSON) {
+					nameTag = mediaTypeToCamelCase(contentTypeName)
+				}
+				add(ResponseDefinition{
+					StatusCode:  responseName,
+					ContentType: contentTypeName,
+					NameTag:     nameTag,
+					Schema:      schema,
+					methodless:  methodless,
+				})
+				continue
+			}
+			add(ResponseDefinition{
+				StatusCode:  responseName,
+				ContentType: contentTypeName,
+				NameTag:     mediaTypeToCamelCase(contentTypeName),
+			})
+		}
+	}
+	return rds, nil
+}
+
+// This describes the headers of one of the responses of an operation, which
+// the client with responses binds into a struct of their own.
+type ResponseHeadersDefinition struct {
+	TypeName   string                // The name of the struct, such as FindPets200ResponseHeaders
+	StatusCode string                // The status code from the spec, such as 200, 4XX or default
+	Headers    []ParameterDefinition // The headers, described as header parameters
+	Schema     Schema                // The schema of the struct
+}
+
+// Returns the name of the field of the client's response which holds the
+// headers, such as Headers200.
+func (r ResponseHeadersDefinition) FieldName() string {
+	return "Headers" + ToCamelCase(r.StatusCode)
+}
+
+// Returns the condition on the status code of the client's response for these
+// headers, which is empty for the default response.
+func (r ResponseHeadersDefinition) StatusCondition() string {
+	return genStatusCondition(r.StatusCode)
+}
+
+// Produces the headers of each of the responses of an Operation, in the order
+// that the client matches the status codes of responses in, so that those of
+// a range, such as 4XX, come after those of a status code in it. Responses
+// without headers are described too, so that the default isn't matched for
+// them, unless none of the responses has headers, when there are none.
+func (o *OperationDefinition) GetResponseHeadersDefinitions() ([]ResponseHeadersDefinition, error) {
+	var rhds []ResponseHeadersDefinition
+	hasHeaders := false
+
+	responses := o.Spec.Responses
+	for _, responseName := range SortedResponsesKeys(responses) {
+		responseRef := responses[responseName]
+		if responseRef.Value == nil {
+			continue
+		}
+		// The spec says that a Content-Type header is ignored, since it's
+		// described by the content of the response.
+		var params openapi3.Parameters
+		for _, headerName := range SortedHeadersKeys(responseRef.Value.Headers) {
+			header := responseRef.Value.Headers[headerName].Value
+			if header == nil || strings.EqualFold(headerName, "Content-Type") {
+				continue
+			}
+			params = append(params, &openapi3.ParameterRef{
+				Value: &openapi3.Parameter{
+					Name:        headerName,
+					In:          "header",
+					Description: header.Description,
+					Required:    header.Required,
+					Schema:      header.Schema,
+					Content:     header.Content,
+				},
+			})
+		}
+
+		typeName := o.OperationId + ToCamelCase(responseName) + "ResponseHeaders"
+		headers, err := o.generator().describeParameters(params, []string{typeName})
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("error describing the headers of %s.%s", o.OperationId, responseName))
+		}
+		s := Schema{}
+		for _, header := range headers {
+			s.Properties = append(s.Properties, Property{
+				Description:   header.Spec.Description,
+				JsonFieldName: header.ParamName,
+				Required:      header.Required,
+				Schema:        header.Schema,
+			})
+		}
+		s.GoType = GenStructFromSchema(s)
+
+		hasHeaders = hasHeaders || len(headers) != 0
+		rhds = append(rhds, ResponseHeadersDefinition{
+			TypeName:   typeName,
+			StatusCode: responseName,
+			Headers:    headers,
+			Schema:     s,
+		})
+	}
+	if !hasHeaders {
+		return nil, nil
+	}
+
+	sort.SliceStable(rhds, func(i, j int) bool {
+		return responseSpecificity(rhds[i].StatusCode) < responseSpecificity(rhds[j].StatusCode)
+	})
+	return rhds, nil
+}
+
+// This tells whether the Go type of a schema, after following its reference,
+// is an interface or a pointer, which a type defined as it can't have methods.
+func (g *generator) isMethodless(sref *openapi3.SchemaRef) (bool, error) {
+	schema, err := g.probeSchema(sref)
+	if err != nil {
+		return false, err
+	}
+	goType := schema.TypeDecl()
+	return strings.HasPrefix(goType, "interface") || strings.HasPrefix(goType, "*"), nil
+}
+
+// This tells whether the Go type of a schema, after following its reference,
+// has JSON methods of its own, which a type defined as it doesn't have. Types
+// from x-go-type are assumed to, since there's no telling.
+func (g *generator) marshalsJSON(sref *openapi3.SchemaRef) (bool, error) {
+	schema, err := g.probeSchema(sref)
+	if err != nil {
+		return false, err
+	}
+	return len(schema.UnionElements) != 0 || schema.HasAdditionalProperties ||
+		schema.NullableOf != nil || schema.DefineViaAlias, nil
+}
+
+// This generates the schema behind a reference, only to look at it, so the
+// Nullable wrappers which it would need aren't recorded.
+func (g *generator) probeSchema(sref *openapi3.SchemaRef) (Schema, error) {
+	if sref.Ref != "" {
+		sref = &openapi3.SchemaRef{Value: sref.Value}
+	}
+	probe := *g
+	probe.nullableTypes = make(map[string]string)
+	return probe.generateGoSchema(sref, nil)
+}
+
+// This turns a content type into part of a Go identifier, such as TextPlain
+// for text/plain. Wildcards, which have no letters, become Any.
+func mediaTypeToCamelCase(mediaType string) string {
+	tag := ToCamelCase(strings.Replace(mediaType, "/", "-", -1))
+	if tag == "" {
+		return "Any"
+	}
+	return tag
+}
+
+// Returns whether bodies of the content type are JSON, which is the case for
+// media types with a +json structured syntax suffix, too.
+func isJSONMediaType(contentType string) bool {
+	return StringInArray(contentType, contentTypesJSON) || strings.HasSuffix(contentType, "+json")
+}
+
 // This describes a request body
 type RequestBodyDefinition struct {
 	// Is this body required, or optional?
@@ -330,6 +622,25 @@ type RequestBodyDefinition struct {
 	// Whether this is the default body type. For an operation named OpFoo, we
 	// will not add suffixes like OpFooJSONBody for this one.
 	Default bool
+
+	// The encodings of the properties of form bodies, from the spec
+	Encodings []FormEncodingDefinition
+
+	// Methods can't be declared on the body's type when the type it's
+	// defined as is an interface or a pointer.
+	methodless bool
+
+	// Whether the type the body's type is defined as has JSON methods
+	marshalsJSON bool
+}
+
+// This describes how a property of a form body is encoded, which becomes a
+// runtime.FormEncoding in the generated code.
+type FormEncodingDefinition struct {
+	PropertyName string
+	ContentType  string
+	Style        string
+	Explode      bool
 }
 
 // Returns the Go type definition for a request body
@@ -344,6 +655,27 @@ func (r RequestBodyDefinition) CustomType() bool {
 	return r.Schema.RefType == ""
 }
 
+// Returns whether the body's type passes the JSON methods of the type it's
+// defined as on, by methods of its own. A defined type doesn't have the
+// methods of its underlying type, so without these, a body would lose the
+// JSON handling of a union, for instance.
+func (r RequestBodyDefinition) DelegatesJSON() bool {
+	return r.Schema.RefType != "" && !r.methodless && r.marshalsJSON && r.NameTag == "JSON"
+}
+
+// Returns whether the body's type passes the Validate method of the type it's
+// defined as on, for the same reason.
+func (r RequestBodyDefinition) DelegatesValidate() bool {
+	return r.Schema.RefType != "" && !r.methodless
+}
+
+// Returns whether the body is read as a stream, an io.Reader, rather than
+// decoded into a value. Streams aren't referred to by pointers, since they
+// can be nil themselves.
+func (r RequestBodyDefinition) IsStream() bool {
+	return r.NameTag == "OctetStream"
+}
+
 // When we're generating multiple functions which relate to request bodies,
 // this generates the suffix. Such as Operation DoFoo would be suffixed with
 // DoFooWithXMLBody.
@@ -369,13 +701,17 @@ func FilterParameterDefinitionByType(params []ParameterDefinition, in string) []
 
 // OperationDefinitions returns all operations for a swagger definition.
 func OperationDefinitions(swagger *openapi3.Swagger) ([]OperationDefinition, error) {
+	return defaultGenerator().operationDefinitions(swagger)
+}
+
+func (g *generator) operationDefinitions(swagger *openapi3.Swagger) ([]OperationDefinition, error) {
 	var operations []OperationDefinition
 
 	for _, requestPath := range SortedPathsKeys(swagger.Paths) {
 		pathItem := swagger.Paths[requestPath]
 		// These are parameters defined for all methods on a given path. They
 		// are shared by all methods.
-		globalParams, err := DescribeParameters(pathItem.Parameters, nil)
+		globalParams, err := g.describeParameters(pathItem.Parameters, nil)
 		if err != nil {
 			return nil, fmt.Errorf("error describing global parameters for %s: %s",
 				requestPath, err)
@@ -392,14 +728,13 @@ func OperationDefinitions(swagger *openapi3.Swagger) ([]OperationDefinition, err
 					return nil, fmt.Errorf("error generating default OperationID for %s/%s: %s",
 						opName, requestPath, err)
 				}
-				op.OperationID = op.OperationID
 			} else {
 				op.OperationID = ToCamelCase(op.OperationID)
 			}
 
 			// These are parameters defined for the specific path method that
 			// we're iterating over.
-			localParams, err := DescribeParameters(op.Parameters, []string{op.OperationID + "Params"})
+			localParams, err := g.describeParameters(op.Parameters, []string{op.OperationID + "Params"})
 			if err != nil {
 				return nil, fmt.Errorf("error describing global parameters for %s/%s: %s",
 					opName, requestPath, err)
@@ -417,7 +752,7 @@ func OperationDefinitions(swagger *openapi3.Swagger) ([]OperationDefinition, err
 				return nil, err
 			}
 
-			bodyDefinitions, typeDefinitions, err := GenerateBodyDefinitions(op.OperationID, op.RequestBody)
+			bodyDefinitions, typeDefinitions, err := g.generateBodyDefinitions(op.OperationID, op.RequestBody)
 			if err != nil {
 				return nil, errors.Wrap(err, "error generating body definitions")
 			}
@@ -435,6 +770,7 @@ func OperationDefinitions(swagger *openapi3.Swagger) ([]OperationDefinition, err
 				Spec:            op,
 				Bodies:          bodyDefinitions,
 				TypeDefinitions: typeDefinitions,
+				gen:             g,
 			}
 
 			// check for overrides of SecurityDefinitions.
@@ -458,6 +794,15 @@ func OperationDefinitions(swagger *openapi3.Swagger) ([]OperationDefinition, err
 			// Generate all the type definitions needed for this operation
 			opDef.TypeDefinitions = append(opDef.TypeDefinitions, GenerateTypeDefsForOperation(opDef)...)
 
+			// Responses may need auxiliary types too, such as for unions.
+			responseDefinitions, err := opDef.GetResponseTypeDefinitions()
+			if err != nil {
+				return nil, errors.Wrap(err, "error generating response definitions")
+			}
+			for _, rd := range responseDefinitions {
+				opDef.TypeDefinitions = append(opDef.TypeDefinitions, rd.Schema.GetAdditionalTypeDefs()...)
+			}
+
 			operations = append(operations, opDef)
 		}
 	}
@@ -487,6 +832,10 @@ func generateDefaultOperationID(opName string, requestPath string) (string, erro
 // This function turns the Swagger body definitions into a list of our body
 // definitions which will be used for code generation.
 func GenerateBodyDefinitions(operationID string, bodyOrRef *openapi3.RequestBodyRef) ([]RequestBodyDefinition, []TypeDefinition, error) {
+	return defaultGenerator().generateBodyDefinitions(operationID, bodyOrRef)
+}
+
+func (g *generator) generateBodyDefinitions(operationID string, bodyOrRef *openapi3.RequestBodyRef) ([]RequestBodyDefinition, []TypeDefinition, error) {
 	if bodyOrRef == nil {
 		return nil, nil, nil
 	}
@@ -495,7 +844,8 @@ func GenerateBodyDefinitions(operationID string, bodyOrRef *openapi3.RequestBody
 	var bodyDefinitions []RequestBodyDefinition
 	var typeDefinitions []TypeDefinition
 
-	for contentType, content := range body.Content {
+	for _, contentType := range SortedContentKeys(body.Content) {
+		content := body.Content[contentType]
 		var tag string
 		var defaultBody bool
 
@@ -503,12 +853,36 @@ func GenerateBodyDefinitions(operationID string, bodyOrRef *openapi3.RequestBody
 		case "application/json":
 			tag = "JSON"
 			defaultBody = true
+		case "application/x-www-form-urlencoded":
+			tag = "Formdata"
+		case "multipart/form-data":
+			tag = "Multipart"
+		case "text/plain":
+			tag = "Text"
+		case "application/octet-stream":
+			tag = "OctetStream"
 		default:
 			continue
 		}
 
+		// Text and binary bodies aren't decoded according to their schema,
+		// they're a string, and a stream of bytes, whatever it says.
+		if tag == "Text" || tag == "OctetStream" {
+			goType := "string"
+			if tag == "OctetStream" {
+				goType = "io.Reader"
+			}
+			bodyDefinitions = append(bodyDefinitions, RequestBodyDefinition{
+				Required:    body.Required,
+				Schema:      Schema{GoType: goType},
+				NameTag:     tag,
+				ContentType: contentType,
+			})
+			continue
+		}
+
 		bodyTypeName := operationID + tag + "Body"
-		bodySchema, err := GenerateGoSchema(content.Schema, []string{bodyTypeName})
+		bodySchema, err := g.withVariant(requestVariant).generateGoSchema(content.Schema, []string{bodyTypeName})
 		if err != nil {
 			return nil, nil, errors.Wrap(err, "error generating request body definition")
 		}
@@ -516,7 +890,7 @@ func GenerateBodyDefinitions(operationID string, bodyOrRef *openapi3.RequestBody
 		// If the body is a pre-defined type
 		if bodyOrRef.Ref != "" {
 			// Convert the reference path to Go type
-			refType, err := RefPathToGoType(bodyOrRef.Ref)
+			refType, err := g.refPathToGoType(bodyOrRef.Ref)
 			if err != nil {
 				return nil, nil, errors.Wrap(err, fmt.Sprintf("error turning reference (%s) into a Go type", bodyOrRef.Ref))
 			}
@@ -533,21 +907,73 @@ func GenerateBodyDefinitions(operationID string, bodyOrRef *openapi3.RequestBody
 			}
 			typeDefinitions = append(typeDefinitions, td)
 			// The body schema now is a reference to a type
-			bodySchema.RefType = bodyTypeName
+			bodySchema.RefType = g.qualifiedTypeName(bodyTypeName)
+		}
+
+		methodless, err := g.isMethodless(content.Schema)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error checking the type of the request body")
+		}
+		marshalsJSON, err := g.marshalsJSON(content.Schema)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error checking the type of the request body")
 		}
 
 		bd := RequestBodyDefinition{
-			Required:    body.Required,
-			Schema:      bodySchema,
-			NameTag:     tag,
-			ContentType: contentType,
-			Default:     defaultBody,
+			Required:     body.Required,
+			Schema:       bodySchema,
+			NameTag:      tag,
+			ContentType:  contentType,
+			Default:      defaultBody,
+			Encodings:    GenerateFormEncodings(tag, content.Encoding),
+			methodless:   methodless,
+			marshalsJSON: marshalsJSON,
 		}
 		bodyDefinitions = append(bodyDefinitions, bd)
 	}
 	return bodyDefinitions, typeDefinitions, nil
 }
 
+// This turns the encoding object of a form body into the encodings of its
+// properties. Styles only apply to urlencoded forms, and content types to
+// multipart ones, so the encodings which say nothing about the body are left
+// out.
+func GenerateFormEncodings(tag string, encodings map[string]*openapi3.Encoding) []FormEncodingDefinition {
+	var definitions []FormEncodingDefinition
+	for _, name := range SortedEncodingKeys(encodings) {
+		encoding := encodings[name]
+		if encoding == nil {
+			continue
+		}
+		definition := FormEncodingDefinition{PropertyName: name}
+		switch tag {
+		case "Formdata":
+			if encoding.Style == "" && encoding.Explode == nil {
+				continue
+			}
+			// The style defaults to form, which is the only one exploded
+			// by default.
+			definition.Style = encoding.Style
+			if definition.Style == "" {
+				definition.Style = "form"
+			}
+			definition.Explode = definition.Style == "form"
+			if encoding.Explode != nil {
+				definition.Explode = *encoding.Explode
+			}
+		case "Multipart":
+			if encoding.ContentType == "" {
+				continue
+			}
+			definition.ContentType = encoding.ContentType
+		default:
+			continue
+		}
+		definitions = append(definitions, definition)
+	}
+	return definitions
+}
+
 func GenerateTypeDefsForOperation(op OperationDefinition) []TypeDefinition {
 	var typeDefs []TypeDefinition
 	// Start with the params object itself
@@ -582,7 +1008,7 @@ func GenerateParamsTypes(op OperationDefinition) []TypeDefinition {
 		pSchema := param.Schema
 		if pSchema.HasAdditionalProperties {
 			propRefName := strings.Join([]string{typeName, param.GoName()}, "_")
-			pSchema.RefType = propRefName
+			pSchema.RefType = op.generator().qualifiedTypeName(propRefName)
 			typeDefs = append(typeDefs, TypeDefinition{
 				TypeName: propRefName,
 				Schema:   param.Schema,
@@ -632,6 +1058,21 @@ func GenerateTypesForOperations(t *template.Template, ops []OperationDefinition)
 		return "", errors.Wrap(err, "error generating additional properties boilerplate for operations")
 	}
 
+	unions, err := GenerateUnionBoilerplate(t, td)
+	if err != nil {
+		return "", errors.Wrap(err, "error generating union boilerplate for operations")
+	}
+
+	enums, err := GenerateEnumBoilerplate(t, td)
+	if err != nil {
+		return "", errors.Wrap(err, "error generating enum boilerplate for operations")
+	}
+
+	validation, err := GenerateValidationBoilerplate(t, td)
+	if err != nil {
+		return "", errors.Wrap(err, "error generating validation boilerplate for operations")
+	}
+
 	_, err = w.WriteString("\n")
 	if err != nil {
 		return "", errors.Wrap(err, "error generating additional properties boilerplate for operations")
@@ -642,6 +1083,21 @@ func GenerateTypesForOperations(t *template.Template, ops []OperationDefinition)
 		return "", errors.Wrap(err, "error generating additional properties boilerplate for operations")
 	}
 
+	_, err = w.WriteString(unions)
+	if err != nil {
+		return "", errors.Wrap(err, "error generating union boilerplate for operations")
+	}
+
+	_, err = w.WriteString(enums)
+	if err != nil {
+		return "", errors.Wrap(err, "error generating enum boilerplate for operations")
+	}
+
+	_, err = w.WriteString(validation)
+	if err != nil {
+		return "", errors.Wrap(err, "error generating validation boilerplate for operations")
+	}
+
 	err = w.Flush()
 	if err != nil {
 		return "", errors.Wrap(err, "error flushing output buffer for server interface")
@@ -679,6 +1135,156 @@ func GenerateChiServer(t *template.Template, operations []OperationDefinition) (
 	return buf.String(), nil
 }
 
+// GenerateStdHTTPServer generates the ServerInterface, the wrappers which bind
+// the parameters of requests for it, and the handler which routes requests to
+// them, using nothing but net/http and our runtime.
+func GenerateStdHTTPServer(t *template.Template, operations []OperationDefinition) (string, error) {
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+
+	err := t.ExecuteTemplate(w, "stdhttp-interface.tmpl", operations)
+	if err != nil {
+		return "", errors.Wrap(err, "error generating server interface")
+	}
+
+	err = t.ExecuteTemplate(w, "stdhttp-wrappers.tmpl", operations)
+	if err != nil {
+		return "", errors.Wrap(err, "error generating server wrappers")
+	}
+
+	err = t.ExecuteTemplate(w, "stdhttp-handler.tmpl", operations)
+	if err != nil {
+		return "", errors.Wrap(err, "error generating server http handler")
+	}
+
+	err = w.Flush()
+	if err != nil {
+		return "", errors.Wrap(err, "error flushing output buffer for server")
+	}
+
+	return buf.String(), nil
+}
+
+// GenerateGinServer generates the ServerInterface, the wrappers which bind the
+// parameters of gin contexts for it, and the function which registers them
+// with a gin router.
+func GenerateGinServer(t *template.Template, operations []OperationDefinition) (string, error) {
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+
+	err := t.ExecuteTemplate(w, "gin-interface.tmpl", operations)
+	if err != nil {
+		return "", errors.Wrap(err, "error generating server interface")
+	}
+
+	err = t.ExecuteTemplate(w, "gin-wrappers.tmpl", operations)
+	if err != nil {
+		return "", errors.Wrap(err, "error generating server wrappers")
+	}
+
+	err = t.ExecuteTemplate(w, "gin-register.tmpl", operations)
+	if err != nil {
+		return "", errors.Wrap(err, "error generating route registration")
+	}
+
+	err = w.Flush()
+	if err != nil {
+		return "", errors.Wrap(err, "error flushing output buffer for server")
+	}
+
+	return buf.String(), nil
+}
+
+// GenerateGorillaServer generates the ServerInterface, the wrappers which bind
+// the parameters of requests for it, and the handler which registers them with
+// a gorilla/mux router.
+func GenerateGorillaServer(t *template.Template, operations []OperationDefinition) (string, error) {
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+
+	// The interface and the binding of parameters are those of the std-http
+	// server, other than how the wrappers get their path parameters.
+	err := t.ExecuteTemplate(w, "stdhttp-interface.tmpl", operations)
+	if err != nil {
+		return "", errors.Wrap(err, "error generating server interface")
+	}
+
+	err = t.ExecuteTemplate(w, "gorilla-wrappers.tmpl", operations)
+	if err != nil {
+		return "", errors.Wrap(err, "error generating server wrappers")
+	}
+
+	err = t.ExecuteTemplate(w, "gorilla-handler.tmpl", operations)
+	if err != nil {
+		return "", errors.Wrap(err, "error generating server http handler")
+	}
+
+	err = w.Flush()
+	if err != nil {
+		return "", errors.Wrap(err, "error flushing output buffer for server")
+	}
+
+	return buf.String(), nil
+}
+
+// GenerateStrictServer generates the request and response objects of the
+// operations, the StrictServerInterface which takes and returns them, and the
+// strict handler, which adapts it to the ServerInterface of the server which
+// the options generate.
+func GenerateStrictServer(t *template.Template, operations []OperationDefinition, opts Options) (string, error) {
+	var templates []string
+	switch {
+	case opts.GenerateEchoServer:
+		templates = []string{"strict-echo.tmpl"}
+	case opts.GenerateChiServer:
+		templates = []string{"strict-http.tmpl", "strict-chi.tmpl"}
+	case opts.GenerateStdHTTPServer, opts.GenerateGorillaServer:
+		templates = []string{"strict-http.tmpl", "strict-stdhttp.tmpl"}
+	default:
+		return "", errors.New("the strict server needs one of the server, chi-server, gorilla-server or std-http targets")
+	}
+
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+
+	err := t.ExecuteTemplate(w, "strict-interface.tmpl", operations)
+	if err != nil {
+		return "", errors.Wrap(err, "error generating strict server interface")
+	}
+
+	for _, name := range templates {
+		err = t.ExecuteTemplate(w, name, operations)
+		if err != nil {
+			return "", errors.Wrap(err, "error generating strict server handler")
+		}
+	}
+
+	err = w.Flush()
+	if err != nil {
+		return "", errors.Wrap(err, "error flushing output buffer for strict server")
+	}
+
+	return buf.String(), nil
+}
+
+// GenerateRequestBodyDecoders generates the functions which decode the request
+// bodies of the operations, according to their content types, which the
+// strict server uses, and which the handlers of the other servers can call.
+func GenerateRequestBodyDecoders(t *template.Template, operations []OperationDefinition) (string, error) {
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+
+	err := t.ExecuteTemplate(w, "request-body-decoders.tmpl", operations)
+	if err != nil {
+		return "", errors.Wrap(err, "error generating request body decoders")
+	}
+	err = w.Flush()
+	if err != nil {
+		return "", errors.Wrap(err, "error flushing output buffer for request body decoders")
+	}
+	return buf.String(), nil
+}
+
 // GenerateEchoServer This function generates all the go code for the ServerInterface as well as
 // all the wrapper functions around our handlers.
 func GenerateEchoServer(t *template.Template, operations []OperationDefinition) (string, error) {
@@ -27,6 +27,9 @@ func walkSwagger(swagger *openapi3.Swagger, doFn func(RefWrapper) (bool, error))
 	}
 
 	for _, p := range swagger.Paths {
+		for _, param := range p.Parameters {
+			_ = walkParameterRef(param, doFn)
+		}
 		for _, op := range p.Operations() {
 			walkOperation(op, doFn)
 		}
@@ -307,6 +310,13 @@ func walkHeaderRef(ref *openapi3.HeaderRef, doFn func(RefWrapper) (bool, error))
 
 	walkSchemaRef(ref.Value.Schema, doFn)
 
+	for _, mediaType := range ref.Value.Content {
+		if mediaType == nil {
+			continue
+		}
+		walkSchemaRef(mediaType.Schema, doFn)
+	}
+
 	return nil
 }
 
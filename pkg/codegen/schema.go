@@ -2,6 +2,9 @@ package codegen
 
 import (
 	"fmt"
+	"go/token"
+	"math"
+	"strconv"
 	"strings"
 
 	"github.com/getkin/kin-openapi/openapi3"
@@ -13,14 +16,22 @@ type Schema struct {
 	GoType  string // The Go type needed to represent the schema
 	RefType string // If the type has a type name, this is set
 
-	EnumValues []string // Enum values
+	EnumValues []EnumValue // Enum values
 
 	Properties               []Property       // For an object, the fields with names
 	HasAdditionalProperties  bool             // Whether we support additional properties
 	AdditionalPropertiesType *Schema          // And if we do, their type
 	AdditionalTypes          []TypeDefinition // We may need to generate auxiliary helper types, stored here
 
+	UnionElements []UnionElement // For oneOf/anyOf, the possible types of the union
+	Discriminator *Discriminator // For oneOf/anyOf, how to tell the union elements apart
+
+	Constraints Constraints // Validation keywords, when generating Validate methods
+	ArrayItems  *Schema     // For arrays, the schema of the items
+	NullableOf  *Schema     // For Nullable wrappers, the schema of the wrapped value
+
 	SkipOptionalPointer bool // Some types don't need a * in front when they're optional
+	DefineViaAlias      bool // The type is defined as an alias, eg, for x-go-type overrides
 }
 
 func (s Schema) IsRef() bool {
@@ -54,14 +65,60 @@ func (s Schema) GetAdditionalTypeDefs() []TypeDefinition {
 	return result
 }
 
+// EnumValue is one of the values of an enum, along with the name of the Go
+// constant which is generated for it.
+type EnumValue struct {
+	Name  string // Identifier for the constant, which is appended to the type name
+	Value string // The value, as a Go literal
+
+	// The name which the constant of a string value had before they were
+	// named in CamelCase, which is appended to the type name and an
+	// underscore, eg, Type_value. It's empty when that wasn't an identifier.
+	LegacyName string
+}
+
+// Returns the values of an enum which have a legacy name, whose constants are
+// still generated as deprecated aliases, so that code which uses them keeps
+// compiling.
+func (s Schema) LegacyEnumValues() []EnumValue {
+	var result []EnumValue
+	for _, value := range s.EnumValues {
+		if value.LegacyName != "" {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+// UnionElement is the Go type of one of the possible values of a oneOf or
+// anyOf union.
+type UnionElement string
+
+// Method returns the name of the union element in the form used by the
+// generated As/From/Merge accessors, eg, "Cat" for AsCat.
+func (u UnionElement) Method() string {
+	return SchemaNameToTypeName(string(u))
+}
+
+// Discriminator describes the property which selects the concrete type of a
+// union, and the Go type for each of its values.
+type Discriminator struct {
+	Property string            // The JSON name of the discriminator property
+	Mapping  map[string]string // Maps discriminator values to Go types
+}
+
 type Property struct {
 	Description   string
 	JsonFieldName string
+	GoName        string // Overrides the Go field name, from x-go-name
 	Schema        Schema
 	Required      bool
 }
 
 func (p Property) GoFieldName() string {
+	if p.GoName != "" {
+		return p.GoName
+	}
 	return SchemaNameToTypeName(p.JsonFieldName)
 }
 
@@ -77,6 +134,7 @@ type TypeDefinition struct {
 	TypeName     string
 	JsonName     string
 	ResponseName string
+	ContentType  string // The content type of a response, for response types
 	Schema       Schema
 }
 
@@ -84,7 +142,13 @@ func PropertiesEqual(a, b Property) bool {
 	return a.JsonFieldName == b.JsonFieldName && a.Schema.TypeDecl() == b.Schema.TypeDecl() && a.Required == b.Required
 }
 
+// Returns the Go schema of an OpenAPI schema, as it's generated with the
+// default options.
 func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
+	return defaultGenerator().generateGoSchema(sref, path)
+}
+
+func (g *generator) generateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 	// If Ref is set on the SchemaRef, it means that this type is actually a reference to
 	// another type. We're not de-referencing, so simply use the referenced type.
 	var refType string
@@ -101,7 +165,7 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 	if sref.Ref != "" {
 		var err error
 		// Convert the reference path to Go type
-		refType, err = RefPathToGoType(sref.Ref)
+		refType, err = g.refPathToGoType(sref.Ref)
 		if err != nil {
 			return Schema{}, fmt.Errorf("error turning reference (%s) into a Go type: %s",
 				sref.Ref, err)
@@ -111,13 +175,38 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 		}, nil
 	}
 
-	// We can't support this in any meaningful way
-	if schema.AnyOf != nil {
-		return Schema{GoType: "interface{}", RefType: refType}, nil
+	// The type may be overridden by the user, in which case we don't look any
+	// further into the schema.
+	override, err := g.goTypeOverride(schema)
+	if err != nil {
+		return Schema{}, errors.Wrap(err, "error reading Go type override")
 	}
-	// We can't support this in any meaningful way
-	if schema.OneOf != nil {
-		return Schema{GoType: "interface{}", RefType: refType}, nil
+	if override != nil {
+		return *override, nil
+	}
+
+	// oneOf and anyOf are represented by a union type, which holds on to the
+	// raw JSON, and provides accessors to each of the possible types.
+	if schema.OneOf != nil || schema.AnyOf != nil {
+		elements := schema.OneOf
+		if elements == nil {
+			elements = schema.AnyOf
+		}
+		outSchema := Schema{
+			RefType: refType,
+		}
+		err := g.generateUnion(&outSchema, elements, path)
+		if err != nil {
+			return Schema{}, errors.Wrap(err, "error generating union")
+		}
+		if schema.Discriminator != nil {
+			outSchema.Discriminator, err = g.generateDiscriminator(schema.Discriminator, elements)
+			if err != nil {
+				return Schema{}, errors.Wrap(err, "error generating discriminator")
+			}
+		}
+		outSchema.GoType = GenStructFromSchema(outSchema)
+		return outSchema, nil
 	}
 
 	// AllOf is interesting, and useful. It's the union of a number of other
@@ -125,7 +214,7 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 	// so that in a RESTful paradigm, the Create operation can return
 	// (object, id), so that other operations can refer to (id)
 	if schema.AllOf != nil {
-		mergedSchema, err := MergeSchemas(schema.AllOf, path)
+		mergedSchema, err := g.mergeSchemas(schema.AllOf, path)
 		if err != nil {
 			return Schema{}, errors.Wrap(err, "error merging schemas")
 		}
@@ -160,8 +249,11 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 			// We've got an object with some properties.
 			for _, pName := range SortedSchemaKeys(schema.Properties) {
 				p := schema.Properties[pName]
+				if p.Value != nil && g.skipReadWriteProperty(p.Value) {
+					continue
+				}
 				propertyPath := append(path, pName)
-				pSchema, err := GenerateGoSchema(p, propertyPath)
+				pSchema, err := g.generateGoSchema(p, propertyPath)
 				if err != nil {
 					return Schema{}, errors.Wrap(err, fmt.Sprintf("error generating Go schema for property '%s'", pName))
 				}
@@ -182,14 +274,40 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 					}
 					pSchema.AdditionalTypes = append(pSchema.AdditionalTypes, typeDef)
 
-					pSchema.RefType = typeName
+					pSchema.RefType = g.qualifiedTypeName(typeName)
+				} else if (len(pSchema.UnionElements) != 0 || len(pSchema.EnumValues) != 0) && pSchema.RefType == "" {
+					// Unions and enums need their own type for their methods
+					// and constants.
+					pSchema = g.defineNamedType(pSchema, propertyPath)
+				}
+				if g.options.NullableTypes && p.Value != nil && p.Value.Nullable {
+					// A $ref can't have siblings in OpenAPI 3.0, so a nullable
+					// reference is written as an allOf of just the reference,
+					// in which case we use the referenced type directly.
+					if len(p.Value.AllOf) == 1 && p.Value.AllOf[0].Ref != "" && len(p.Value.Properties) == 0 {
+						pSchema, err = g.generateGoSchema(p.Value.AllOf[0], propertyPath)
+						if err != nil {
+							return Schema{}, errors.Wrap(err, fmt.Sprintf("error generating Go schema for property '%s'", pName))
+						}
+					}
+					pSchema = g.nullableSchema(pSchema, propertyPath)
 				}
 				description := ""
+				goName := ""
 				if p.Value != nil {
 					description = p.Value.Description
+					// For references, the extensions belong to the referenced
+					// schema rather than the property.
+					if p.Ref == "" {
+						goName, err = extensionString(p.Value.Extensions, extGoName)
+						if err != nil {
+							return Schema{}, errors.Wrap(err, fmt.Sprintf("error reading Go name of property '%s'", pName))
+						}
+					}
 				}
 				prop := Property{
 					JsonFieldName: pName,
+					GoName:        goName,
 					Schema:        pSchema,
 					Required:      required,
 					Description:   description,
@@ -202,7 +320,7 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 				GoType: "interface{}",
 			}
 			if schema.AdditionalProperties != nil {
-				additionalSchema, err := GenerateGoSchema(schema.AdditionalProperties, path)
+				additionalSchema, err := g.generateGoSchema(schema.AdditionalProperties, path)
 				if err != nil {
 					return Schema{}, errors.Wrap(err, "error generating type for additional properties")
 				}
@@ -215,16 +333,26 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 	} else {
 		f := schema.Format
 
-		switch t {
-		case "array":
+		// The user's type mapping takes precedence over our own choices.
+		mappedType, mapped := g.typeMapping[typeMappingKey(t, f)]
+
+		switch {
+		case mapped:
+			outSchema.GoType = mappedType
+		case t == "array":
 			// For arrays, we'll get the type of the Items and throw a
 			// [] in front of it.
-			arrayType, err := GenerateGoSchema(schema.Items, path)
+			arrayType, err := g.generateGoSchema(schema.Items, path)
 			if err != nil {
 				return Schema{}, errors.Wrap(err, "error generating type for array")
 			}
+			if (len(arrayType.UnionElements) != 0 || len(arrayType.EnumValues) != 0) && arrayType.RefType == "" {
+				arrayType = g.defineNamedType(arrayType, append(path, "Item"))
+			}
 			outSchema.GoType = "[]" + arrayType.TypeDecl()
-		case "integer":
+			outSchema.AdditionalTypes = arrayType.GetAdditionalTypeDefs()
+			outSchema.ArrayItems = &arrayType
+		case t == "integer":
 			// We default to int if format doesn't ask for something else.
 			if f == "int64" {
 				outSchema.GoType = "int64"
@@ -235,7 +363,7 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 			} else {
 				return Schema{}, fmt.Errorf("invalid integer format: %s", f)
 			}
-		case "number":
+		case t == "number":
 			// We default to float for "number"
 			if f == "double" {
 				outSchema.GoType = "float64"
@@ -244,15 +372,12 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 			} else {
 				return Schema{}, fmt.Errorf("invalid number format: %s", f)
 			}
-		case "boolean":
+		case t == "boolean":
 			if f != "" {
 				return Schema{}, fmt.Errorf("invalid format (%s) for boolean", f)
 			}
 			outSchema.GoType = "bool"
-		case "string":
-			for _, enumValue := range schema.Enum {
-				outSchema.EnumValues = append(outSchema.EnumValues, enumValue.(string))
-			}
+		case t == "string":
 			// Special case string formats here.
 			switch f {
 			case "byte":
@@ -264,6 +389,12 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 			case "json":
 				outSchema.GoType = "json.RawMessage"
 				outSchema.SkipOptionalPointer = true
+			case "uuid", "email", "uri", "ipv4", "ipv6", "binary":
+				if g.options.PlainStringFormats {
+					outSchema.GoType = "string"
+				} else {
+					outSchema.GoType = stringFormatTypes[f]
+				}
 			default:
 				// All unrecognized formats are simply a regular string.
 				outSchema.GoType = "string"
@@ -271,10 +402,102 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 		default:
 			return Schema{}, fmt.Errorf("unhandled Schema type: %s", t)
 		}
+
+		if g.options.GenerateValidation {
+			outSchema.Constraints, err = schemaConstraints(schema)
+			if err != nil {
+				return Schema{}, errors.Wrap(err, "error reading validation constraints")
+			}
+		}
+
+		// Enums are only supported on the basic Go types, since we need to
+		// declare constants of them.
+		if len(schema.Enum) != 0 && isBasicGoType(outSchema.GoType) {
+			enumValues, err := generateEnumValues(schema.Enum, t)
+			if err != nil {
+				return Schema{}, errors.Wrap(err, "error generating enum values")
+			}
+			outSchema.EnumValues = enumValues
+		}
 	}
 	return outSchema, nil
 }
 
+// This converts the values of an enum into Go literals of the given OpenAPI
+// type, and picks a unique constant name for each of them.
+func generateEnumValues(values []interface{}, t string) ([]EnumValue, error) {
+	var result []EnumValue
+	names := make(map[string]bool)
+	literals := make(map[string]bool)
+	for _, value := range values {
+		// A null enum value only makes sense for nullable types, and isn't
+		// representable as a constant.
+		if value == nil {
+			continue
+		}
+
+		var literal, name, legacyName string
+		switch t {
+		case "string":
+			str, ok := value.(string)
+			if !ok {
+				return nil, fmt.Errorf("enum value %v is not a string", value)
+			}
+			literal = strconv.Quote(str)
+			name = ToCamelCase(str)
+			if str == "" {
+				name = "Empty"
+			}
+			if isIdentifierSuffix(str) {
+				legacyName = str
+			}
+		case "integer":
+			num, ok := value.(float64)
+			if !ok || num != math.Trunc(num) {
+				return nil, fmt.Errorf("enum value %v is not an integer", value)
+			}
+			literal = strconv.FormatInt(int64(num), 10)
+			name = numberToEnumName(literal)
+		case "number":
+			num, ok := value.(float64)
+			if !ok {
+				return nil, fmt.Errorf("enum value %v is not a number", value)
+			}
+			literal = strconv.FormatFloat(num, 'g', -1, 64)
+			name = numberToEnumName(literal)
+		case "boolean":
+			b, ok := value.(bool)
+			if !ok {
+				return nil, fmt.Errorf("enum value %v is not a boolean", value)
+			}
+			literal = strconv.FormatBool(b)
+			name = ToCamelCase(literal)
+		default:
+			return nil, fmt.Errorf("enums of type %s are not supported", t)
+		}
+
+		// Repeated values would produce duplicate cases in Valid()
+		if literals[literal] {
+			continue
+		}
+		literals[literal] = true
+
+		// Values which differ only in punctuation, or which are made up
+		// entirely of it, need a suffix to keep the constants distinct.
+		if name == "" {
+			name = "Value"
+		}
+		unique := name
+		for i := 2; names[unique]; i++ {
+			unique = fmt.Sprintf("%s%d", name, i)
+		}
+		names[unique] = true
+
+		result = append(result, EnumValue{Name: unique, Value: literal, LegacyName: legacyName})
+	}
+	return result, nil
+}
+
 // This describes a Schema, a type definition.
 type SchemaDescriptor struct {
 	Fields                   []FieldDescriptor
@@ -328,12 +551,19 @@ func GenStructFromSchema(schema Schema) string {
 		objectParts = append(objectParts,
 			fmt.Sprintf("AdditionalProperties map[string]%s `json:\"-\"`", addPropsType))
 	}
+	if len(schema.UnionElements) != 0 {
+		objectParts = append(objectParts, "union json.RawMessage")
+	}
 	objectParts = append(objectParts, "}")
 	return strings.Join(objectParts, "\n")
 }
 
 // Merge all the fields in the schemas supplied into one giant schema.
 func MergeSchemas(allOf []*openapi3.SchemaRef, path []string) (Schema, error) {
+	return defaultGenerator().mergeSchemas(allOf, path)
+}
+
+func (g *generator) mergeSchemas(allOf []*openapi3.SchemaRef, path []string) (Schema, error) {
 	var outSchema Schema
 	for _, schemaOrRef := range allOf {
 		ref := schemaOrRef.Ref
@@ -341,13 +571,13 @@ func MergeSchemas(allOf []*openapi3.SchemaRef, path []string) (Schema, error) {
 		var refType string
 		var err error
 		if ref != "" {
-			refType, err = RefPathToGoType(ref)
+			refType, err = g.refPathToGoType(ref)
 			if err != nil {
 				return Schema{}, errors.Wrap(err, "error converting reference path to a go type")
 			}
 		}
 
-		schema, err := GenerateGoSchema(schemaOrRef, path)
+		schema, err := g.generateGoSchema(schemaOrRef, path)
 		if err != nil {
 			return Schema{}, errors.Wrap(err, "error generating Go schema in allOf")
 		}
@@ -378,7 +608,7 @@ func MergeSchemas(allOf []*openapi3.SchemaRef, path []string) (Schema, error) {
 
 	// Now, we generate the struct which merges together all the fields.
 	var err error
-	outSchema.GoType, err = GenStructFromAllOf(allOf, path)
+	outSchema.GoType, err = g.genStructFromAllOf(allOf, path)
 	if err != nil {
 		return Schema{}, errors.Wrap(err, "unable to generate aggregate type for AllOf")
 	}
@@ -389,6 +619,10 @@ func MergeSchemas(allOf []*openapi3.SchemaRef, path []string) (Schema, error) {
 // input array. In the case of Ref objects, we use an embedded struct, otherwise,
 // we inline the fields.
 func GenStructFromAllOf(allOf []*openapi3.SchemaRef, path []string) (string, error) {
+	return defaultGenerator().genStructFromAllOf(allOf, path)
+}
+
+func (g *generator) genStructFromAllOf(allOf []*openapi3.SchemaRef, path []string) (string, error) {
 	// Start out with struct {
 	objectParts := []string{"struct {"}
 	for _, schemaOrRef := range allOf {
@@ -400,7 +634,7 @@ func GenStructFromAllOf(allOf []*openapi3.SchemaRef, path []string) (string, err
 			//   InlinedMember
 			//   ...
 			// }
-			goType, err := RefPathToGoType(ref)
+			goType, err := g.refPathToGoType(ref)
 			if err != nil {
 				return "", err
 			}
@@ -411,7 +645,7 @@ func GenStructFromAllOf(allOf []*openapi3.SchemaRef, path []string) (string, err
 		} else {
 			// Inline all the fields from the schema into the output struct,
 			// just like in the simple case of generating an object.
-			goSchema, err := GenerateGoSchema(schemaOrRef, path)
+			goSchema, err := g.generateGoSchema(schemaOrRef, path)
 			if err != nil {
 				return "", err
 			}
@@ -424,16 +658,210 @@ func GenStructFromAllOf(allOf []*openapi3.SchemaRef, path []string) (string, err
 	return strings.Join(objectParts, "\n"), nil
 }
 
+// This populates the union elements of outSchema from the oneOf or anyOf
+// elements. Referenced schemas are used directly, while inline schemas are
+// given a type name derived from the path and their position in the union.
+func (g *generator) generateUnion(outSchema *Schema, elements []*openapi3.SchemaRef, path []string) error {
+	for i, element := range elements {
+		elementPath := append(append([]string{}, path...), fmt.Sprint(i))
+		elementSchema, err := g.generateGoSchema(element, elementPath)
+		if err != nil {
+			return err
+		}
+
+		if element.Ref == "" {
+			elementSchema = g.defineNamedType(elementSchema, elementPath)
+		}
+		outSchema.AdditionalTypes = append(outSchema.AdditionalTypes, elementSchema.GetAdditionalTypeDefs()...)
+		outSchema.UnionElements = append(outSchema.UnionElements, UnionElement(elementSchema.TypeDecl()))
+	}
+	return nil
+}
+
+// This maps each discriminator value to the Go type it selects. Explicit
+// mapping entries take precedence, and any referenced union elements which
+// aren't mapped explicitly are selected by their schema name, as described by
+// the OpenAPI specification. Inline elements can only be selected via mapping.
+func (g *generator) generateDiscriminator(d *openapi3.Discriminator, elements []*openapi3.SchemaRef) (*Discriminator, error) {
+	if d.PropertyName == "" {
+		return nil, errors.New("discriminator has no propertyName")
+	}
+	result := Discriminator{
+		Property: d.PropertyName,
+		Mapping:  make(map[string]string),
+	}
+
+	mapped := make(map[string]bool)
+	for value, ref := range d.Mapping {
+		// A mapping may be a schema name rather than a reference, which
+		// can be told apart by having neither a fragment nor a path.
+		if !strings.ContainsAny(ref, "#/") {
+			ref = "#/components/schemas/" + ref
+		}
+		goType, err := g.refPathToGoType(ref)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("error turning mapping for '%s' into a Go type", value))
+		}
+		result.Mapping[value] = goType
+		mapped[ref] = true
+	}
+
+	for _, element := range elements {
+		if element.Ref == "" || mapped[element.Ref] {
+			continue
+		}
+		goType, err := g.refPathToGoType(element.Ref)
+		if err != nil {
+			return nil, err
+		}
+		parts := strings.Split(element.Ref, "/")
+		result.Mapping[parts[len(parts)-1]] = goType
+	}
+	return &result, nil
+}
+
+// The suffixes of the variants of schemas with readOnly or writeOnly
+// properties, which are used in requests and responses respectively.
+const (
+	requestVariant  = "Request"
+	responseVariant = "Response"
+)
+
+// Returns whether a property is left out of the variant being generated;
+// readOnly properties aren't sent in requests, and writeOnly properties
+// aren't returned in responses.
+func (g *generator) skipReadWriteProperty(schema *openapi3.Schema) bool {
+	switch g.readWriteVariant {
+	case requestVariant:
+		return schema.ReadOnly
+	case responseVariant:
+		return schema.WriteOnly
+	}
+	return false
+}
+
+// This finds the component schemas which need Request and Response variants,
+// which are those with readOnly or writeOnly properties, either directly or
+// in any schema they contain or refer to. The result is keyed by Go type.
+func (g *generator) findReadWriteSchemas(schemas map[string]*openapi3.SchemaRef) map[string]bool {
+	result := make(map[string]bool)
+	for name, schemaRef := range schemas {
+		if hasReadWriteProperties(schemaRef, make(map[*openapi3.Schema]bool)) {
+			result[g.schemaTypeName(name)] = true
+		}
+	}
+	return result
+}
+
+func hasReadWriteProperties(sref *openapi3.SchemaRef, visited map[*openapi3.Schema]bool) bool {
+	if sref == nil || sref.Value == nil || visited[sref.Value] {
+		return false
+	}
+	schema := sref.Value
+	visited[schema] = true
+
+	for _, p := range schema.Properties {
+		if p.Value != nil && (p.Value.ReadOnly || p.Value.WriteOnly) {
+			return true
+		}
+		if hasReadWriteProperties(p, visited) {
+			return true
+		}
+	}
+	var children []*openapi3.SchemaRef
+	children = append(children, schema.AllOf...)
+	children = append(children, schema.OneOf...)
+	children = append(children, schema.AnyOf...)
+	children = append(children, schema.Items, schema.AdditionalProperties)
+	for _, child := range children {
+		if hasReadWriteProperties(child, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+// These are the types in pkg/types for well-known string formats, which are
+// validated as they're decoded.
+var stringFormatTypes = map[string]string{
+	"uuid":   "openapi_types.UUID",
+	"email":  "openapi_types.Email",
+	"uri":    "openapi_types.URI",
+	"ipv4":   "openapi_types.IP",
+	"ipv6":   "openapi_types.IP",
+	"binary": "openapi_types.File",
+}
+
+// These are the tri-state types we use for nullable properties of each of
+// the builtin types.
+var nullablePrimitives = map[string]string{
+	"string":             "openapi_types.NullableString",
+	"int":                "openapi_types.NullableInt",
+	"int32":              "openapi_types.NullableInt32",
+	"int64":              "openapi_types.NullableInt64",
+	"float32":            "openapi_types.NullableFloat32",
+	"float64":            "openapi_types.NullableFloat64",
+	"bool":               "openapi_types.NullableBool",
+	"time.Time":          "openapi_types.NullableTime",
+	"openapi_types.Date": "openapi_types.NullableDate",
+}
+
+// This wraps the schema of a nullable property in a tri-state Nullable type,
+// which distinguishes an explicit null from an absent value. Builtin types use
+// the ones in pkg/types, and named types get a generated wrapper. Anonymous
+// types, such as inline objects and arrays, are first given a type of their
+// own, named after the path we followed to get to them, so that they can be
+// wrapped too. Types of other packages can't be wrapped, so they remain
+// optional pointers.
+func (g *generator) nullableSchema(schema Schema, path []string) Schema {
+	goType, found := nullablePrimitives[schema.TypeDecl()]
+	if !found {
+		if strings.ContainsAny(schema.TypeDecl(), "[]{}") {
+			schema = g.defineNamedType(schema, path)
+		}
+		typeName := strings.TrimPrefix(schema.TypeDecl(), g.typesPrefix())
+		if !token.IsIdentifier(typeName) {
+			return schema
+		}
+		g.nullableTypes["Nullable"+typeName] = typeName
+		goType = g.qualifiedTypeName("Nullable" + typeName)
+	}
+	return Schema{
+		GoType:              goType,
+		AdditionalTypes:     schema.GetAdditionalTypeDefs(),
+		SkipOptionalPointer: true,
+		NullableOf:          &schema,
+	}
+}
+
+// Some inline schemas, such as unions, can't be expressed as an anonymous Go
+// type, since we need to hang methods off of them. This defines an auxiliary
+// type for the schema, named after the path we followed to get to it, and
+// returns a schema which refers to it.
+func (g *generator) defineNamedType(schema Schema, path []string) Schema {
+	typeName := SchemaNameToTypeName(PathToTypeName(path))
+	typeDef := TypeDefinition{
+		TypeName: typeName,
+		JsonName: strings.Join(path, "."),
+		Schema:   schema,
+	}
+	return Schema{
+		GoType:          schema.GoType,
+		RefType:         g.qualifiedTypeName(typeName),
+		AdditionalTypes: append(schema.GetAdditionalTypeDefs(), typeDef),
+	}
+}
+
 // This constructs a Go type for a parameter, looking at either the schema or
 // the content, whichever is available
-func paramToGoType(param *openapi3.Parameter, path []string) (Schema, error) {
+func (g *generator) paramToGoType(param *openapi3.Parameter, path []string) (Schema, error) {
 	if param.Content == nil && param.Schema == nil {
 		return Schema{}, fmt.Errorf("parameter '%s' has no schema or content", param.Name)
 	}
 
 	// We can process the schema through the generic schema processor
 	if param.Schema != nil {
-		return GenerateGoSchema(param.Schema, path)
+		return g.generateGoSchema(param.Schema, path)
 	}
 
 	// At this point, we have a content type. We know how to deal with
@@ -455,5 +883,5 @@ func paramToGoType(param *openapi3.Parameter, path []string) (Schema, error) {
 	}
 
 	// For json, we go through the standard schema mechanism
-	return GenerateGoSchema(mt.Schema, path)
+	return g.generateGoSchema(mt.Schema, path)
 }
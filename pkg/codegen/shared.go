@@ -0,0 +1,120 @@
+package codegen
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/pkg/errors"
+)
+
+const schemaRefPrefix = "#/components/schemas/"
+
+// FindSharedSchemas finds the component schemas which are identical in more
+// than one of the specs, so that they can be generated once, into a shared
+// package, rather than into the package of every spec. Schemas are identical
+// when they have the same name and the same structure, including that of all
+// of the schemas they refer to, so that the schemas they refer to are shared
+// too.
+//
+// It returns a spec holding just the shared schemas, which has no paths, so it
+// must be generated with SkipPrune, along with the names of the shared schemas
+// of each of the specs, for their Options.SharedSchemas.
+func FindSharedSchemas(swaggers []*openapi3.Swagger) (*openapi3.Swagger, [][]string, error) {
+	type schemaKey struct {
+		name string
+		hash string
+	}
+
+	hashes := make([]map[string]string, len(swaggers))
+	counts := make(map[schemaKey]int)
+	for i, swagger := range swaggers {
+		specHashes, err := schemaHashes(swagger.Components.Schemas)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, fmt.Sprintf("error comparing the schemas of spec %d", i+1))
+		}
+		hashes[i] = specHashes
+		for name, hash := range specHashes {
+			counts[schemaKey{name, hash}]++
+		}
+	}
+
+	shared := &openapi3.Swagger{
+		OpenAPI: "3.0.0",
+		Info: &openapi3.Info{
+			Title:   "Shared components",
+			Version: "1.0.0",
+		},
+		Paths: openapi3.Paths{},
+		Components: openapi3.Components{
+			Schemas: make(map[string]*openapi3.SchemaRef),
+		},
+	}
+	sharedNames := make([][]string, len(swaggers))
+	for i, swagger := range swaggers {
+		for _, name := range SortedStringKeys(hashes[i]) {
+			if counts[schemaKey{name, hashes[i][name]}] < 2 {
+				continue
+			}
+			sharedNames[i] = append(sharedNames[i], name)
+			if _, found := shared.Components.Schemas[name]; !found {
+				shared.Components.Schemas[name] = swagger.Components.Schemas[name]
+			}
+		}
+	}
+	return shared, sharedNames, nil
+}
+
+// This hashes each of the component schemas, along with all of the component
+// schemas which it refers to, directly or indirectly, so that two schemas have
+// the same hash only when they'd generate the same types.
+func schemaHashes(schemas map[string]*openapi3.SchemaRef) (map[string]string, error) {
+	encoded := make(map[string][]byte, len(schemas))
+	for name, schemaRef := range schemas {
+		data, err := json.Marshal(schemaRef)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("error encoding schema %s", name))
+		}
+		encoded[name] = data
+	}
+
+	hashes := make(map[string]string, len(schemas))
+	for name := range schemas {
+		reachable := make(map[string]bool)
+		findReachableSchemas(name, schemas, reachable)
+		names := make([]string, 0, len(reachable))
+		for reachableName := range reachable {
+			names = append(names, reachableName)
+		}
+		sort.Strings(names)
+
+		h := sha256.New()
+		for _, reachableName := range names {
+			fmt.Fprintf(h, "%s\n%s\n", reachableName, encoded[reachableName])
+		}
+		hashes[name] = hex.EncodeToString(h.Sum(nil))
+	}
+	return hashes, nil
+}
+
+// This adds the named component schema to reachable, along with every
+// component schema which it refers to.
+func findReachableSchemas(name string, schemas map[string]*openapi3.SchemaRef, reachable map[string]bool) {
+	if reachable[name] {
+		return
+	}
+	reachable[name] = true
+	_ = walkSchemaRef(schemas[name], func(ref RefWrapper) (bool, error) {
+		if ref.Ref == "" {
+			return true, nil
+		}
+		if strings.HasPrefix(ref.Ref, schemaRefPrefix) {
+			findReachableSchemas(strings.TrimPrefix(ref.Ref, schemaRefPrefix), schemas, reachable)
+		}
+		return false, nil
+	})
+}
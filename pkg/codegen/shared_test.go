@@ -0,0 +1,115 @@
+package codegen
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The service schemas are the same in both specs, apart from Pet, whose
+// properties differ.
+const sharedDefinition = `
+openapi: 3.0.1
+info:
+  title: %s
+  version: 1.0.0
+paths:
+  /items:
+    get:
+      operationId: listItems
+      responses:
+        '200':
+          description: The items
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Page'
+        default:
+          description: An error
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Error'
+components:
+  schemas:
+    Error:
+      type: object
+      required: [message]
+      properties:
+        message:
+          type: string
+    Page:
+      type: object
+      properties:
+        cursor:
+          $ref: '#/components/schemas/Cursor'
+        pets:
+          type: array
+          items:
+            $ref: '#/components/schemas/Pet'
+    Pagination:
+      type: object
+      properties:
+        next:
+          $ref: '#/components/schemas/Cursor'
+    Cursor:
+      type: string
+    Pet:
+      type: object
+      properties:
+        %s:
+          type: string
+`
+
+func loadSharedDefinition(t *testing.T, title string, petProperty string) *openapi3.Swagger {
+	swagger, err := openapi3.NewSwaggerLoader().LoadSwaggerFromData([]byte(fmt.Sprintf(sharedDefinition, title, petProperty)))
+	require.NoError(t, err)
+	return swagger
+}
+
+func TestFindSharedSchemas(t *testing.T) {
+	users := loadSharedDefinition(t, "Users", "name")
+	billing := loadSharedDefinition(t, "Billing", "owner")
+	other := loadSharedDefinition(t, "Other", "name")
+
+	shared, sharedNames, err := FindSharedSchemas([]*openapi3.Swagger{users, billing})
+	require.NoError(t, err)
+	// Page refers to Pet, which differs, so it can't be shared
+	expected := []string{"Cursor", "Error", "Pagination"}
+	assert.Equal(t, expected, SortedSchemaKeys(shared.Components.Schemas))
+	assert.Equal(t, [][]string{expected, expected}, sharedNames)
+
+	// Only the specs which have the same schema share it
+	_, sharedNames, err = FindSharedSchemas([]*openapi3.Swagger{users, billing, other})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Cursor", "Error", "Page", "Pagination", "Pet"}, sharedNames[0])
+	assert.Equal(t, expected, sharedNames[1])
+	assert.Equal(t, []string{"Cursor", "Error", "Page", "Pagination", "Pet"}, sharedNames[2])
+
+	opts := Options{
+		GenerateTypes:  true,
+		GenerateClient: true,
+		SharedPackage:  "github.com/me/gateway/shared",
+		SharedSchemas:  sharedNames[1],
+	}
+	code, err := Generate(billing, "billing", opts)
+	require.NoError(t, err)
+	assert.Contains(t, code, `"github.com/me/gateway/shared"`)
+	assert.NotContains(t, code, "type Error ")
+	assert.NotContains(t, code, "type Cursor ")
+	assert.Contains(t, code, "type Pet ")
+	assert.Regexp(t, `Cursor +\*shared\.Cursor`, code)
+	assert.Regexp(t, `JSONDefault +\*shared\.Error`, code)
+
+	code, err = Generate(shared, "shared", Options{GenerateTypes: true, SkipPrune: true})
+	require.NoError(t, err)
+	assert.Contains(t, code, "type Error struct")
+	assert.Contains(t, code, "type Cursor string")
+	assert.Contains(t, code, "type Pagination struct")
+
+	_, err = Generate(loadSharedDefinition(t, "Users", "name"), "users", Options{GenerateTypes: true, SharedSchemas: []string{"Error"}})
+	assert.Error(t, err, "Expected an error on shared schemas without a shared package")
+}
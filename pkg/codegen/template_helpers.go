@@ -97,12 +97,13 @@ func genResponsePayload(operationID string) string {
 	return buffer.String()
 }
 
-// genResponseUnmarshal generates unmarshaling steps for structured response payloads
+// genResponseUnmarshal generates unmarshaling steps for structured response
+// payloads. Each content type of each response is decoded by the decoder which
+// the client has for it, so that decoders can be added at runtime.
 func genResponseUnmarshal(op *OperationDefinition) string {
 	var buffer = bytes.NewBufferString("")
 
-	var handledCaseClauses = make(map[string]string)
-	var unhandledCaseClauses = make(map[string]string)
+	var caseClauses = make(map[string]string)
 
 	// Get the type definitions from the operation:
 	typeDefinitions, err := op.GetResponseTypeDefinitions()
@@ -110,128 +111,101 @@ func genResponseUnmarshal(op *OperationDefinition) string {
 		panic(err)
 	}
 
-	// Add a case for each possible response:
-	responses := op.Spec.Responses
+	// Add a case for each content type of each response:
 	for _, typeDefinition := range typeDefinitions {
-
-		responseRef, ok := responses[typeDefinition.ResponseName]
-		if !ok {
-			continue
+		condition := fmt.Sprintf("runtime.MediaTypeMatches(contentType, %q)", typeDefinition.ContentType)
+		if statusCondition := genStatusCondition(typeDefinition.ResponseName); statusCondition != "" {
+			condition += " && " + statusCondition
 		}
+		// Bodies which the decoder can't decode into the type are left in the
+		// Body, as are those without a decoder.
+		caseAction := fmt.Sprintf("if decode, found := decoders.Lookup(contentType); found {\n"+
+			"var dest %s\n"+
+			"switch err := decode(bodyBytes, &dest); err {\n"+
+			"case nil:\n"+
+			"response.%s = &dest\n"+
+			"case runtime.ErrUnsupportedDestination:\n"+
+			"default:\n"+
+			" return nil, err \n"+
+			"}\n"+
+			"}",
+			typeDefinition.Schema.TypeDecl(),
+			typeDefinition.TypeName)
+		caseKey := fmt.Sprintf("%s.%s.%d.%s", responseSpecificity(typeDefinition.ResponseName),
+			typeDefinition.ResponseName, contentTypeSpecificity(typeDefinition.ContentType), typeDefinition.ContentType)
+		caseClauses[caseKey] = fmt.Sprintf("case %s:\n%s\n", condition, caseAction)
+	}
+	decodes := len(caseClauses) != 0
 
-		// We can't do much without a value:
+	// If there is no content-type then we have no unmarshaling to do:
+	responses := op.Spec.Responses
+	for _, responseName := range SortedResponsesKeys(responses) {
+		responseRef := responses[responseName]
 		if responseRef.Value == nil {
-			fmt.Fprintf(os.Stderr, "Response %s.%s has nil value\n", op.OperationId, typeDefinition.ResponseName)
+			fmt.Fprintf(os.Stderr, "Response %s.%s has nil value\n", op.OperationId, responseName)
 			continue
 		}
-
-		// If there is no content-type then we have no unmarshaling to do:
-		if len(responseRef.Value.Content) == 0 {
-			caseAction := "break // No content-type"
-			if typeDefinition.ResponseName == "default" {
-				caseClauseKey := "default:"
-				unhandledCaseClauses[prefixLeastSpecific+caseClauseKey] = fmt.Sprintf("%s\n%s\n", caseClauseKey, caseAction)
-			} else {
-				caseClauseKey := fmt.Sprintf("case rsp.StatusCode == %s:", typeDefinition.ResponseName)
-				unhandledCaseClauses[prefixLessSpecific+caseClauseKey] = fmt.Sprintf("%s\n%s\n", caseClauseKey, caseAction)
-			}
+		if len(responseRef.Value.Content) != 0 {
 			continue
 		}
-
-		// If we made it this far then we need to handle unmarshaling for each content-type:
-		sortedContentKeys := SortedContentKeys(responseRef.Value.Content)
-		for _, contentTypeName := range sortedContentKeys {
-
-			// We get "interface{}" when using "anyOf" or "oneOf" (which doesn't work with Go types):
-			if typeDefinition.TypeName == "interface{}" {
-				// Unable to unmarshal this, so we leave it out:
-				continue
-			}
-
-			// Add content-types here (json / yaml / xml etc):
-			switch {
-
-			// JSON:
-			case StringInArray(contentTypeName, contentTypesJSON):
-				var caseAction string
-
-				caseAction = fmt.Sprintf("var dest %s\n"+
-					"if err := json.Unmarshal(bodyBytes, &dest); err != nil { \n"+
-					" return nil, err \n"+
-					"}\n"+
-					"response.%s = &dest",
-					typeDefinition.Schema.TypeDecl(),
-					typeDefinition.TypeName)
-
-				caseKey, caseClause := buildUnmarshalCase(typeDefinition, caseAction, "json")
-				handledCaseClauses[caseKey] = caseClause
-
-			// YAML:
-			case StringInArray(contentTypeName, contentTypesYAML):
-				var caseAction string
-				caseAction = fmt.Sprintf("var dest %s\n"+
-					"if err := yaml.Unmarshal(bodyBytes, &dest); err != nil { \n"+
-					" return nil, err \n"+
-					"}\n"+
-					"response.%s = &dest",
-					typeDefinition.Schema.TypeDecl(),
-					typeDefinition.TypeName)
-				caseKey, caseClause := buildUnmarshalCase(typeDefinition, caseAction, "yaml")
-				handledCaseClauses[caseKey] = caseClause
-
-			// XML:
-			case StringInArray(contentTypeName, contentTypesXML):
-				var caseAction string
-				caseAction = fmt.Sprintf("var dest %s\n"+
-					"if err := xml.Unmarshal(bodyBytes, &dest); err != nil { \n"+
-					" return nil, err \n"+
-					"}\n"+
-					"response.%s = &dest",
-					typeDefinition.Schema.TypeDecl(),
-					typeDefinition.TypeName)
-				caseKey, caseClause := buildUnmarshalCase(typeDefinition, caseAction, "xml")
-				handledCaseClauses[caseKey] = caseClause
-
-			// Everything else:
-			default:
-				caseAction := fmt.Sprintf("// Content-type (%s) unsupported", contentTypeName)
-				if typeDefinition.ResponseName == "default" {
-					caseClauseKey := "default:"
-					unhandledCaseClauses[prefixLeastSpecific+caseClauseKey] = fmt.Sprintf("%s\n%s\n", caseClauseKey, caseAction)
-				} else {
-					caseClauseKey := fmt.Sprintf("case rsp.StatusCode == %s:", typeDefinition.ResponseName)
-					unhandledCaseClauses[prefixLessSpecific+caseClauseKey] = fmt.Sprintf("%s\n%s\n", caseClauseKey, caseAction)
-				}
-			}
+		caseClauseKey := "default:"
+		if statusCondition := genStatusCondition(responseName); statusCondition != "" {
+			caseClauseKey = fmt.Sprintf("case %s:", statusCondition)
 		}
+		caseKey := fmt.Sprintf("%s.%s", responseSpecificity(responseName), responseName)
+		caseClauses[caseKey] = fmt.Sprintf("%s\nbreak // No content-type\n", caseClauseKey)
 	}
 
 	// Now build the switch statement in order of most-to-least specific:
-	// See: https://github.com/deepmap/oapi-codegen/issues/127 for why we handle this in two separate
-	// groups.
-	fmt.Fprintf(buffer, "switch {\n")
-	for _, caseClauseKey := range SortedStringKeys(handledCaseClauses) {
-
-		fmt.Fprintf(buffer, "%s\n", handledCaseClauses[caseClauseKey])
+	// See: https://github.com/deepmap/oapi-codegen/issues/127 for why the
+	// responses for a range of status codes come after those for one of them.
+	if decodes {
+		fmt.Fprintf(buffer, "contentType := rsp.Header.Get(\"%s\")\n", echo.HeaderContentType)
 	}
-	for _, caseClauseKey := range SortedStringKeys(unhandledCaseClauses) {
-
-		fmt.Fprintf(buffer, "%s\n", unhandledCaseClauses[caseClauseKey])
+	fmt.Fprintf(buffer, "switch {\n")
+	for _, caseClauseKey := range SortedStringKeys(caseClauses) {
+		fmt.Fprintf(buffer, "%s\n", caseClauses[caseClauseKey])
 	}
 	fmt.Fprintf(buffer, "}\n")
 
 	return buffer.String()
 }
 
-// buildUnmarshalCase builds an unmarshalling case clause for different content-types:
-func buildUnmarshalCase(typeDefinition TypeDefinition, caseAction string, contentType string) (caseKey string, caseClause string) {
-	caseKey = fmt.Sprintf("%s.%s.%s", prefixLeastSpecific, contentType, typeDefinition.ResponseName)
-	if typeDefinition.ResponseName == "default" {
-		caseClause = fmt.Sprintf("case strings.Contains(rsp.Header.Get(\"%s\"), \"%s\"):\n%s\n", echo.HeaderContentType, contentType, caseAction)
-	} else {
-		caseClause = fmt.Sprintf("case strings.Contains(rsp.Header.Get(\"%s\"), \"%s\") && rsp.StatusCode == %s:\n%s\n", echo.HeaderContentType, contentType, typeDefinition.ResponseName, caseAction)
+// genStatusCondition returns the condition on the status code of a response
+// for a status code of a spec, which may be a range, such as 4XX. The default
+// response has none.
+func genStatusCondition(responseName string) string {
+	switch {
+	case responseName == "default":
+		return ""
+	case len(responseName) == 3 && strings.HasSuffix(strings.ToUpper(responseName), "XX"):
+		return fmt.Sprintf("rsp.StatusCode/100 == %s", responseName[:1])
+	default:
+		return fmt.Sprintf("rsp.StatusCode == %s", responseName)
+	}
+}
+
+// responseSpecificity orders the responses of an operation, so that those for
+// a status code are handled before those for a range, and the default last.
+func responseSpecificity(responseName string) string {
+	switch genStatusCondition(responseName) {
+	case "":
+		return prefixLeastSpecific
+	case fmt.Sprintf("rsp.StatusCode == %s", responseName):
+		return prefixMostSpecific
+	default:
+		return prefixLessSpecific
+	}
+}
+
+// contentTypeSpecificity orders the content types of a response, so that
+// those with a suffix, such as application/problem+json, are matched before
+// that of the suffix.
+func contentTypeSpecificity(contentType string) int {
+	if strings.Contains(contentType, "+") {
+		return 0
 	}
-	return caseKey, caseClause
+	return 1
 }
 
 // genResponseTypeName creates the name of generated response types (given the operationID):
@@ -247,6 +221,22 @@ func getResponseTypeDefinitions(op *OperationDefinition) []TypeDefinition {
 	return td
 }
 
+func getResponseHeadersDefinitions(op *OperationDefinition) []ResponseHeadersDefinition {
+	rhds, err := op.GetResponseHeadersDefinitions()
+	if err != nil {
+		panic(err)
+	}
+	return rhds
+}
+
+func getResponseDefinitions(op *OperationDefinition) []ResponseDefinition {
+	rd, err := op.GetResponseDefinitions()
+	if err != nil {
+		panic(err)
+	}
+	return rd
+}
+
 // This outputs a string array
 func toStringArray(sarr []string) string {
 	return `[]string{"` + strings.Join(sarr, `","`) + `"}`
@@ -257,24 +247,50 @@ func stripNewLines(s string) string {
 	return r.Replace(s)
 }
 
+// Returns the template functions which depend on the options and state of
+// the generator. generateValidation returns whether Validate methods are
+// generated, so that the servers and clients can call them, validationCode
+// returns the body of the Validate method of a type, and typesPrefix returns
+// the prefix of the names of generated types.
+func (g *generator) templateFunctions() template.FuncMap {
+	return template.FuncMap{
+		"generateValidation": func() bool { return g.options.GenerateValidation },
+		"validationCode":     g.validationCode,
+		"typesPrefix":        g.typesPrefix,
+	}
+}
+
 // This function map is passed to the template engine, and we can call each
 // function here by keyName from the template code.
 var TemplateFunctions = template.FuncMap{
-	"genParamArgs":               genParamArgs,
-	"genParamTypes":              genParamTypes,
-	"genParamNames":              genParamNames,
-	"genParamFmtString":          genParamFmtString,
-	"swaggerUriToEchoUri":        SwaggerUriToEchoUri,
-	"swaggerUriToChiUri":         SwaggerUriToChiUri,
-	"lcFirst":                    LowercaseFirstCharacter,
-	"ucFirst":                    UppercaseFirstCharacter,
-	"camelCase":                  ToCamelCase,
-	"genResponsePayload":         genResponsePayload,
-	"genResponseTypeName":        genResponseTypeName,
-	"genResponseUnmarshal":       genResponseUnmarshal,
-	"getResponseTypeDefinitions": getResponseTypeDefinitions,
-	"toStringArray":              toStringArray,
-	"lower":                      strings.ToLower,
-	"title":                      strings.Title,
-	"stripNewLines":              stripNewLines,
+	"genParamArgs":                  genParamArgs,
+	"genParamTypes":                 genParamTypes,
+	"genParamNames":                 genParamNames,
+	"genParamFmtString":             genParamFmtString,
+	"swaggerUriToEchoUri":           SwaggerUriToEchoUri,
+	"swaggerUriToChiUri":            SwaggerUriToChiUri,
+	"swaggerUriToGinUri":            SwaggerUriToGinUri,
+	"swaggerUriToGorillaUri":        SwaggerUriToGorillaUri,
+	"swaggerUriToStdHttpUri":        SwaggerUriToStdHttpUri,
+	"lcFirst":                       LowercaseFirstCharacter,
+	"ucFirst":                       UppercaseFirstCharacter,
+	"camelCase":                     ToCamelCase,
+	"genResponsePayload":            genResponsePayload,
+	"genResponseTypeName":           genResponseTypeName,
+	"genResponseUnmarshal":          genResponseUnmarshal,
+	"getResponseTypeDefinitions":    getResponseTypeDefinitions,
+	"getResponseHeadersDefinitions": getResponseHeadersDefinitions,
+	"getResponseDefinitions":        getResponseDefinitions,
+	"toStringArray":                 toStringArray,
+	"lower":                         strings.ToLower,
+	"title":                         strings.Title,
+	"stripNewLines":                 stripNewLines,
+}
+
+func init() {
+	// Templates executed outside of Generate get the functions of a generator
+	// with the default options.
+	for name, function := range defaultGenerator().templateFunctions() {
+		TemplateFunctions[name] = function
+	}
 }
@@ -99,8 +99,8 @@ func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
 
 {{if .RequiresParamObject}}
 // ParamsFor{{.OperationId}} operation parameters from context
-func ParamsFor{{.OperationId}}(ctx context.Context) *{{.OperationId}}Params {
-  return ctx.Value("{{.OperationId}}Params").(*{{.OperationId}}Params)
+func ParamsFor{{.OperationId}}(ctx context.Context) *{{typesPrefix}}{{.OperationId}}Params {
+  return ctx.Value("{{.OperationId}}Params").(*{{typesPrefix}}{{.OperationId}}Params)
 }
 {{end}}
 
@@ -142,7 +142,7 @@ func {{$opid}}Ctx(next http.Handler) http.Handler {
 
     {{if .RequiresParamObject}}
       // Parameter object where we will unmarshal all parameters from the context
-      var params {{.OperationId}}Params
+      var params {{typesPrefix}}{{.OperationId}}Params
 
       {{range $paramIdx, $param := .QueryParams}}// ------------- {{if .Required}}Required{{else}}Optional{{end}} query parameter "{{.ParamName}}" -------------
         if paramValue := r.URL.Query().Get("{{.ParamName}}"); paramValue != "" {
@@ -260,6 +260,12 @@ func {{$opid}}Ctx(next http.Handler) http.Handler {
         {{- end}}
       {{end}}
 
+      {{if generateValidation}}
+      if err := params.Validate(); err != nil {
+        http.Error(w, fmt.Sprintf("Invalid parameters: %s", err), http.StatusBadRequest)
+        return
+      }
+      {{end}}
       ctx = context.WithValue(ctx, "{{.OperationId}}Params", &params)
     {{end}}
     next.ServeHTTP(w, r.WithContext(ctx))
@@ -273,6 +279,10 @@ func {{$opid}}Ctx(next http.Handler) http.Handler {
 	"client-with-responses.tmpl": `// ClientWithResponses builds on ClientInterface to offer response payloads
 type ClientWithResponses struct {
     ClientInterface
+
+    // The decoders of response bodies, from the ResponseDecoders of the
+    // Client.
+    decoders *runtime.ResponseDecoders
 }
 
 // NewClientWithResponses creates a new ClientWithResponses, which wraps
@@ -282,7 +292,7 @@ func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithRes
     if err != nil {
         return nil, err
     }
-    return &ClientWithResponses{client}, nil
+    return &ClientWithResponses{ClientInterface: client, decoders: client.ResponseDecoders}, nil
 }
 
 // WithBaseURL overrides the baseURL.
@@ -304,20 +314,28 @@ type ClientWithResponsesInterface interface {
 {{$pathParams := .PathParams -}}
 {{$opid := .OperationId -}}
     // {{$opid}} request {{if .HasBody}} with any body{{end}}
-    {{$opid}}{{if .HasBody}}WithBody{{end}}WithResponse(ctx context.Context{{genParamArgs .PathParams}}{{if .RequiresParamObject}}, params *{{$opid}}Params{{end}}{{if .HasBody}}, contentType string, body io.Reader{{end}}) (*{{genResponseTypeName $opid | ucFirst}}, error)
+    {{$opid}}{{if .HasBody}}WithBody{{end}}WithResponse(ctx context.Context{{genParamArgs .PathParams}}{{if .RequiresParamObject}}, params *{{typesPrefix}}{{$opid}}Params{{end}}{{if .HasBody}}, contentType string, body io.Reader{{end}}) (*{{genResponseTypeName $opid | ucFirst}}, error)
 {{range .Bodies}}
-    {{$opid}}{{.Suffix}}WithResponse(ctx context.Context{{genParamArgs $pathParams}}{{if $hasParams}}, params *{{$opid}}Params{{end}}, body {{$opid}}{{.NameTag}}RequestBody) (*{{genResponseTypeName $opid | ucFirst}}, error)
+    {{$opid}}{{.Suffix}}WithResponse(ctx context.Context{{genParamArgs $pathParams}}{{if $hasParams}}, params *{{typesPrefix}}{{$opid}}Params{{end}}, body {{typesPrefix}}{{$opid}}{{.NameTag}}RequestBody) (*{{genResponseTypeName $opid | ucFirst}}, error)
 {{end}}{{/* range .Bodies */}}
 {{end}}{{/* range . $opid := .OperationId */}}
 }
 
 {{range .}}{{$opid := .OperationId}}{{$op := .}}
+{{range getResponseHeadersDefinitions .}}{{if .Headers}}
+// {{.TypeName}} are the headers of the {{.StatusCode}} response of {{$opid}}
+type {{.TypeName}} {{.Schema.TypeDecl}}
+{{end}}{{end}}
+
 type {{$opid | ucFirst}}Response struct {
     Body         []byte
 	HTTPResponse *http.Response
     {{- range getResponseTypeDefinitions .}}
     {{.TypeName}} *{{.Schema.TypeDecl}}
     {{- end}}
+    {{- range getResponseHeadersDefinitions .}}{{if .Headers}}
+    {{.FieldName}} *{{.TypeName}}
+    {{- end}}{{end}}
 }
 
 // Status returns HTTPResponse.Status
@@ -343,24 +361,24 @@ func (r {{$opid | ucFirst}}Response) StatusCode() int {
 {{/* Generate client methods (with responses)*/}}
 
 // {{$opid}}{{if .HasBody}}WithBody{{end}}WithResponse request{{if .HasBody}} with arbitrary body{{end}} returning *{{$opid}}Response
-func (c *ClientWithResponses) {{$opid}}{{if .HasBody}}WithBody{{end}}WithResponse(ctx context.Context{{genParamArgs .PathParams}}{{if .RequiresParamObject}}, params *{{$opid}}Params{{end}}{{if .HasBody}}, contentType string, body io.Reader{{end}}) (*{{genResponseTypeName $opid | ucFirst}}, error){
+func (c *ClientWithResponses) {{$opid}}{{if .HasBody}}WithBody{{end}}WithResponse(ctx context.Context{{genParamArgs .PathParams}}{{if .RequiresParamObject}}, params *{{typesPrefix}}{{$opid}}Params{{end}}{{if .HasBody}}, contentType string, body io.Reader{{end}}) (*{{genResponseTypeName $opid | ucFirst}}, error){
     rsp, err := c.{{$opid}}{{if .HasBody}}WithBody{{end}}(ctx{{genParamNames .PathParams}}{{if .RequiresParamObject}}, params{{end}}{{if .HasBody}}, contentType, body{{end}})
     if err != nil {
         return nil, err
     }
-    return Parse{{genResponseTypeName $opid | ucFirst}}(rsp)
+    return Parse{{genResponseTypeName $opid | ucFirst}}WithDecoders(rsp, c.decoders)
 }
 
 {{$hasParams := .RequiresParamObject -}}
 {{$pathParams := .PathParams -}}
 {{$bodyRequired := .BodyRequired -}}
 {{range .Bodies}}
-func (c *ClientWithResponses) {{$opid}}{{.Suffix}}WithResponse(ctx context.Context{{genParamArgs $pathParams}}{{if $hasParams}}, params *{{$opid}}Params{{end}}, body {{$opid}}{{.NameTag}}RequestBody) (*{{genResponseTypeName $opid | ucFirst}}, error) {
+func (c *ClientWithResponses) {{$opid}}{{.Suffix}}WithResponse(ctx context.Context{{genParamArgs $pathParams}}{{if $hasParams}}, params *{{typesPrefix}}{{$opid}}Params{{end}}, body {{typesPrefix}}{{$opid}}{{.NameTag}}RequestBody) (*{{genResponseTypeName $opid | ucFirst}}, error) {
     rsp, err := c.{{$opid}}{{.Suffix}}(ctx{{genParamNames $pathParams}}{{if $hasParams}}, params{{end}}, body)
     if err != nil {
         return nil, err
     }
-    return Parse{{genResponseTypeName $opid | ucFirst}}(rsp)
+    return Parse{{genResponseTypeName $opid | ucFirst}}WithDecoders(rsp, c.decoders)
 }
 {{end}}
 
@@ -371,6 +389,15 @@ func (c *ClientWithResponses) {{$opid}}{{.Suffix}}WithResponse(ctx context.Conte
 
 // Parse{{genResponseTypeName $opid | ucFirst}} parses an HTTP response from a {{$opid}}WithResponse call
 func Parse{{genResponseTypeName $opid | ucFirst}}(rsp *http.Response) (*{{genResponseTypeName $opid | ucFirst}}, error) {
+    return Parse{{genResponseTypeName $opid | ucFirst}}WithDecoders(rsp, nil)
+}
+
+// Parse{{genResponseTypeName $opid | ucFirst}}WithDecoders parses an HTTP response from a {{$opid}}WithResponse call,
+// decoding its body with the given decoders, or runtime.DefaultResponseDecoders when nil
+func Parse{{genResponseTypeName $opid | ucFirst}}WithDecoders(rsp *http.Response, decoders *runtime.ResponseDecoders) (*{{genResponseTypeName $opid | ucFirst}}, error) {
+    if decoders == nil {
+        decoders = runtime.DefaultResponseDecoders
+    }
     bodyBytes, err := ioutil.ReadAll(rsp.Body)
     defer rsp.Body.Close()
     if err != nil {
@@ -380,7 +407,40 @@ func Parse{{genResponseTypeName $opid | ucFirst}}(rsp *http.Response) (*{{genRes
     response := {{genResponsePayload $opid}}
 
     {{genResponseUnmarshal .}}
-
+{{with getResponseHeadersDefinitions .}}
+    switch {
+    {{range .}}
+    {{if .StatusCondition}}case {{.StatusCondition}}:{{else}}default:{{end}}
+    {{- if .Headers}}
+        var headers {{.TypeName}}
+        {{range .Headers}}
+        if value := rsp.Header.Get("{{.ParamName}}"); value != "" {
+            var dest {{.TypeDef}}
+            {{if .IsPassThrough}}
+            dest = value
+            {{end}}
+            {{if .IsJson}}
+            if err := json.Unmarshal([]byte(value), &dest); err != nil {
+                return nil, fmt.Errorf("error unmarshaling header {{.ParamName}} as JSON: %s", err)
+            }
+            {{end}}
+            {{if .IsStyled}}
+            if err := runtime.BindStyledParameter("{{.Style}}", {{.Explode}}, "{{.ParamName}}", value, &dest); err != nil {
+                return nil, fmt.Errorf("invalid format for header {{.ParamName}}: %s", err)
+            }
+            {{end}}
+            headers.{{.GoFieldName}} = {{if .IndirectOptional}}&{{end}}dest
+        }{{if .Required}} else {
+            return nil, fmt.Errorf("header {{.ParamName}} is required, but not found")
+        }{{end}}
+        {{end}}
+        response.{{.FieldName}} = &headers
+    {{else}}
+        break // No headers
+    {{end}}
+    {{end}}
+    }
+{{end}}
     return response, nil
 }
 {{end}}{{/* range . $opid := .OperationId */}}
@@ -409,6 +469,10 @@ type Client struct {
 	// A callback for modifying requests which are generated before sending over
 	// the network.
 	RequestEditor RequestEditorFn
+
+	// The decoders of response bodies, by media type. When nil,
+	// runtime.DefaultResponseDecoders is used.
+	ResponseDecoders *runtime.ResponseDecoders
 }
 
 // ClientOption allows setting custom parameters during construction
@@ -455,6 +519,19 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 	}
 }
 
+// WithResponseDecoder registers the decoder of the response bodies of a media
+// type, such as application/x-protobuf, for this client only. Registering the
+// decoder of application/cbor decodes the media types with a +cbor suffix too.
+func WithResponseDecoder(mediaType string, decoder runtime.ResponseDecoder) ClientOption {
+	return func(c *Client) error {
+		if c.ResponseDecoders == nil {
+			c.ResponseDecoders = runtime.DefaultResponseDecoders.Clone()
+		}
+		c.ResponseDecoders.Register(mediaType, decoder)
+		return nil
+	}
+}
+
 // The interface specification for the client above.
 type ClientInterface interface {
 {{range . -}}
@@ -462,9 +539,9 @@ type ClientInterface interface {
 {{$pathParams := .PathParams -}}
 {{$opid := .OperationId -}}
     // {{$opid}} request {{if .HasBody}} with any body{{end}}
-    {{$opid}}{{if .HasBody}}WithBody{{end}}(ctx context.Context{{genParamArgs $pathParams}}{{if $hasParams}}, params *{{$opid}}Params{{end}}{{if .HasBody}}, contentType string, body io.Reader{{end}}) (*http.Response, error)
+    {{$opid}}{{if .HasBody}}WithBody{{end}}(ctx context.Context{{genParamArgs $pathParams}}{{if $hasParams}}, params *{{typesPrefix}}{{$opid}}Params{{end}}{{if .HasBody}}, contentType string, body io.Reader{{end}}) (*http.Response, error)
 {{range .Bodies}}
-    {{$opid}}{{.Suffix}}(ctx context.Context{{genParamArgs $pathParams}}{{if $hasParams}}, params *{{$opid}}Params{{end}}, body {{$opid}}{{.NameTag}}RequestBody) (*http.Response, error)
+    {{$opid}}{{.Suffix}}(ctx context.Context{{genParamArgs $pathParams}}{{if $hasParams}}, params *{{typesPrefix}}{{$opid}}Params{{end}}, body {{typesPrefix}}{{$opid}}{{.NameTag}}RequestBody) (*http.Response, error)
 {{end}}{{/* range .Bodies */}}
 {{end}}{{/* range . $opid := .OperationId */}}
 }
@@ -476,7 +553,7 @@ type ClientInterface interface {
 {{$pathParams := .PathParams -}}
 {{$opid := .OperationId -}}
 
-func (c *Client) {{$opid}}{{if .HasBody}}WithBody{{end}}(ctx context.Context{{genParamArgs $pathParams}}{{if $hasParams}}, params *{{$opid}}Params{{end}}{{if .HasBody}}, contentType string, body io.Reader{{end}}) (*http.Response, error) {
+func (c *Client) {{$opid}}{{if .HasBody}}WithBody{{end}}(ctx context.Context{{genParamArgs $pathParams}}{{if $hasParams}}, params *{{typesPrefix}}{{$opid}}Params{{end}}{{if .HasBody}}, contentType string, body io.Reader{{end}}) (*http.Response, error) {
     req, err := New{{$opid}}Request{{if .HasBody}}WithBody{{end}}(c.Server{{genParamNames .PathParams}}{{if $hasParams}}, params{{end}}{{if .HasBody}}, contentType, body{{end}})
     if err != nil {
         return nil, err
@@ -485,6 +562,11 @@ func (c *Client) {{$opid}}{{if .HasBody}}WithBody{{end}}(ctx context.Context{{ge
     if c.RequestEditor != nil {
         err = c.RequestEditor(ctx, req)
         if err != nil {
+            // The request isn't sent, so its body is closed here rather than
+            // by the Doer, which stops the writing of a streamed body.
+            if req.Body != nil {
+                req.Body.Close()
+            }
             return nil, err
         }
     }
@@ -492,8 +574,8 @@ func (c *Client) {{$opid}}{{if .HasBody}}WithBody{{end}}(ctx context.Context{{ge
 }
 
 {{range .Bodies}}
-func (c *Client) {{$opid}}{{.Suffix}}(ctx context.Context{{genParamArgs $pathParams}}{{if $hasParams}}, params *{{$opid}}Params{{end}}, body {{$opid}}{{.NameTag}}RequestBody) (*http.Response, error) {
-    req, err := New{{$opid}}{{.Suffix}}Request(c.Server{{genParamNames $pathParams}}{{if $hasParams}}, params{{end}}, body)
+func (c *Client) {{$opid}}{{.Suffix}}(ctx context.Context{{genParamArgs $pathParams}}{{if $hasParams}}, params *{{typesPrefix}}{{$opid}}Params{{end}}, body {{typesPrefix}}{{$opid}}{{.NameTag}}RequestBody) (*http.Response, error) {
+    req, err := New{{$opid}}Request{{.Suffix}}(c.Server{{genParamNames $pathParams}}{{if $hasParams}}, params{{end}}, body)
     if err != nil {
         return nil, err
     }
@@ -501,6 +583,11 @@ func (c *Client) {{$opid}}{{.Suffix}}(ctx context.Context{{genParamArgs $pathPar
     if c.RequestEditor != nil {
         err = c.RequestEditor(ctx, req)
         if err != nil {
+            // The request isn't sent, so its body is closed here rather than
+            // by the Doer, which stops the writing of a streamed body.
+            if req.Body != nil {
+                req.Body.Close()
+            }
             return nil, err
         }
     }
@@ -518,21 +605,72 @@ func (c *Client) {{$opid}}{{.Suffix}}(ctx context.Context{{genParamArgs $pathPar
 
 {{range .Bodies}}
 // New{{$opid}}Request{{.Suffix}} calls the generic {{$opid}} builder with {{.ContentType}} body
-func New{{$opid}}Request{{.Suffix}}(server string{{genParamArgs $pathParams}}{{if $hasParams}}, params *{{$opid}}Params{{end}}, body {{$opid}}{{.NameTag}}RequestBody) (*http.Request, error) {
+{{- if eq .NameTag "Multipart"}}
+// The body is written by a goroutine as the request reads it, so the request
+// must either be sent, or have its Body closed, which stops the goroutine.
+// Files made with types.File's InitFromReader are read as they're sent,
+// rather than held in memory.
+{{- end}}
+func New{{$opid}}Request{{.Suffix}}(server string{{genParamArgs $pathParams}}{{if $hasParams}}, params *{{typesPrefix}}{{$opid}}Params{{end}}, body {{typesPrefix}}{{$opid}}{{.NameTag}}RequestBody) (*http.Request, error) {
+{{- if not (eq .NameTag "Multipart")}}
     var bodyReader io.Reader
+{{- end}}
+{{- if generateValidation}}
+    if err := runtime.ValidateValue(body); err != nil {
+        return nil, err
+    }
+{{- end}}
+{{- if eq .NameTag "Formdata"}}
+    form, err := {{if .Encodings}}runtime.MarshalFormWithEncoding(body, {{template "form-encodings" .Encodings}}){{else}}runtime.MarshalForm(body){{end}}
+    if err != nil {
+        return nil, err
+    }
+    bodyReader = strings.NewReader(form.Encode())
+{{- else if eq .NameTag "Multipart"}}
+    // The body is streamed to the request as it's written, so that files which
+    // are read from readers aren't held in memory.
+    bodyReader, bodyWriter := io.Pipe()
+    writer := multipart.NewWriter(bodyWriter)
+    go func() {
+        err := {{if .Encodings}}runtime.MarshalMultipartWithEncoding(body, writer, {{template "form-encodings" .Encodings}}){{else}}runtime.MarshalMultipart(body, writer){{end}}
+        if err == nil {
+            err = writer.Close()
+        }
+        bodyWriter.CloseWithError(err)
+    }()
+    req, err := New{{$opid}}RequestWithBody(server{{genParamNames $pathParams}}{{if $hasParams}}, params{{end}}, writer.FormDataContentType(), bodyReader)
+    if err != nil {
+        bodyReader.CloseWithError(err)
+        return nil, err
+    }
+    return req, nil
+}
+{{- else if eq .NameTag "Text"}}
+    bodyReader = strings.NewReader(string(body))
+{{- else if eq .NameTag "OctetStream"}}
+    bodyReader = body
+{{- else}}
     buf, err := json.Marshal(body)
     if err != nil {
         return nil, err
     }
     bodyReader = bytes.NewReader(buf)
+{{- end}}
+{{- if not (eq .NameTag "Multipart")}}
     return New{{$opid}}RequestWithBody(server{{genParamNames $pathParams}}{{if $hasParams}}, params{{end}}, "{{.ContentType}}", bodyReader)
 }
+{{- end}}
 {{end}}
 
 // New{{$opid}}Request{{if .HasBody}}WithBody{{end}} generates requests for {{$opid}}{{if .HasBody}} with any type of body{{end}}
-func New{{$opid}}Request{{if .HasBody}}WithBody{{end}}(server string{{genParamArgs $pathParams}}{{if $hasParams}}, params *{{$opid}}Params{{end}}{{if .HasBody}}, contentType string, body io.Reader{{end}}) (*http.Request, error) {
+func New{{$opid}}Request{{if .HasBody}}WithBody{{end}}(server string{{genParamArgs $pathParams}}{{if $hasParams}}, params *{{typesPrefix}}{{$opid}}Params{{end}}{{if .HasBody}}, contentType string, body io.Reader{{end}}) (*http.Request, error) {
     var err error
-{{range $paramIdx, $param := .PathParams}}
+{{if and $hasParams generateValidation}}
+    err = params.Validate()
+    if err != nil {
+        return nil, err
+    }
+{{end}}{{range $paramIdx, $param := .PathParams}}
     var pathParam{{$paramIdx}} string
     {{if .IsPassThrough}}
     pathParam{{$paramIdx}} = {{.ParamName}}
@@ -669,9 +807,240 @@ func New{{$opid}}Request{{if .HasBody}}WithBody{{end}}(server string{{genParamAr
 
 {{end}}{{/* Range */}}
 `,
-	"imports.tmpl": `// Package {{.PackageName}} provides primitives to interact the openapi HTTP API.
+	"enums.tmpl": `{{range .Types}}{{$typeName := .TypeName}}
+// List of {{$typeName}}
+const (
+{{- range .Schema.EnumValues}}
+    {{$typeName}}{{.Name}} {{$typeName}} = {{.Value}}
+{{- end}}
+)
+{{with .Schema.LegacyEnumValues}}
+// The names which the constants of {{$typeName}} had before they were named
+// in CamelCase
+const (
+{{- range .}}
+    // Deprecated: Use {{$typeName}}{{.Name}} instead.
+    {{$typeName}}_{{.LegacyName}} = {{$typeName}}{{.Name}}
+{{- end}}
+)
+{{end}}
+
+// All{{$typeName}}Values returns all the possible values of {{$typeName}}
+func All{{$typeName}}Values() []{{$typeName}} {
+    return []{{$typeName}}{
+{{- range .Schema.EnumValues}}
+        {{$typeName}}{{.Name}},
+{{- end}}
+    }
+}
+
+// Valid returns whether the {{$typeName}} is one of the values of the enum
+func (e {{$typeName}}) Valid() bool {
+    switch e {
+{{- range .Schema.EnumValues}}
+    case {{$typeName}}{{.Name}}:
+        return true
+{{- end}}
+    default:
+        return false
+    }
+}
+{{end}}
+`,
+	"gin-interface.tmpl": `// ServerInterface represents all server handlers.
+type ServerInterface interface {
+{{range .}}{{.SummaryAsComment }}
+// ({{.Method}} {{.Path}})
+{{.OperationId}}(c *gin.Context{{genParamArgs .PathParams}}{{if .RequiresParamObject}}, params {{typesPrefix}}{{.OperationId}}Params{{end}})
+{{end}}
+}
+`,
+	"gin-register.tmpl": `// RegisterHandlers adds each server route to the gin router.
+func RegisterHandlers(router gin.IRoutes, si ServerInterface) {
+{{if .}}
+    wrapper := ServerInterfaceWrapper{
+        Handler: si,
+    }
+{{end}}
+{{range .}}router.Handle("{{.Method}}", "{{.Path | swaggerUriToGinUri}}", wrapper.{{.OperationId}})
+{{end}}
+}
+`,
+	"gin-wrappers.tmpl": `// ServerInterfaceWrapper converts gin contexts to parameters.
+type ServerInterfaceWrapper struct {
+    Handler ServerInterface
+}
+
+{{range .}}{{$opid := .OperationId}}// {{$opid}} converts gin context to params.
+func (siw *ServerInterfaceWrapper) {{$opid}}(c *gin.Context) {
+{{range .PathParams}}// ------------- Path parameter "{{.ParamName}}" -------------
+    var {{$varName := .GoVariableName}}{{$varName}} {{.TypeDef}}
+{{if .IsPassThrough}}
+    {{$varName}} = c.Param("{{.ParamName}}")
+{{end}}
+{{if .IsJson}}
+    if err := json.Unmarshal([]byte(c.Param("{{.ParamName}}")), &{{$varName}}); err != nil {
+        c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": "Error unmarshaling parameter '{{.ParamName}}' as JSON"})
+        return
+    }
+{{end}}
+{{if .IsStyled}}
+    if err := runtime.BindStyledParameter("{{.Style}}",{{.Explode}}, "{{.ParamName}}", c.Param("{{.ParamName}}"), &{{$varName}}); err != nil {
+        c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Invalid format for parameter {{.ParamName}}: %s", err)})
+        return
+    }
+{{end}}
+{{end}}
+
+{{range .SecurityDefinitions}}
+    c.Set("{{.ProviderName}}.Scopes", {{toStringArray .Scopes}})
+{{end}}
+
+{{if .RequiresParamObject}}
+    // Parameter object where we will unmarshal all parameters from the context
+    var params {{typesPrefix}}{{.OperationId}}Params
+{{range $paramIdx, $param := .QueryParams}}// ------------- {{if .Required}}Required{{else}}Optional{{end}} query parameter "{{.ParamName}}" -------------
+    {{if .IsStyled}}
+    if err := runtime.BindQueryParameter("{{.Style}}", {{.Explode}}, {{.Required}}, "{{.ParamName}}", c.Request.URL.Query(), &params.{{.GoName}}); err != nil {
+        c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Invalid format for parameter {{.ParamName}}: %s", err)})
+        return
+    }
+    {{else}}
+    if paramValue := c.Request.URL.Query().Get("{{.ParamName}}"); paramValue != "" {
+    {{if .IsPassThrough}}
+    params.{{.GoName}} = {{if not .Required}}&{{end}}paramValue
+    {{end}}
+    {{if .IsJson}}
+    var value {{.TypeDef}}
+    if err := json.Unmarshal([]byte(paramValue), &value); err != nil {
+        c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": "Error unmarshaling parameter '{{.ParamName}}' as JSON"})
+        return
+    }
+    params.{{.GoName}} = {{if not .Required}}&{{end}}value
+    {{end}}
+    }{{if .Required}} else {
+        c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": "Query argument {{.ParamName}} is required, but not found"})
+        return
+    }{{end}}
+    {{end}}
+{{end}}
+
+{{if .HeaderParams}}
+    headers := c.Request.Header
+{{range .HeaderParams}}// ------------- {{if .Required}}Required{{else}}Optional{{end}} header parameter "{{.ParamName}}" -------------
+    if valueList, found := headers[http.CanonicalHeaderKey("{{.ParamName}}")]; found {
+        var {{.GoName}} {{.TypeDef}}
+        n := len(valueList)
+        if n != 1 {
+            c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Expected one value for {{.ParamName}}, got %d", n)})
+            return
+        }
+{{if .IsPassThrough}}
+        params.{{.GoName}} = {{if not .Required}}&{{end}}valueList[0]
+{{end}}
+{{if .IsJson}}
+        if err := json.Unmarshal([]byte(valueList[0]), &{{.GoName}}); err != nil {
+            c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": "Error unmarshaling parameter '{{.ParamName}}' as JSON"})
+            return
+        }
+{{end}}
+{{if .IsStyled}}
+        if err := runtime.BindStyledParameter("{{.Style}}",{{.Explode}}, "{{.ParamName}}", valueList[0], &{{.GoName}}); err != nil {
+            c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Invalid format for parameter {{.ParamName}}: %s", err)})
+            return
+        }
+{{end}}
+        params.{{.GoName}} = {{if not .Required}}&{{end}}{{.GoName}}
+    } {{if .Required}}else {
+        c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": "Header parameter {{.ParamName}} is required, but not found"})
+        return
+    }{{end}}
+{{end}}
+{{end}}
+
+{{range .CookieParams}}
+    if cookie, err := c.Request.Cookie("{{.ParamName}}"); err == nil {
+    {{if .IsPassThrough}}
+    params.{{.GoName}} = {{if not .Required}}&{{end}}cookie.Value
+    {{end}}
+    {{if .IsJson}}
+    var value {{.TypeDef}}
+    decoded, err := url.QueryUnescape(cookie.Value)
+    if err != nil {
+        c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": "Error unescaping cookie parameter '{{.ParamName}}'"})
+        return
+    }
+    if err := json.Unmarshal([]byte(decoded), &value); err != nil {
+        c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": "Error unmarshaling parameter '{{.ParamName}}' as JSON"})
+        return
+    }
+    params.{{.GoName}} = {{if not .Required}}&{{end}}value
+    {{end}}
+    {{if .IsStyled}}
+    var value {{.TypeDef}}
+    if err := runtime.BindStyledParameter("simple",{{.Explode}}, "{{.ParamName}}", cookie.Value, &value); err != nil {
+        c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Invalid format for parameter {{.ParamName}}: %s", err)})
+        return
+    }
+    params.{{.GoName}} = {{if not .Required}}&{{end}}value
+    {{end}}
+    }{{if .Required}} else {
+        c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": "Cookie parameter {{.ParamName}} is required, but not found"})
+        return
+    }{{end}}
+
+{{end}}{{/* .CookieParams */}}
+
+{{end}}{{/* .RequiresParamObject */}}
+{{if and .RequiresParamObject generateValidation}}
+    if err := params.Validate(); err != nil {
+        c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Invalid parameters: %s", err)})
+        return
+    }
+{{end}}
+    siw.Handler.{{.OperationId}}(c{{genParamNames .PathParams}}{{if .RequiresParamObject}}, params{{end}})
+}
+{{end}}
+`,
+	"gorilla-handler.tmpl": `// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+    return HandlerFromMux(si, mux.NewRouter())
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r *mux.Router) http.Handler {
+{{if .}}
+    wrapper := ServerInterfaceWrapper{
+        Handler: si,
+    }
+{{end}}
+{{range .}}r.HandleFunc("{{.Path | swaggerUriToGorillaUri}}", wrapper.{{.OperationId}}).Methods("{{.Method}}")
+{{end}}
+    return r
+}
+`,
+	"gorilla-wrappers.tmpl": `// ServerInterfaceWrapper converts requests to parameters.
+type ServerInterfaceWrapper struct {
+    Handler ServerInterface
+}
+
+{{range .}}{{$opid := .OperationId}}// {{$opid}} converts the request to params.
+func (siw *ServerInterfaceWrapper) {{$opid}}(w http.ResponseWriter, r *http.Request) {
+{{if .PathParams}}
+    pathParams := mux.Vars(r)
+{{end}}
+{{template "stdhttp-wrapper-body" .}}
+}
+{{end}}
+`,
+	"imports.tmpl": `{{if .PackageDoc -}}
+// Package {{.PackageName}} provides primitives to interact the openapi HTTP API.
 //
 // Code generated by github.com/deepmap/oapi-codegen DO NOT EDIT.
+{{- else -}}
+// Code generated by github.com/deepmap/oapi-codegen DO NOT EDIT.
+
+{{end}}
 package {{.PackageName}}
 
 {{if .Imports}}
@@ -709,6 +1078,95 @@ func GetSwagger() (*openapi3.Swagger, error) {
     }
     return swagger, nil
 }
+`,
+	"nullable.tmpl": `{{$prefix := .TypesPrefix}}{{range .Types}}
+// {{.TypeName}} is a Nullable {{.ElementType}}, which may also be null or unspecified.
+{{- if $prefix}}
+// See {{$prefix}}NullableString for details.
+{{- end}}
+type {{.TypeName}} map[bool]{{.ElementType}}
+
+// New{{.TypeName}} returns a {{.TypeName}} which is set to the given value.
+func New{{.TypeName}}(v {{.ElementType}}) {{.TypeName}} {
+	return {{.TypeName}}{true: v}
+}
+
+// NewNull{{.TypeName}} returns a {{.TypeName}} which is explicitly null.
+func NewNull{{.TypeName}}() {{.TypeName}} {
+	var zero {{.ElementType}}
+	return {{.TypeName}}{false: zero}
+}
+
+// Get returns the value, or an error when it's null or unspecified.
+func (t {{.TypeName}}) Get() ({{.ElementType}}, error) {
+	var zero {{.ElementType}}
+	if t.IsNull() {
+		return zero, {{$prefix}}ErrNullableIsNull
+	}
+	if !t.IsSpecified() {
+		return zero, {{$prefix}}ErrNullableNotSpecified
+	}
+	return t[true], nil
+}
+
+// MustGet returns the value, and panics when it's null or unspecified.
+func (t {{.TypeName}}) MustGet() {{.ElementType}} {
+	v, err := t.Get()
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Set sets the value.
+func (t *{{.TypeName}}) Set(v {{.ElementType}}) {
+	*t = {{.TypeName}}{true: v}
+}
+
+// IsNull returns whether the value is explicitly null.
+func (t {{.TypeName}}) IsNull() bool {
+	_, found := t[false]
+	return found
+}
+
+// SetNull makes the value explicitly null.
+func (t *{{.TypeName}}) SetNull() {
+	var zero {{.ElementType}}
+	*t = {{.TypeName}}{false: zero}
+}
+
+// IsSpecified returns whether the value is either null or set.
+func (t {{.TypeName}}) IsSpecified() bool {
+	return len(t) != 0
+}
+
+// SetUnspecified clears the value, so that it's omitted from JSON.
+func (t *{{.TypeName}}) SetUnspecified() {
+	*t = nil
+}
+
+// MarshalJSON writes the value, or null when it's null or unspecified.
+func (t {{.TypeName}}) MarshalJSON() ([]byte, error) {
+	if t.IsNull() || !t.IsSpecified() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t[true])
+}
+
+// UnmarshalJSON reads the value, recording an explicit null.
+func (t *{{.TypeName}}) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		t.SetNull()
+		return nil
+	}
+	var v {{.ElementType}}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	t.Set(v)
+	return nil
+}
+{{end}}
 `,
 	"param-types.tmpl": `{{range .}}{{$opid := .OperationId}}
 {{range .TypeDefinitions}}
@@ -746,33 +1204,531 @@ func RegisterHandlers(router EchoRouter, si ServerInterface, pathPrefix string)
 }
 `,
 	"request-bodies.tmpl": `{{range .}}{{$opid := .OperationId}}
-{{range .Bodies}}
-// {{$opid}}RequestBody defines body for {{$opid}} for application/json ContentType.
-type {{$opid}}{{.NameTag}}RequestBody {{.TypeDef}}
-{{end}}
+{{range .Bodies}}{{$typeName := printf "%s%sRequestBody" $opid .NameTag}}
+// {{$typeName}} defines body for {{$opid}} for {{.ContentType}} ContentType.
+type {{$typeName}} {{.TypeDef}}
+{{if .DelegatesJSON}}
+// MarshalJSON encodes the {{$typeName}} as the {{.TypeDef}} it's defined as.
+func (t {{$typeName}}) MarshalJSON() ([]byte, error) {
+    return json.Marshal({{.TypeDef}}(t))
+}
+
+// UnmarshalJSON decodes the {{$typeName}} as the {{.TypeDef}} it's defined as.
+func (t *{{$typeName}}) UnmarshalJSON(b []byte) error {
+    return json.Unmarshal(b, (*{{.TypeDef}})(t))
+}
+{{end}}{{if and generateValidation .DelegatesValidate}}
+// Validate checks the {{$typeName}} as the {{.TypeDef}} it's defined as.
+func (t {{$typeName}}) Validate() error {
+    return runtime.ValidateValue({{.TypeDef}}(t))
+}
+{{end}}{{end}}
 {{end}}
+`,
+	"request-body-decoders.tmpl": `{{range .}}{{$opid := .OperationId}}{{range .Bodies}}
+// Decode{{$opid}}{{.NameTag}}RequestBody decodes the {{.ContentType}} body of {{$opid}} requests. It returns EOF when the request has no body.
+func Decode{{$opid}}{{.NameTag}}RequestBody(r *http.Request) ({{typesPrefix}}{{$opid}}{{.NameTag}}RequestBody, error) {
+{{- if eq .NameTag "Formdata"}}
+    var body {{typesPrefix}}{{$opid}}{{.NameTag}}RequestBody
+    if r.ContentLength == 0 {
+        return body, io.EOF
+    }
+    if err := r.ParseForm(); err != nil {
+        return body, err
+    }
+    err := {{if .Encodings}}runtime.BindFormWithEncoding(&body, r.PostForm, {{template "form-encodings" .Encodings}}){{else}}runtime.BindForm(&body, r.PostForm){{end}}
+    return body, err
+{{- else if eq .NameTag "Multipart"}}
+    var body {{typesPrefix}}{{$opid}}{{.NameTag}}RequestBody
+    if r.ContentLength == 0 {
+        return body, io.EOF
+    }
+    if err := r.ParseMultipartForm(runtime.MultipartMemory); err != nil {
+        return body, err
+    }
+    err := {{if .Encodings}}runtime.BindMultipartWithEncoding(&body, r.MultipartForm, {{template "form-encodings" .Encodings}}){{else}}runtime.BindMultipart(&body, r.MultipartForm){{end}}
+    return body, err
+{{- else if eq .NameTag "Text"}}
+    data, err := ioutil.ReadAll(r.Body)
+    if err != nil {
+        return "", err
+    }
+    if len(data) == 0 {
+        return "", io.EOF
+    }
+    return {{typesPrefix}}{{$opid}}{{.NameTag}}RequestBody(data), nil
+{{- else if eq .NameTag "OctetStream"}}
+    if r.ContentLength == 0 {
+        return nil, io.EOF
+    }
+    return r.Body, nil
+{{- else}}
+    var body {{typesPrefix}}{{$opid}}{{.NameTag}}RequestBody
+    err := json.NewDecoder(r.Body).Decode(&body)
+    return body, err
+{{- end}}
+}
+{{end}}{{end}}
+
+{{/* form-encodings is the runtime.FormEncoding of each property of a form body, which has an encoding */}}
+{{define "form-encodings"}}map[string]runtime.FormEncoding{
+{{range .}}    {{printf "%q" .PropertyName}}: { {{- if .ContentType}}ContentType: {{printf "%q" .ContentType}}{{else}}Style: {{printf "%q" .Style}}, Explode: {{.Explode}}{{end}}},
+{{end}}}{{end}}
 `,
 	"server-interface.tmpl": `// ServerInterface represents all server handlers.
 type ServerInterface interface {
 {{range .}}{{.SummaryAsComment }}
 // ({{.Method}} {{.Path}})
-{{.OperationId}}(ctx echo.Context{{genParamArgs .PathParams}}{{if .RequiresParamObject}}, params {{.OperationId}}Params{{end}}) error
+{{.OperationId}}(ctx echo.Context{{genParamArgs .PathParams}}{{if .RequiresParamObject}}, params {{typesPrefix}}{{.OperationId}}Params{{end}}) error
+{{end}}
+}
+`,
+	"stdhttp-handler.tmpl": `// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+    return HandlerFromMux(si, http.NewServeMux())
+}
+
+// HandlerFromMux registers the routes of the OpenAPI spec on the provided
+// ServeMux, under the literal prefixes of their paths, and returns it.
+func HandlerFromMux(si ServerInterface, m *http.ServeMux) http.Handler {
+{{if .}}
+    wrapper := ServerInterfaceWrapper{
+        Handler: si,
+    }
+    router := runtime.NewRouter(
+{{range .}}runtime.Route{Method: "{{.Method}}", Path: "{{.Path | swaggerUriToStdHttpUri}}", Handler: wrapper.{{.OperationId}}},
+{{end}}
+    )
+    for _, pattern := range router.Patterns() {
+        m.Handle(pattern, router)
+    }
+{{end}}
+    return m
+}
+`,
+	"stdhttp-interface.tmpl": `// ServerInterface represents all server handlers.
+type ServerInterface interface {
+{{range .}}{{.SummaryAsComment }}
+// ({{.Method}} {{.Path}})
+{{.OperationId}}(w http.ResponseWriter, r *http.Request{{genParamArgs .PathParams}}{{if .RequiresParamObject}}, params {{typesPrefix}}{{.OperationId}}Params{{end}})
+{{end}}
+}
+`,
+	"stdhttp-wrappers.tmpl": `// ServerInterfaceWrapper converts requests to parameters.
+type ServerInterfaceWrapper struct {
+    Handler ServerInterface
+}
+
+{{range .}}{{$opid := .OperationId}}// {{$opid}} converts the request to params.
+func (siw *ServerInterfaceWrapper) {{$opid}}(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+{{template "stdhttp-wrapper-body" .}}
+}
+{{end}}
+
+{{/* stdhttp-wrapper-body binds the parameters of an operation from the request,
+and calls its handler with them. The path parameters are looked up in the
+pathParams map, which the wrapper declares in whatever way its router hands
+them over. */}}
+{{define "stdhttp-wrapper-body"}}{{$opid := .OperationId -}}
+{{range .PathParams}}// ------------- Path parameter "{{.ParamName}}" -------------
+    var {{$varName := .GoVariableName}}{{$varName}} {{.TypeDef}}
+{{if .IsPassThrough}}
+    {{$varName}} = pathParams["{{.ParamName}}"]
+{{end}}
+{{if .IsJson}}
+    if err := json.Unmarshal([]byte(pathParams["{{.ParamName}}"]), &{{$varName}}); err != nil {
+        http.Error(w, "Error unmarshaling parameter '{{.ParamName}}' as JSON", http.StatusBadRequest)
+        return
+    }
+{{end}}
+{{if .IsStyled}}
+    if err := runtime.BindStyledParameter("{{.Style}}",{{.Explode}}, "{{.ParamName}}", pathParams["{{.ParamName}}"], &{{$varName}}); err != nil {
+        http.Error(w, fmt.Sprintf("Invalid format for parameter {{.ParamName}}: %s", err), http.StatusBadRequest)
+        return
+    }
+{{end}}
+{{end}}
+
+{{if .SecurityDefinitions}}
+    ctx := r.Context()
+{{range .SecurityDefinitions}}
+    ctx = context.WithValue(ctx, "{{.ProviderName}}.Scopes", {{toStringArray .Scopes}})
+{{end}}
+    r = r.WithContext(ctx)
+{{end}}
+
+{{if .RequiresParamObject}}
+    // Parameter object where we will unmarshal all parameters from the request
+    var params {{typesPrefix}}{{.OperationId}}Params
+{{range $paramIdx, $param := .QueryParams}}// ------------- {{if .Required}}Required{{else}}Optional{{end}} query parameter "{{.ParamName}}" -------------
+    {{if .IsStyled}}
+    if err := runtime.BindQueryParameter("{{.Style}}", {{.Explode}}, {{.Required}}, "{{.ParamName}}", r.URL.Query(), &params.{{.GoName}}); err != nil {
+        http.Error(w, fmt.Sprintf("Invalid format for parameter {{.ParamName}}: %s", err), http.StatusBadRequest)
+        return
+    }
+    {{else}}
+    if paramValue := r.URL.Query().Get("{{.ParamName}}"); paramValue != "" {
+    {{if .IsPassThrough}}
+    params.{{.GoName}} = {{if not .Required}}&{{end}}paramValue
+    {{end}}
+    {{if .IsJson}}
+    var value {{.TypeDef}}
+    if err := json.Unmarshal([]byte(paramValue), &value); err != nil {
+        http.Error(w, "Error unmarshaling parameter '{{.ParamName}}' as JSON", http.StatusBadRequest)
+        return
+    }
+    params.{{.GoName}} = {{if not .Required}}&{{end}}value
+    {{end}}
+    }{{if .Required}} else {
+        http.Error(w, "Query argument {{.ParamName}} is required, but not found", http.StatusBadRequest)
+        return
+    }{{end}}
+    {{end}}
+{{end}}
+
+{{if .HeaderParams}}
+    headers := r.Header
+{{range .HeaderParams}}// ------------- {{if .Required}}Required{{else}}Optional{{end}} header parameter "{{.ParamName}}" -------------
+    if valueList, found := headers[http.CanonicalHeaderKey("{{.ParamName}}")]; found {
+        var {{.GoName}} {{.TypeDef}}
+        n := len(valueList)
+        if n != 1 {
+            http.Error(w, fmt.Sprintf("Expected one value for {{.ParamName}}, got %d", n), http.StatusBadRequest)
+            return
+        }
+{{if .IsPassThrough}}
+        params.{{.GoName}} = {{if not .Required}}&{{end}}valueList[0]
+{{end}}
+{{if .IsJson}}
+        if err := json.Unmarshal([]byte(valueList[0]), &{{.GoName}}); err != nil {
+            http.Error(w, "Error unmarshaling parameter '{{.ParamName}}' as JSON", http.StatusBadRequest)
+            return
+        }
+{{end}}
+{{if .IsStyled}}
+        if err := runtime.BindStyledParameter("{{.Style}}",{{.Explode}}, "{{.ParamName}}", valueList[0], &{{.GoName}}); err != nil {
+            http.Error(w, fmt.Sprintf("Invalid format for parameter {{.ParamName}}: %s", err), http.StatusBadRequest)
+            return
+        }
+{{end}}
+        params.{{.GoName}} = {{if not .Required}}&{{end}}{{.GoName}}
+    } {{if .Required}}else {
+        http.Error(w, "Header parameter {{.ParamName}} is required, but not found", http.StatusBadRequest)
+        return
+    }{{end}}
+{{end}}
+{{end}}
+
+{{range .CookieParams}}
+    if cookie, err := r.Cookie("{{.ParamName}}"); err == nil {
+    {{if .IsPassThrough}}
+    params.{{.GoName}} = {{if not .Required}}&{{end}}cookie.Value
+    {{end}}
+    {{if .IsJson}}
+    var value {{.TypeDef}}
+    decoded, err := url.QueryUnescape(cookie.Value)
+    if err != nil {
+        http.Error(w, "Error unescaping cookie parameter '{{.ParamName}}'", http.StatusBadRequest)
+        return
+    }
+    if err := json.Unmarshal([]byte(decoded), &value); err != nil {
+        http.Error(w, "Error unmarshaling parameter '{{.ParamName}}' as JSON", http.StatusBadRequest)
+        return
+    }
+    params.{{.GoName}} = {{if not .Required}}&{{end}}value
+    {{end}}
+    {{if .IsStyled}}
+    var value {{.TypeDef}}
+    if err := runtime.BindStyledParameter("simple",{{.Explode}}, "{{.ParamName}}", cookie.Value, &value); err != nil {
+        http.Error(w, fmt.Sprintf("Invalid format for parameter {{.ParamName}}: %s", err), http.StatusBadRequest)
+        return
+    }
+    params.{{.GoName}} = {{if not .Required}}&{{end}}value
+    {{end}}
+    }{{if .Required}} else {
+        http.Error(w, "Cookie parameter {{.ParamName}} is required, but not found", http.StatusBadRequest)
+        return
+    }{{end}}
+
+{{end}}{{/* .CookieParams */}}
+
+{{end}}{{/* .RequiresParamObject */}}
+{{if and .RequiresParamObject generateValidation}}
+    if err := params.Validate(); err != nil {
+        http.Error(w, fmt.Sprintf("Invalid parameters: %s", err), http.StatusBadRequest)
+        return
+    }
+{{end}}
+    siw.Handler.{{.OperationId}}(w, r{{genParamNames .PathParams}}{{if .RequiresParamObject}}, params{{end}})
+{{- end}}
+`,
+	"strict-chi.tmpl": `{{range .}}{{$opid := .OperationId}}
+// {{$opid}} operation.
+func (sh *strictHandler) {{$opid}}(w http.ResponseWriter, r *http.Request) {
+    var request {{$opid}}RequestObject
+{{range .PathParams}}    request.{{.GoFieldName}} = r.Context().Value("{{.GoVariableName}}").({{.TypeDef}})
+{{end}}{{if .RequiresParamObject}}    request.Params = *ParamsFor{{$opid}}(r.Context())
+{{end}}{{template "strict-http-call" .}}}
+{{end}}
+`,
+	"strict-echo.tmpl": `type strictHandler struct {
+    ssi StrictServerInterface
+}
+
+// NewStrictHandler returns a ServerInterface which decodes requests into the
+// request objects of ssi, and writes the response objects which it returns.
+func NewStrictHandler(ssi StrictServerInterface) ServerInterface {
+    return &strictHandler{ssi: ssi}
+}
+{{range .}}{{$opid := .OperationId}}{{$bodies := .Bodies}}
+// {{$opid}} operation.
+func (sh *strictHandler) {{$opid}}(ctx echo.Context{{genParamArgs .PathParams}}{{if .RequiresParamObject}}, params {{typesPrefix}}{{$opid}}Params{{end}}) error {
+    var request {{$opid}}RequestObject
+{{range .PathParams}}    request.{{.GoFieldName}} = {{.GoVariableName}}
+{{end}}{{if .RequiresParamObject}}    request.Params = params
+{{end}}{{range .Bodies}}
+{{if gt (len $bodies) 1}}    if runtime.MediaTypeMatches(ctx.Request().Header.Get("Content-Type"), "{{.ContentType}}") {
+{{end}}        body, err := Decode{{$opid}}{{.NameTag}}RequestBody(ctx.Request())
+{{if .Required}}        if err != nil {
+            return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid request body: %s", err))
+        }
+        request.{{if .Default}}Body{{else}}{{.NameTag}}Body{{end}} = {{if not .IsStream}}&{{end}}body
+{{else}}        if err == nil {
+            request.{{if .Default}}Body{{else}}{{.NameTag}}Body{{end}} = {{if not .IsStream}}&{{end}}body
+        } else if err != io.EOF {
+            return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid request body: %s", err))
+        }
+{{end}}{{if gt (len $bodies) 1}}    }
+{{end}}{{end}}
+    response, err := sh.ssi.{{$opid}}(ctx.Request().Context(), request)
+    if err != nil {
+        return err
+    }
+    if response == nil {
+        return fmt.Errorf("no response from {{$opid}}")
+    }
+    return response.Visit{{$opid}}Response(ctx.Response())
+}
+{{end}}
+`,
+	"strict-http.tmpl": `// StrictHTTPServerOptions holds the functions which respond to the errors of
+// a strict handler: RequestErrorHandlerFunc to request bodies which can't be
+// decoded, and ResponseErrorHandlerFunc to the errors which the
+// StrictServerInterface returns, or which writing its responses runs into.
+type StrictHTTPServerOptions struct {
+    RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+    ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type strictHandler struct {
+    ssi     StrictServerInterface
+    options StrictHTTPServerOptions
+}
+
+// NewStrictHandler returns a ServerInterface which decodes requests into the
+// request objects of ssi, and writes the response objects which it returns.
+// Requests which can't be decoded are answered with 400 Bad Request, and the
+// errors of ssi with 500 Internal Server Error.
+func NewStrictHandler(ssi StrictServerInterface) ServerInterface {
+    return NewStrictHandlerWithOptions(ssi, StrictHTTPServerOptions{})
+}
+
+// NewStrictHandlerWithOptions is NewStrictHandler, with the errors handled by
+// the given functions, where they're set.
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, options StrictHTTPServerOptions) ServerInterface {
+    if options.RequestErrorHandlerFunc == nil {
+        options.RequestErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+            http.Error(w, err.Error(), http.StatusBadRequest)
+        }
+    }
+    if options.ResponseErrorHandlerFunc == nil {
+        options.ResponseErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+            http.Error(w, err.Error(), http.StatusInternalServerError)
+        }
+    }
+    return &strictHandler{ssi: ssi, options: options}
+}
+
+{{define "strict-http-call"}}{{$opid := .OperationId}}{{$bodies := .Bodies}}{{range .Bodies}}
+{{if gt (len $bodies) 1}}    if runtime.MediaTypeMatches(r.Header.Get("Content-Type"), "{{.ContentType}}") {
+{{end}}        body, err := Decode{{$opid}}{{.NameTag}}RequestBody(r)
+{{if .Required}}        if err != nil {
+            sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("invalid request body: %s", err))
+            return
+        }
+        request.{{if .Default}}Body{{else}}{{.NameTag}}Body{{end}} = {{if not .IsStream}}&{{end}}body
+{{else}}        if err == nil {
+            request.{{if .Default}}Body{{else}}{{.NameTag}}Body{{end}} = {{if not .IsStream}}&{{end}}body
+        } else if err != io.EOF {
+            sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("invalid request body: %s", err))
+            return
+        }
+{{end}}{{if gt (len $bodies) 1}}    }
+{{end}}{{end}}
+    response, err := sh.ssi.{{$opid}}(r.Context(), request)
+    if err == nil && response == nil {
+        err = fmt.Errorf("no response from {{$opid}}")
+    }
+    if err == nil {
+        err = response.Visit{{$opid}}Response(w)
+    }
+    if err != nil {
+        sh.options.ResponseErrorHandlerFunc(w, r, err)
+    }
+{{end}}
+`,
+	"strict-interface.tmpl": `{{range .}}{{$opid := .OperationId}}
+// {{$opid}}RequestObject holds the parameters and body of {{$opid}} requests.
+type {{$opid}}RequestObject struct {
+{{range .PathParams}}    {{.GoFieldName}} {{.TypeDef}}
+{{end}}{{if .RequiresParamObject}}    Params {{typesPrefix}}{{$opid}}Params
+{{end}}{{range .Bodies}}    {{if .Default}}Body{{else}}{{.NameTag}}Body{{end}} {{if not .IsStream}}*{{end}}{{typesPrefix}}{{$opid}}{{.NameTag}}RequestBody
+{{end}}}
+
+// {{$opid}}ResponseObject is one of the responses which {{$opid}} may return.
+type {{$opid}}ResponseObject interface {
+    Visit{{$opid}}Response(w http.ResponseWriter) error
+}
+{{range getResponseDefinitions .}}
+// {{.TypeName}} is the {{.StatusCode}} response of {{$opid}}{{if .ContentType}}, for its {{.ContentType}} content type{{end}}.
+{{if .HasBodyField}}type {{.TypeName}} struct {
+{{if .IsJSON}}    Body {{.Schema.TypeDecl}}
+{{else if .ContentType}}    Body          io.Reader
+    ContentLength int64
+{{end}}{{if not .HasFixedStatusCode}}    StatusCode int
+{{end}}}
+{{else}}type {{.TypeName}} {{.Schema.TypeDecl}}
+{{end}}
+func (response {{.TypeName}}) Visit{{$opid}}Response(w http.ResponseWriter) error {
+{{if .SetsContentType}}    w.Header().Set("Content-Type", "{{.ContentType}}")
+{{end}}{{if and .ContentType (not .IsJSON)}}    if response.ContentLength != 0 {
+        w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+    }
+{{end}}    w.WriteHeader({{if .HasFixedStatusCode}}{{.StatusCode}}{{else}}response.StatusCode{{end}})
+{{if .IsJSON}}    return json.NewEncoder(w).Encode({{if .HasBodyField}}response.Body{{else}}({{.Schema.TypeDecl}})(response){{end}})
+{{else if .ContentType}}    if response.Body == nil {
+        return nil
+    }
+    if closer, ok := response.Body.(io.Closer); ok {
+        defer closer.Close()
+    }
+    _, err := io.Copy(w, response.Body)
+    return err
+{{else}}    return nil
+{{end}}}
+{{end}}{{end}}
+
+// StrictServerInterface represents all server handlers, which take the
+// request objects, and return the response objects, of the operations.
+type StrictServerInterface interface {
+{{range .}}{{.SummaryAsComment }}
+// ({{.Method}} {{.Path}})
+{{.OperationId}}(ctx context.Context, request {{.OperationId}}RequestObject) ({{.OperationId}}ResponseObject, error)
 {{end}}
 }
+`,
+	"strict-stdhttp.tmpl": `{{range .}}{{$opid := .OperationId}}
+// {{$opid}} operation.
+func (sh *strictHandler) {{$opid}}(w http.ResponseWriter, r *http.Request{{genParamArgs .PathParams}}{{if .RequiresParamObject}}, params {{typesPrefix}}{{$opid}}Params{{end}}) {
+    var request {{$opid}}RequestObject
+{{range .PathParams}}    request.{{.GoFieldName}} = {{.GoVariableName}}
+{{end}}{{if .RequiresParamObject}}    request.Params = params
+{{end}}{{template "strict-http-call" .}}}
+{{end}}
 `,
 	"typedef.tmpl": `{{range .Types}}
 // {{.TypeName}} defines model for {{.JsonName}}.
-type {{.TypeName}} {{.Schema.TypeDecl}}
-{{- if gt (len .Schema.EnumValues) 0 }}
-// List of {{ .TypeName }}
-const (
-	{{- $typeName := .TypeName }}
-    {{- range .Schema.EnumValues }}
-    {{ $typeName }}_{{ . }} {{ $typeName }} = "{{ . }}"
-    {{- end }}
-)
-{{- end }}
+type {{.TypeName}} {{if .Schema.DefineViaAlias}}= {{end}}{{.Schema.TypeDecl}}
 {{end}}
+`,
+	"union.tmpl": `{{range .Types}}{{$typeName := .TypeName}}
+{{range .Schema.UnionElements}}
+// As{{.Method}} returns the union data inside the {{$typeName}} as a {{.}}
+func (t {{$typeName}}) As{{.Method}}() ({{.}}, error) {
+    var body {{.}}
+    err := json.Unmarshal(t.union, &body)
+    return body, err
+}
+
+// From{{.Method}} overwrites any union data inside the {{$typeName}} as the provided {{.}}
+func (t *{{$typeName}}) From{{.Method}}(v {{.}}) error {
+    b, err := json.Marshal(v)
+    t.union = b
+    return err
+}
+
+// Merge{{.Method}} performs a merge with any union data inside the {{$typeName}}, using the provided {{.}}
+func (t *{{$typeName}}) Merge{{.Method}}(v {{.}}) error {
+    b, err := json.Marshal(v)
+    if err != nil {
+        return err
+    }
+
+    merged, err := runtime.JsonMerge(t.union, b)
+    t.union = merged
+    return err
+}
+{{end}}
+{{with .Schema.Discriminator}}
+// Discriminator returns the value of the "{{.Property}}" property of the {{$typeName}}, which
+// selects the type of the union data
+func (t {{$typeName}}) Discriminator() (string, error) {
+    var object map[string]json.RawMessage
+    err := json.Unmarshal(t.union, &object)
+    if err != nil {
+        return "", err
+    }
+    var discriminator string
+    if raw, found := object[{{printf "%q" .Property}}]; found {
+        err = json.Unmarshal(raw, &discriminator)
+    }
+    return discriminator, err
+}
+
+// ValueByDiscriminator returns the union data inside the {{$typeName}} as the
+// type selected by its discriminator
+func (t {{$typeName}}) ValueByDiscriminator() (interface{}, error) {
+    discriminator, err := t.Discriminator()
+    if err != nil {
+        return nil, err
+    }
+    switch discriminator {
+{{- range $value, $type := .Mapping}}
+    case {{printf "%q" $value}}:
+        var body {{$type}}
+        err := json.Unmarshal(t.union, &body)
+        return body, err
+{{- end}}
+    default:
+        return nil, errors.New("unknown discriminator value: " + discriminator)
+    }
+}
+{{end}}
+// MarshalJSON returns the raw union data of {{$typeName}}
+func (t {{$typeName}}) MarshalJSON() ([]byte, error) {
+    b, err := t.union.MarshalJSON()
+    return b, err
+}
+
+// UnmarshalJSON stores the raw union data of {{$typeName}}, which is decoded by
+// the As* accessors.
+func (t *{{$typeName}}) UnmarshalJSON(b []byte) error {
+    err := t.union.UnmarshalJSON(b)
+    return err
+}
+{{end}}
+`,
+	"validate.tmpl": `{{if generateValidation}}{{range .Types}}{{$body := validationCode .}}
+// Validate checks the value of {{.TypeName}} against the constraints of its schema.
+func ({{if $body}}t {{end}}{{.TypeName}}) Validate() error {
+{{- if $body}}
+    var errs runtime.ValidationErrors
+{{$body}}    return errs.ErrorOrNil()
+{{- else}}
+    return nil
+{{- end}}
+}
+{{end}}{{end}}
 `,
 	"wrappers.tmpl": `// ServerInterfaceWrapper converts echo contexts to parameters.
 type ServerInterfaceWrapper struct {
@@ -807,7 +1763,7 @@ func (w *ServerInterfaceWrapper) {{.OperationId}} (ctx echo.Context) error {
 
 {{if .RequiresParamObject}}
     // Parameter object where we will unmarshal all parameters from the context
-    var params {{.OperationId}}Params
+    var params {{typesPrefix}}{{.OperationId}}Params
 {{range $paramIdx, $param := .QueryParams}}// ------------- {{if .Required}}Required{{else}}Optional{{end}} query parameter "{{.ParamName}}" -------------
     {{if .IsStyled}}
     err = runtime.BindQueryParameter("{{.Style}}", {{.Explode}}, {{.Required}}, "{{.ParamName}}", ctx.QueryParams(), &params.{{.GoName}})
@@ -897,6 +1853,12 @@ func (w *ServerInterfaceWrapper) {{.OperationId}} (ctx echo.Context) error {
 {{end}}{{/* .CookieParams */}}
 
 {{end}}{{/* .RequiresParamObject */}}
+{{if and .RequiresParamObject generateValidation}}
+    err = params.Validate()
+    if err != nil {
+        return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid parameters: %s", err))
+    }
+{{end}}
     // Invoke the callback with all the unmarshalled arguments
     err = w.Handler.{{.OperationId}}(ctx{{genParamNames .PathParams}}{{if .RequiresParamObject}}, params{{end}})
     return err
@@ -0,0 +1,62 @@
+package codegen
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// These are the OpenAPI types which may be mapped onto Go types with
+// Options.TypeMapping.
+var mappableTypes = []string{"integer", "number", "string", "boolean"}
+
+// This checks the TypeMapping option, and converts it into the Go types used
+// for each type and format, registering the imports they need.
+func (g *generator) parseTypeMapping(typeMapping map[string]string) (map[string]string, error) {
+	result := make(map[string]string)
+	for key, qualifiedType := range typeMapping {
+		t := strings.SplitN(key, "/", 2)[0]
+		if !StringInArray(t, mappableTypes) {
+			return nil, fmt.Errorf("type mapping for '%s' must be for one of %s, with an optional format",
+				key, strings.Join(mappableTypes, ", "))
+		}
+		if qualifiedType == "" {
+			return nil, fmt.Errorf("type mapping for '%s' has no Go type", key)
+		}
+
+		goType, imp := parseQualifiedGoType(qualifiedType)
+		if imp != nil {
+			g.goTypeImports[imp.packageName] = *imp
+		}
+		result[key] = goType
+	}
+	return result, nil
+}
+
+// Returns the key in the type mapping for an OpenAPI type and format, eg,
+// "string/decimal", or just "integer" when there's no format.
+func typeMappingKey(t, format string) string {
+	if format == "" {
+		return t
+	}
+	return t + "/" + format
+}
+
+// This splits a Go type which is qualified by the full import path of its
+// package, eg, "github.com/shopspring/decimal.Decimal", into the type as
+// it's used in code, "decimal.Decimal", and the import it needs. Builtin
+// types, such as "int64", need no import.
+func parseQualifiedGoType(qualified string) (string, *goImport) {
+	lastSlash := strings.LastIndex(qualified, "/")
+	lastDot := strings.LastIndex(qualified, ".")
+	if lastDot < 0 || lastDot < lastSlash {
+		return qualified, nil
+	}
+
+	importPath := qualified[:lastDot]
+	packageName := packageNameFromPath(importPath)
+	return packageName + qualified[lastDot:], &goImport{
+		lookFor:     regexp.QuoteMeta(packageName) + "\\.",
+		packageName: importPath,
+	}
+}
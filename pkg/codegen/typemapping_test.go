@@ -0,0 +1,82 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQualifiedGoType(t *testing.T) {
+	goType, imp := parseQualifiedGoType("int64")
+	assert.Equal(t, "int64", goType)
+	assert.Nil(t, imp)
+
+	goType, imp = parseQualifiedGoType("time.Duration")
+	assert.Equal(t, "time.Duration", goType)
+	assert.Equal(t, `"time"`, imp.String())
+
+	goType, imp = parseQualifiedGoType("github.com/shopspring/decimal.Decimal")
+	assert.Equal(t, "decimal.Decimal", goType)
+	assert.Equal(t, `"github.com/shopspring/decimal"`, imp.String())
+	assert.Equal(t, `decimal\.`, imp.lookFor)
+
+	goType, imp = parseQualifiedGoType("gopkg.in/yaml.v2.MapSlice")
+	assert.Equal(t, "yaml.MapSlice", goType)
+	assert.Equal(t, `"gopkg.in/yaml.v2"`, imp.String())
+}
+
+const typeMappingDefinition = `
+openapi: 3.0.1
+info:
+  title: Type mapping
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Amounts:
+      type: object
+      properties:
+        count:
+          type: integer
+        small:
+          type: integer
+          format: int32
+        total:
+          type: number
+        price:
+          type: string
+          format: decimal
+        name:
+          type: string
+`
+
+func TestTypeMapping(t *testing.T) {
+	swagger, err := openapi3.NewSwaggerLoader().LoadSwaggerFromData([]byte(typeMappingDefinition))
+	require.NoError(t, err)
+
+	opts := Options{
+		GenerateTypes: true,
+		SkipPrune:     true,
+		TypeMapping: map[string]string{
+			"integer":        "int64",
+			"number":         "float64",
+			"string/decimal": "github.com/shopspring/decimal.Decimal",
+		},
+	}
+	code, err := Generate(swagger, "api", opts)
+	require.NoError(t, err)
+
+	assert.Contains(t, code, `"github.com/shopspring/decimal"`)
+	assert.Regexp(t, `Count +\*int64 +`+"`json:\"count,omitempty\"`", code)
+	assert.Regexp(t, `Small +\*int32 +`+"`json:\"small,omitempty\"`", code)
+	assert.Regexp(t, `Total +\*float64 +`+"`json:\"total,omitempty\"`", code)
+	assert.Regexp(t, `Price +\*decimal\.Decimal +`+"`json:\"price,omitempty\"`", code)
+	assert.Regexp(t, `Name +\*string +`+"`json:\"name,omitempty\"`", code)
+
+	// Only the basic types may be mapped
+	opts.TypeMapping = map[string]string{"object": "map[string]string"}
+	_, err = Generate(swagger, "api", opts)
+	assert.Error(t, err)
+}
@@ -15,6 +15,7 @@ package codegen
 
 import (
 	"fmt"
+	"path"
 	"regexp"
 	"sort"
 	"strings"
@@ -182,6 +183,29 @@ func SortedRequestBodyKeys(dict map[string]*openapi3.RequestBodyRef) []string {
 	return keys
 }
 
+func SortedEncodingKeys(dict map[string]*openapi3.Encoding) []string {
+	keys := make([]string, len(dict))
+	i := 0
+	for key := range dict {
+		keys[i] = key
+		i++
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// This returns the names of the headers of a response in sorted order
+func SortedHeadersKeys(dict map[string]*openapi3.HeaderRef) []string {
+	keys := make([]string, len(dict))
+	i := 0
+	for key := range dict {
+		keys[i] = key
+		i++
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 // This function checks whether the specified string is present in an array
 // of strings
 func StringInArray(str string, array []string) bool {
@@ -197,12 +221,21 @@ func StringInArray(str string, array []string) bool {
 // #/components/schemas/Foo -> Foo
 // #/components/parameters/Bar -> Bar
 // #/components/responses/Baz -> Baz
-// Remote components (document.json#/Foo) are not yet supported
+// Remote components (document.json#/components/schemas/Foo) refer to the type
+// in the Go package which the document is mapped to by Options.ImportMapping,
+// eg, document.Foo.
 // URL components (http://deepmap.com/schemas/document.json#Foo) are not yet
 // supported
 // We only support flat components for now, so no components in a schema under
 // components.
 func RefPathToGoType(refPath string) (string, error) {
+	return defaultGenerator().refPathToGoType(refPath)
+}
+
+func (g *generator) refPathToGoType(refPath string) (string, error) {
+	if !strings.HasPrefix(refPath, "#") {
+		return g.externalRefPathToGoType(refPath)
+	}
 	pathParts := strings.Split(refPath, "/")
 	if pathParts[0] != "#" {
 		return "", errors.New("Only local document components are supported")
@@ -210,7 +243,64 @@ func RefPathToGoType(refPath string) (string, error) {
 	if len(pathParts) != 4 {
 		return "", errors.New("Parameter nesting is deeper than supported")
 	}
-	return SchemaNameToTypeName(pathParts[3]), nil
+	goType := SchemaNameToTypeName(pathParts[3])
+	if pathParts[2] == "schemas" {
+		goType = g.schemaTypeName(pathParts[3])
+	}
+	// While generating a request or response, schemas which have readOnly or
+	// writeOnly properties are referred to by the matching variant.
+	if pathParts[2] == "schemas" && g.readWriteSchemas[goType] {
+		goType += g.readWriteVariant
+	}
+	if pathParts[2] == "schemas" {
+		if packageName, shared := g.sharedSchemas[pathParts[3]]; shared {
+			return packageName + "." + goType, nil
+		}
+	}
+	return g.qualifiedTypeName(goType), nil
+}
+
+// This converts a reference to a component in another document into the Go
+// type in the package the document is mapped to.
+func (g *generator) externalRefPathToGoType(refPath string) (string, error) {
+	refParts := strings.SplitN(refPath, "#", 2)
+	if len(refParts) != 2 {
+		return "", fmt.Errorf("external reference %s must refer to a component of the document", refPath)
+	}
+	packageName, found := g.importMapping[normalizeRefDocument(refParts[0])]
+	if !found {
+		return "", fmt.Errorf("the document of external reference %s isn't mapped to a Go package with an import mapping", refPath)
+	}
+	pathParts := strings.Split(refParts[1], "/")
+	if len(pathParts) != 4 || pathParts[0] != "" || pathParts[1] != "components" {
+		return "", fmt.Errorf("external reference %s must refer to a component of the document", refPath)
+	}
+	typeName := SchemaNameToTypeName(pathParts[3])
+	return packageName + "." + typeName, nil
+}
+
+// Documents may be referred to by equivalent paths, such as "common.yaml" and
+// "./common.yaml", so we clean them up before looking them up.
+func normalizeRefDocument(document string) string {
+	if strings.Contains(document, "://") {
+		return document
+	}
+	return path.Clean(document)
+}
+
+// Qualifies the name of one of the generated types with the package it's
+// in, when the types are generated into a separate package.
+func (g *generator) qualifiedTypeName(typeName string) string {
+	return g.typesPrefix() + typeName
+}
+
+// Returns the prefix of the names of generated types, which is empty, unless
+// they're in a separate package.
+func (g *generator) typesPrefix() string {
+	if g.typesPackage == "" {
+		return ""
+	}
+	return g.typesPackage + "."
 }
 
 // This function converts a swagger style path URI with parameters to a
@@ -243,6 +333,26 @@ func SwaggerUriToChiUri(uri string) string {
 	return pathParamRE.ReplaceAllString(uri, "{$1}")
 }
 
+// This function converts a swagger style path URI with parameters to a
+// gorilla/mux compatible path URI, which, like Chi, uses "{param}" for
+// parameters.
+func SwaggerUriToGorillaUri(uri string) string {
+	return SwaggerUriToChiUri(uri)
+}
+
+// This function converts a swagger style path URI with parameters to a
+// gin compatible path URI, which, like Echo, uses ":param" for parameters.
+func SwaggerUriToGinUri(uri string) string {
+	return SwaggerUriToEchoUri(uri)
+}
+
+// This function converts a swagger style path URI with parameters to the
+// path of a runtime.Route, for the std-http server. The router uses "{param}"
+// for parameters, as Chi does.
+func SwaggerUriToStdHttpUri(uri string) string {
+	return SwaggerUriToChiUri(uri)
+}
+
 // Returns the argument names, in order, in a given URI string, so for
 // /path/{param1}/{.param2*}/{?param3}, it would return param1, param2, param3
 func OrderedParamsFromUri(uri string) []string {
@@ -328,6 +438,49 @@ func SchemaNameToTypeName(name string) string {
 	return name
 }
 
+// This converts a number into a suffix for the name of its enum constant. Signs
+// and decimal points, which ToCamelCase would drop, are spelled out so that
+// eg, -1 and 1 remain distinct.
+func numberToEnumName(literal string) string {
+	literal = strings.Replace(literal, "-", " Minus ", -1)
+	literal = strings.Replace(literal, "+", " Plus ", -1)
+	literal = strings.Replace(literal, ".", " Dot ", -1)
+	return ToCamelCase(literal)
+}
+
+// Returns whether a string can follow the start of an identifier, as it's
+// made up of letters, digits and underscores only.
+func isIdentifierSuffix(str string) bool {
+	if str == "" {
+		return false
+	}
+	for _, r := range str {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns whether the Go type is one of the builtin types which may be used
+// for constants.
+func isBasicGoType(goType string) bool {
+	switch goType {
+	case "string", "bool", "int", "int32", "int64", "float32", "float64":
+		return true
+	}
+	return false
+}
+
+// Returns the Go type name of a component schema, which may be overridden
+// with x-go-name.
+func (g *generator) schemaTypeName(schemaName string) string {
+	if goName, found := g.schemaGoNames[schemaName]; found {
+		return goName
+	}
+	return SchemaNameToTypeName(schemaName)
+}
+
 // According to the spec, additionalProperties may be true, false, or a
 // schema. If not present, true is implied. If it's a schema, true is implied.
 // If it's false, no additional properties are allowed. We're going to act a little
@@ -335,22 +488,25 @@ func SchemaNameToTypeName(name string) string {
 // you must specify an additionalProperties type
 // If additionalProperties it true/false, this field will be non-nil.
 func SchemaHasAdditionalProperties(schema *openapi3.Schema) bool {
-	if schema.AdditionalPropertiesAllowed != nil {
-		return *schema.AdditionalPropertiesAllowed
-	}
+	// An explicit schema always means additional properties are allowed; the
+	// loader may also set AdditionalPropertiesAllowed in that case.
 	if schema.AdditionalProperties != nil {
 		return true
 	}
+	if schema.AdditionalPropertiesAllowed != nil {
+		return *schema.AdditionalPropertiesAllowed
+	}
 	return false
 }
 
 // This converts a path, like Object/field1/nestedField into a go
 // type name.
 func PathToTypeName(path []string) string {
+	parts := make([]string, len(path))
 	for i, p := range path {
-		path[i] = ToCamelCase(p)
+		parts[i] = ToCamelCase(p)
 	}
-	return strings.Join(path, "_")
+	return strings.Join(parts, "_")
 }
 
 // StringToGoComment renders a possible multi-line string as a valid Go-Comment.
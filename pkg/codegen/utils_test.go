@@ -152,6 +152,65 @@ func TestRefPathToGoType(t *testing.T) {
 	assert.Errorf(t, err, "Expected an error on reference depth")
 }
 
+func TestExternalRefPathToGoType(t *testing.T) {
+	g := defaultGenerator()
+	g.importMapping = g.parseImportMapping(map[string]string{
+		"./common.yaml":               "github.com/me/api/common",
+		"http://deepmap.com/doc.json": "github.com/me/api/doc",
+		"other/common.yaml":           "github.com/me/other/common",
+		"shared/errors.yaml":          "github.com/me/api/common",
+	})
+
+	goType, err := g.refPathToGoType("common.yaml#/components/schemas/Error")
+	assert.NoError(t, err)
+	assert.Equal(t, "common.Error", goType)
+
+	goType, err = g.refPathToGoType("./shared/../shared/errors.yaml#/components/schemas/error_code")
+	assert.NoError(t, err)
+	assert.Equal(t, "common.ErrorCode", goType)
+
+	goType, err = g.refPathToGoType("http://deepmap.com/doc.json#/components/parameters/foo_bar")
+	assert.NoError(t, err)
+	assert.Equal(t, "doc.FooBar", goType)
+
+	// Packages with the same name are told apart by an alias
+	goType, err = g.refPathToGoType("other/common.yaml#/components/schemas/Error")
+	assert.NoError(t, err)
+	assert.Equal(t, "common2.Error", goType)
+	assert.Equal(t, "common2", g.goTypeImports["github.com/me/other/common"].alias)
+
+	_, err = g.refPathToGoType("unmapped.yaml#/components/schemas/Error")
+	assert.Error(t, err, "Expected an error on an unmapped document")
+
+	_, err = g.refPathToGoType("common.yaml#/definitions/Error")
+	assert.Error(t, err, "Expected an error on a reference which isn't to a component")
+}
+
+func TestGenerateDiscriminatorMapping(t *testing.T) {
+	g := defaultGenerator()
+	g.importMapping = g.parseImportMapping(map[string]string{
+		"other.yaml": "github.com/me/api/other",
+	})
+	elements := []*openapi3.SchemaRef{
+		{Ref: "#/components/schemas/Cat"},
+		{Ref: "other.yaml#/components/schemas/Dog"},
+		{Ref: "#/components/schemas/Cow"},
+	}
+	d, err := g.generateDiscriminator(&openapi3.Discriminator{
+		PropertyName: "kind",
+		Mapping: map[string]string{
+			"cat": "Cat",
+			"dog": "other.yaml#/components/schemas/Dog",
+		},
+	}, elements)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"cat": "Cat",
+		"dog": "other.Dog",
+		"Cow": "Cow",
+	}, d.Mapping)
+}
+
 func TestSwaggerUriToEchoUri(t *testing.T) {
 	assert.Equal(t, "/path", SwaggerUriToEchoUri("/path"))
 	assert.Equal(t, "/path/:arg", SwaggerUriToEchoUri("/path/{arg}"))
@@ -169,6 +228,36 @@ func TestSwaggerUriToEchoUri(t *testing.T) {
 	assert.Equal(t, "/path/:arg/foo", SwaggerUriToEchoUri("/path/{?arg*}/foo"))
 }
 
+func TestSwaggerUriToGorillaUri(t *testing.T) {
+	assert.Equal(t, "/path", SwaggerUriToGorillaUri("/path"))
+	assert.Equal(t, "/path/{arg}", SwaggerUriToGorillaUri("/path/{arg}"))
+	assert.Equal(t, "/path/{arg1}/{arg2}/foo", SwaggerUriToGorillaUri("/path/{arg1}/{arg2}/foo"))
+
+	// Make sure all the exploded and alternate formats match too
+	assert.Equal(t, "/path/{arg}/foo", SwaggerUriToGorillaUri("/path/{arg*}/foo"))
+	assert.Equal(t, "/path/{arg}/foo", SwaggerUriToGorillaUri("/path/{.arg}/foo"))
+	assert.Equal(t, "/path/{arg}/foo", SwaggerUriToGorillaUri("/path/{.arg*}/foo"))
+	assert.Equal(t, "/path/{arg}/foo", SwaggerUriToGorillaUri("/path/{;arg}/foo"))
+	assert.Equal(t, "/path/{arg}/foo", SwaggerUriToGorillaUri("/path/{;arg*}/foo"))
+	assert.Equal(t, "/path/{arg}/foo", SwaggerUriToGorillaUri("/path/{?arg}/foo"))
+	assert.Equal(t, "/path/{arg}/foo", SwaggerUriToGorillaUri("/path/{?arg*}/foo"))
+}
+
+func TestSwaggerUriToGinUri(t *testing.T) {
+	assert.Equal(t, "/path", SwaggerUriToGinUri("/path"))
+	assert.Equal(t, "/path/:arg1/:arg2/foo", SwaggerUriToGinUri("/path/{arg1}/{arg2}/foo"))
+	assert.Equal(t, "/path/:arg/foo", SwaggerUriToGinUri("/path/{.arg*}/foo"))
+	assert.Equal(t, "/path/:arg/foo", SwaggerUriToGinUri("/path/{;arg}/foo"))
+}
+
+func TestSwaggerUriToStdHttpUri(t *testing.T) {
+	assert.Equal(t, "/path", SwaggerUriToStdHttpUri("/path"))
+	assert.Equal(t, "/path/{arg1}/{arg2}/foo", SwaggerUriToStdHttpUri("/path/{arg1}/{arg2}/foo"))
+	assert.Equal(t, "/path/{arg}/foo", SwaggerUriToStdHttpUri("/path/{.arg*}/foo"))
+	assert.Equal(t, "/path/{arg}/foo", SwaggerUriToStdHttpUri("/path/{;arg}/foo"))
+	assert.Equal(t, "/path/{arg}/foo", SwaggerUriToStdHttpUri("/path/{?arg*}/foo"))
+}
+
 func TestOrderedParamsFromUri(t *testing.T) {
 	result := OrderedParamsFromUri("/path/{param1}/{.param2}/{;param3*}/foo")
 	assert.EqualValues(t, []string{"param1", "param2", "param3"}, result)
@@ -0,0 +1,257 @@
+package codegen
+
+import (
+	"fmt"
+	"go/token"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// Constraints holds the validation keywords of a schema which are checked by
+// the generated Validate methods.
+type Constraints struct {
+	MinLength    uint64
+	MaxLength    *uint64
+	Pattern      string
+	Minimum      *float64
+	Maximum      *float64
+	ExclusiveMin bool
+	ExclusiveMax bool
+	MultipleOf   *float64
+	MinItems     uint64
+	MaxItems     *uint64
+	UniqueItems  bool
+}
+
+// This reads the constraints of a schema. Patterns are checked here, since
+// the generated code compiles them with the regexp package, which doesn't
+// support all of the ECMA 262 syntax allowed by OpenAPI.
+func schemaConstraints(schema *openapi3.Schema) (Constraints, error) {
+	if schema.Pattern != "" {
+		if _, err := regexp.Compile(schema.Pattern); err != nil {
+			return Constraints{}, fmt.Errorf("pattern '%s' isn't supported by Go regular expressions: %s", schema.Pattern, err)
+		}
+	}
+	return Constraints{
+		MinLength:    schema.MinLength,
+		MaxLength:    schema.MaxLength,
+		Pattern:      schema.Pattern,
+		Minimum:      schema.Min,
+		Maximum:      schema.Max,
+		ExclusiveMin: schema.ExclusiveMin,
+		ExclusiveMax: schema.ExclusiveMax,
+		MultipleOf:   schema.MultipleOf,
+		MinItems:     schema.MinItems,
+		MaxItems:     schema.MaxItems,
+		UniqueItems:  schema.UniqueItems,
+	}, nil
+}
+
+// Returns the body of the Validate method of a type, which records any errors
+// in a runtime.ValidationErrors named errs. It's empty when there's nothing to
+// check.
+func (g *generator) validationCode(t TypeDefinition) string {
+	s := t.Schema
+	var code strings.Builder
+	if len(s.EnumValues) != 0 {
+		// Enum types have a method to check their values, so the value
+		// needn't be checked against them again.
+		code.WriteString("if !t.Valid() {\nerrs.Add(\"\", \"must be one of the values of the enum\")\n}\n")
+		s.EnumValues = nil
+	}
+	if len(s.UnionElements) != 0 {
+		// The elements of a union can only be told apart by its
+		// discriminator, so unions without one aren't checked.
+		if s.Discriminator != nil {
+			code.WriteString("if v, err := t.ValueByDiscriminator(); err != nil {\nerrs.Add(\"\", err.Error())\n} else {\nerrs.Append(\"\", runtime.ValidateValue(v))\n}\n")
+		}
+		return code.String()
+	}
+	// Types defined from a basic type, or another of our types, are
+	// converted back to it, so that we can check the value with its
+	// constraints, or call its Validate method.
+	expr := "t"
+	if isBasicGoType(s.GoType) || g.isGeneratedTypeName(s.GoType) {
+		expr = s.GoType + "(t)"
+	}
+	code.WriteString(g.validateValue(s, expr, `""`, 0))
+	return code.String()
+}
+
+// This generates the checks of a value of the given schema, where expr is
+// the Go expression for the value, and path is the Go expression for its
+// field path. Depth is used to give unique names to loop variables.
+func (g *generator) validateValue(s Schema, expr string, path string, depth int) string {
+	// Our own types validate themselves, although aliases of types from
+	// elsewhere, and types generated without validation, may not, so we
+	// leave it to runtime.ValidateValue to check.
+	if s.RefType != "" || g.isGeneratedTypeName(s.GoType) {
+		return fmt.Sprintf("errs.Append(%s, runtime.ValidateValue(%s))\n", path, expr)
+	}
+
+	var code strings.Builder
+	if len(s.EnumValues) != 0 {
+		// Inline enums, such as those of parameters, which don't have a
+		// type of their own, are checked against their values here.
+		values := make([]string, len(s.EnumValues))
+		for i, v := range s.EnumValues {
+			values[i] = v.Value
+		}
+		fmt.Fprintf(&code, "switch %s {\ncase %s:\ndefault:\nerrs.Add(%s, \"must be one of the values of the enum\")\n}\n",
+			expr, strings.Join(values, ", "), path)
+	}
+	c := s.Constraints
+	switch {
+	case s.GoType == "string":
+		if c.MinLength != 0 {
+			fmt.Fprintf(&code, "errs.MinLength(%s, %s, %d)\n", path, expr, c.MinLength)
+		}
+		if c.MaxLength != nil {
+			fmt.Fprintf(&code, "errs.MaxLength(%s, %s, %d)\n", path, expr, *c.MaxLength)
+		}
+		if c.Pattern != "" {
+			fmt.Fprintf(&code, "errs.Pattern(%s, %s, %s)\n", path, expr, strconv.Quote(c.Pattern))
+		}
+	case isNumericGoType(s.GoType):
+		if c.Minimum != nil {
+			fmt.Fprintf(&code, "errs.Minimum(%s, float64(%s), %s, %t)\n", path, expr, formatFloat(*c.Minimum), c.ExclusiveMin)
+		}
+		if c.Maximum != nil {
+			fmt.Fprintf(&code, "errs.Maximum(%s, float64(%s), %s, %t)\n", path, expr, formatFloat(*c.Maximum), c.ExclusiveMax)
+		}
+		if c.MultipleOf != nil {
+			fmt.Fprintf(&code, "errs.MultipleOf(%s, float64(%s), %s)\n", path, expr, formatFloat(*c.MultipleOf))
+		}
+	case s.ArrayItems != nil:
+		if c.MinItems != 0 {
+			fmt.Fprintf(&code, "errs.MinItems(%s, len(%s), %d)\n", path, expr, c.MinItems)
+		}
+		if c.MaxItems != nil {
+			fmt.Fprintf(&code, "errs.MaxItems(%s, len(%s), %d)\n", path, expr, *c.MaxItems)
+		}
+		if c.UniqueItems {
+			fmt.Fprintf(&code, "errs.UniqueItems(%s, %s)\n", path, expr)
+		}
+		index := fmt.Sprintf("i%d", depth)
+		item := fmt.Sprintf("item%d", depth)
+		itemCode := g.validateValue(*s.ArrayItems, item, fmt.Sprintf("runtime.IndexPath(%s, %s)", path, index), depth+1)
+		if itemCode != "" {
+			fmt.Fprintf(&code, "for %s, %s := range %s {\n%s}\n", index, item, expr, itemCode)
+		}
+	default:
+		// Selectors dereference pointers for us
+		expr = strings.TrimPrefix(expr, "*")
+		for _, p := range s.Properties {
+			code.WriteString(g.validateProperty(p, expr+"."+p.GoFieldName(), joinPathExpr(path, p.JsonFieldName), depth))
+		}
+		if s.HasAdditionalProperties && s.AdditionalPropertiesType != nil {
+			key := fmt.Sprintf("k%d", depth)
+			value := fmt.Sprintf("v%d", depth)
+			valueCode := g.validateValue(*s.AdditionalPropertiesType, value, fmt.Sprintf("runtime.JoinPath(%s, %s)", path, key), depth+1)
+			if valueCode != "" {
+				fmt.Fprintf(&code, "for %s, %s := range %s.AdditionalProperties {\n%s}\n", key, value, expr, valueCode)
+			}
+		}
+	}
+	return code.String()
+}
+
+// This generates the checks of a property, which is either present in the
+// struct as its value, or may be missing, when it's a pointer, or Nullable.
+// Required properties are only reported as missing when their Go type can
+// tell, so the zero value of a required string or number, for instance, is
+// taken to have been given.
+func (g *generator) validateProperty(p Property, expr string, path string, depth int) string {
+	s := p.Schema
+	if s.NullableOf != nil {
+		var code strings.Builder
+		if p.Required {
+			fmt.Fprintf(&code, "if !%s.IsSpecified() {\nerrs.Required(%s)\n}\n", expr, path)
+		}
+		value := fmt.Sprintf("n%d", depth)
+		valueCode := g.validateValue(*s.NullableOf, value, path, depth+1)
+		if valueCode != "" {
+			fmt.Fprintf(&code, "if %s, err := %s.Get(); err == nil {\n%s}\n", value, expr, valueCode)
+		}
+		return code.String()
+	}
+
+	if !p.Required && !s.SkipOptionalPointer {
+		valueCode := g.validateValue(s, "*"+expr, path, depth)
+		if valueCode == "" {
+			return ""
+		}
+		return fmt.Sprintf("if %s != nil {\n%s}\n", expr, valueCode)
+	}
+
+	valueCode := g.validateValue(s, expr, path, depth)
+	if p.Required && isNilableGoType(s.TypeDecl()) {
+		if valueCode == "" {
+			return fmt.Sprintf("if %s == nil {\nerrs.Required(%s)\n}\n", expr, path)
+		}
+		return fmt.Sprintf("if %s == nil {\nerrs.Required(%s)\n} else {\n%s}\n", expr, path, valueCode)
+	}
+	return valueCode
+}
+
+// This appends a field name to the Go expression for a field path, doing it
+// at generation time when the path is a constant.
+func joinPathExpr(path string, field string) string {
+	if prefix, err := strconv.Unquote(path); err == nil {
+		if prefix == "" {
+			return strconv.Quote(field)
+		}
+		return strconv.Quote(prefix + "." + field)
+	}
+	return fmt.Sprintf("runtime.JoinPath(%s, %s)", path, strconv.Quote(field))
+}
+
+// Returns whether the Go type is one of the types we generate, either in
+// this package, or in the types package, or the shared package, or in one of
+// the packages which external references are mapped to, as opposed to a basic
+// type, or a type from some other package.
+func (g *generator) isGeneratedTypeName(goType string) bool {
+	if token.IsIdentifier(goType) {
+		return !isBasicGoType(goType)
+	}
+	parts := strings.Split(goType, ".")
+	if len(parts) != 2 || !token.IsIdentifier(parts[1]) {
+		return false
+	}
+	if g.typesPackage != "" && parts[0] == g.typesPackage {
+		return true
+	}
+	for _, packageName := range g.importMapping {
+		if parts[0] == packageName {
+			return true
+		}
+	}
+	for _, packageName := range g.sharedSchemas {
+		if parts[0] == packageName {
+			return true
+		}
+	}
+	return false
+}
+
+func isNumericGoType(goType string) bool {
+	switch goType {
+	case "int", "int32", "int64", "float32", "float64":
+		return true
+	}
+	return false
+}
+
+// Returns whether a missing value of the Go type is nil, so that we can tell
+// whether a required property was given.
+func isNilableGoType(goType string) bool {
+	return strings.HasPrefix(goType, "[]") || strings.HasPrefix(goType, "map[") ||
+		goType == "interface{}" || goType == "json.RawMessage"
+}
+
+func formatFloat(n float64) string {
+	return strconv.FormatFloat(n, 'g', -1, 64)
+}
@@ -0,0 +1,53 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchemaConstraints(t *testing.T) {
+	maxLength := uint64(5)
+	constraints, err := schemaConstraints(&openapi3.Schema{MinLength: 1, MaxLength: &maxLength, Pattern: "^[a-z]+$"})
+	assert.NoError(t, err)
+	assert.Equal(t, Constraints{MinLength: 1, MaxLength: &maxLength, Pattern: "^[a-z]+$"}, constraints)
+
+	// Go's regular expressions don't support lookaheads
+	_, err = schemaConstraints(&openapi3.Schema{Pattern: "^(?=a)"})
+	assert.Error(t, err)
+}
+
+func TestJoinPathExpr(t *testing.T) {
+	assert.Equal(t, `"name"`, joinPathExpr(`""`, "name"))
+	assert.Equal(t, `"owner.name"`, joinPathExpr(`"owner"`, "name"))
+	assert.Equal(t, `runtime.JoinPath(runtime.IndexPath("pets", i0), "name")`,
+		joinPathExpr(`runtime.IndexPath("pets", i0)`, "name"))
+}
+
+func TestValidationSkipsInterfaces(t *testing.T) {
+	const spec = `
+openapi: "3.0.1"
+info:
+  version: 1.0.0
+  title: Anything
+paths: {}
+components:
+  schemas:
+    Anything:
+      description: Any value at all
+    Name:
+      type: string
+      minLength: 1
+`
+	swagger, err := openapi3.NewSwaggerLoader().LoadSwaggerFromData([]byte(spec))
+	assert.NoError(t, err)
+
+	code, err := Generate(swagger, "anything", Options{GenerateTypes: true, GenerateValidation: true, SkipPrune: true})
+	assert.NoError(t, err)
+
+	// An interface can't have methods, so it has no Validate
+	assert.Contains(t, code, "type Anything interface{}")
+	assert.NotContains(t, code, "func (Anything) Validate() error")
+	assert.Contains(t, code, "func (t Name) Validate() error")
+}
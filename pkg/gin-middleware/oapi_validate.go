@@ -0,0 +1,205 @@
+// Copyright 2019 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ginmiddleware
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/gin-gonic/gin"
+)
+
+const GinContextKey = "oapi-codegen/gin-context"
+const UserDataKey = "oapi-codegen/user-data"
+
+// This is a gin middleware function which validates incoming HTTP requests
+// to make sure that they conform to the given OAPI 3.0 specification. When
+// OAPI validation fails on the request, we abort it with an HTTP/400, in the
+// same way as the Echo middleware in pkg/middleware.
+
+// Create validator middleware from a YAML file path
+func OapiValidatorFromYamlFile(path string) (gin.HandlerFunc, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s: %s", path, err)
+	}
+
+	swagger, err := openapi3.NewSwaggerLoader().LoadSwaggerFromData(data)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %s as Swagger YAML: %s",
+			path, err)
+	}
+	return OapiRequestValidator(swagger), nil
+}
+
+// Create a validator from a swagger object.
+func OapiRequestValidator(swagger *openapi3.Swagger) gin.HandlerFunc {
+	return OapiRequestValidatorWithOptions(swagger, nil)
+}
+
+// Options to customize request validation. These are passed through to
+// openapi3filter. ErrorHandler responds to the requests which fail
+// validation; by default, they're aborted with a JSON body holding the
+// message, as in {"message": "..."}.
+type Options struct {
+	Options      openapi3filter.Options
+	ParamDecoder openapi3filter.ContentParameterDecoder
+	UserData     interface{}
+	ErrorHandler func(c *gin.Context, err *ValidationError)
+}
+
+// ValidationError is the reason a request failed validation, along with the
+// HTTP status code which it's rejected with.
+type ValidationError struct {
+	Code     int
+	Message  string
+	Internal error
+}
+
+func (e *ValidationError) Error() string {
+	return e.Message
+}
+
+// Create a validator from a swagger object, with validation options
+func OapiRequestValidatorWithOptions(swagger *openapi3.Swagger, options *Options) gin.HandlerFunc {
+	router := openapi3filter.NewRouter().WithSwagger(swagger)
+	return func(c *gin.Context) {
+		err := ValidateRequestFromContext(c, router, options)
+		if err != nil {
+			validationErr, ok := err.(*ValidationError)
+			if !ok {
+				validationErr = &ValidationError{
+					Code:     http.StatusInternalServerError,
+					Message:  err.Error(),
+					Internal: err,
+				}
+			}
+			if options != nil && options.ErrorHandler != nil {
+				options.ErrorHandler(c, validationErr)
+			} else {
+				c.AbortWithStatusJSON(validationErr.Code, gin.H{"message": validationErr.Message})
+			}
+			// In case the error handler didn't abort the request itself
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+// This function is called from the middleware above and actually does the work
+// of validating a request. The errors it returns are *ValidationError.
+func ValidateRequestFromContext(c *gin.Context, router *openapi3filter.Router, options *Options) error {
+	req := c.Request
+	route, pathParams, err := router.FindRoute(req.Method, req.URL)
+
+	// We failed to find a matching route for the request.
+	if err != nil {
+		switch e := err.(type) {
+		case *openapi3filter.RouteError:
+			// We've got a bad request, the path requested doesn't match
+			// either server, or path, or something.
+			return &ValidationError{
+				Code:     http.StatusBadRequest,
+				Message:  e.Reason,
+				Internal: err,
+			}
+		default:
+			// This should never happen today, but if our upstream code changes,
+			// we don't want to crash the server, so handle the unexpected error.
+			return &ValidationError{
+				Code:     http.StatusInternalServerError,
+				Message:  fmt.Sprintf("error validating route: %s", err.Error()),
+				Internal: err,
+			}
+		}
+	}
+
+	validationInput := &openapi3filter.RequestValidationInput{
+		Request:    req,
+		PathParams: pathParams,
+		Route:      route,
+	}
+
+	// Pass the gin context into the request validator, so that any callbacks
+	// which it invokes make it available.
+	requestContext := context.WithValue(context.Background(), GinContextKey, c)
+
+	if options != nil {
+		validationInput.Options = &options.Options
+		validationInput.ParamDecoder = options.ParamDecoder
+		requestContext = context.WithValue(requestContext, UserDataKey, options.UserData)
+	}
+
+	err = openapi3filter.ValidateRequest(requestContext, validationInput)
+	if err != nil {
+		switch e := err.(type) {
+		case *openapi3filter.RequestError:
+			// We've got a bad request
+			// Split up the verbose error by lines and return the first one
+			// openapi errors seem to be multi-line with a decent message on the first
+			errorLines := strings.Split(e.Error(), "\n")
+			return &ValidationError{
+				Code:     http.StatusBadRequest,
+				Message:  errorLines[0],
+				Internal: err,
+			}
+		case *openapi3filter.SecurityRequirementsError:
+			for _, err := range e.Errors {
+				validationErr, ok := err.(*ValidationError)
+				if ok {
+					return validationErr
+				}
+			}
+			return &ValidationError{
+				Code:     http.StatusForbidden,
+				Message:  e.Error(),
+				Internal: err,
+			}
+		default:
+			// This should never happen today, but if our upstream code changes,
+			// we don't want to crash the server, so handle the unexpected error.
+			return &ValidationError{
+				Code:     http.StatusInternalServerError,
+				Message:  fmt.Sprintf("error validating request: %s", err),
+				Internal: err,
+			}
+		}
+	}
+	return nil
+}
+
+// Helper function to get the gin context from within requests. It returns
+// nil if not found or wrong type.
+func GetGinContext(c context.Context) *gin.Context {
+	iface := c.Value(GinContextKey)
+	if iface == nil {
+		return nil
+	}
+	ginCtx, ok := iface.(*gin.Context)
+	if !ok {
+		return nil
+	}
+	return ginCtx
+}
+
+func GetUserData(c context.Context) interface{} {
+	return c.Value(UserDataKey)
+}
@@ -0,0 +1,675 @@
+// Copyright 2019 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"encoding"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/textproto"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+)
+
+// MultipartMemory is the most memory which a multipart form is parsed into,
+// with the rest of its files stored on disk, the same as in net/http.
+const MultipartMemory = 32 << 20
+
+var fileType = reflect.TypeOf(types.File{})
+
+// FormEncoding is how a property of a form body is encoded, from the encoding
+// object of its media type in the spec. Style and Explode only apply to
+// application/x-www-form-urlencoded bodies, where a Style of "" leaves arrays
+// as repeated values, and objects in the deepObject style. ContentType only
+// applies to multipart/form-data bodies, as the content type of the part.
+type FormEncoding struct {
+	ContentType string
+	Style       string
+	Explode     bool
+}
+
+// BindForm binds the values of an application/x-www-form-urlencoded body to
+// the fields of the struct which ptr points to, by their json names. Slices
+// are bound from repeated values, and objects from values in the deepObject
+// style, such as address[city]=Paris. Fields which the form doesn't have are
+// left alone.
+func BindForm(ptr interface{}, form url.Values) error {
+	return BindFormWithEncoding(ptr, form, nil)
+}
+
+// BindFormWithEncoding is BindForm, with the properties which have an
+// encoding bound in its style.
+func BindFormWithEncoding(ptr interface{}, form url.Values, encodings map[string]FormEncoding) error {
+	return formBinder{values: form, encodings: encodings}.bind(ptr)
+}
+
+// BindMultipart binds a parsed multipart/form-data body to the struct which
+// ptr points to, in the same way as BindForm, except that objects, and the
+// properties with a JSON content type, are decoded from JSON parts, and fields
+// of type types.File from the files of the form.
+func BindMultipart(ptr interface{}, form *multipart.Form) error {
+	return BindMultipartWithEncoding(ptr, form, nil)
+}
+
+// BindMultipartWithEncoding is BindMultipart, with the content types of the
+// properties taken from their encodings.
+func BindMultipartWithEncoding(ptr interface{}, form *multipart.Form, encodings map[string]FormEncoding) error {
+	return formBinder{values: form.Value, files: form.File, encodings: encodings, multipart: true}.bind(ptr)
+}
+
+// A formBinder binds the values and files of a form to a struct.
+type formBinder struct {
+	values    url.Values
+	files     map[string][]*multipart.FileHeader
+	encodings map[string]FormEncoding
+	multipart bool
+}
+
+func (b formBinder) bind(ptr interface{}) error {
+	v := reflect.Indirect(reflect.ValueOf(ptr))
+	if v.Kind() != reflect.Struct {
+		return fmt.Errorf("form bodies must be bound to structs, not %s", v.Type())
+	}
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := v.Field(i)
+		name, _ := formFieldName(t.Field(i))
+		if name == "" || !field.CanSet() {
+			continue
+		}
+		if err := b.bindField(field, name); err != nil {
+			return fmt.Errorf("error binding form field '%s': %s", name, err)
+		}
+	}
+	return nil
+}
+
+func (b formBinder) bindField(field reflect.Value, name string) error {
+	t := field.Type()
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	encoding := b.encodings[name]
+	styled := !b.multipart && encoding.Style != ""
+	values := b.values[name]
+
+	// The value is bound to a new one, which only replaces the field once
+	// it's been bound successfully.
+	value := reflect.New(t)
+	switch {
+	case t == fileType:
+		if len(b.files[name]) == 0 {
+			return nil
+		}
+		value.Interface().(*types.File).InitFromMultipart(b.files[name][0])
+	case t.Kind() == reflect.Slice && t.Elem() == fileType:
+		if len(b.files[name]) == 0 {
+			return nil
+		}
+		fileSlice := reflect.MakeSlice(t, len(b.files[name]), len(b.files[name]))
+		for i, header := range b.files[name] {
+			fileSlice.Index(i).Addr().Interface().(*types.File).InitFromMultipart(header)
+		}
+		value.Elem().Set(fileSlice)
+	case b.multipart && (isJSONContentType(encoding.ContentType) || isFormObject(t)):
+		if len(values) == 0 {
+			return nil
+		}
+		if err := json.Unmarshal([]byte(values[0]), value.Interface()); err != nil {
+			return err
+		}
+	case b.multipart && t.Kind() == reflect.Slice && isFormObject(indirectType(t.Elem())):
+		if len(values) == 0 {
+			return nil
+		}
+		elems := reflect.MakeSlice(t, len(values), len(values))
+		for i, elemValue := range values {
+			if err := json.Unmarshal([]byte(elemValue), elems.Index(i).Addr().Interface()); err != nil {
+				return err
+			}
+		}
+		value.Elem().Set(elems)
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8:
+		if len(values) == 0 {
+			return nil
+		}
+		data, err := base64.StdEncoding.DecodeString(values[0])
+		if err != nil {
+			return err
+		}
+		value.Elem().SetBytes(data)
+	case t.Kind() == reflect.Slice:
+		if len(values) == 0 {
+			return nil
+		}
+		if styled && !encoding.Explode {
+			if len(values) != 1 {
+				return fmt.Errorf("expected one value, got %d", len(values))
+			}
+			values = strings.Split(values[0], styleSeparator(encoding.Style))
+		}
+		if err := bindSplitPartsToDestinationArray(values, value.Interface()); err != nil {
+			return err
+		}
+	case isFormObject(t):
+		deepValues := b.values
+		if styled && encoding.Style != "deepObject" {
+			var err error
+			deepValues, err = styledObjectValues(t, name, encoding, b.values)
+			if err != nil {
+				return err
+			}
+		}
+		if !hasDeepObjectValues(deepValues, name) {
+			return nil
+		}
+		if err := UnmarshalDeepObject(value.Interface(), name, deepValues); err != nil {
+			return err
+		}
+	default:
+		if len(values) == 0 {
+			return nil
+		}
+		if len(values) != 1 {
+			return fmt.Errorf("expected one value, got %d", len(values))
+		}
+		if err := BindStringToObject(values[0], value.Interface()); err != nil {
+			return err
+		}
+	}
+
+	if field.Kind() == reflect.Ptr {
+		field.Set(value)
+	} else {
+		field.Set(value.Elem())
+	}
+	return nil
+}
+
+// This turns the values of an object in the form style into the deepObject
+// style, which it's bound from. Exploded, its fields are values of their own,
+// such as city=Paris&zip=75001, and otherwise, they're a single value, such
+// as address=city,Paris,zip,75001.
+func styledObjectValues(t reflect.Type, name string, encoding FormEncoding, values url.Values) (url.Values, error) {
+	deepValues := make(url.Values)
+	if encoding.Explode {
+		if t.Kind() != reflect.Struct {
+			return nil, fmt.Errorf("exploded objects in the %s style must be structs", encoding.Style)
+		}
+		for i := 0; i < t.NumField(); i++ {
+			fieldName, _ := formFieldName(t.Field(i))
+			if fieldValues, found := values[fieldName]; found && fieldName != "" {
+				deepValues[name+"["+fieldName+"]"] = fieldValues
+			}
+		}
+		return deepValues, nil
+	}
+
+	if len(values[name]) == 0 {
+		return deepValues, nil
+	}
+	parts := strings.Split(values[name][0], styleSeparator(encoding.Style))
+	if len(parts)%2 != 0 {
+		return nil, fmt.Errorf("expected pairs of field names and values, got %d parts", len(parts))
+	}
+	for i := 0; i < len(parts); i += 2 {
+		deepValues.Add(name+"["+parts[i]+"]", parts[i+1])
+	}
+	return deepValues, nil
+}
+
+// This returns what separates the values of unexploded arrays and objects in
+// a style.
+func styleSeparator(style string) string {
+	switch style {
+	case "spaceDelimited":
+		return " "
+	case "pipeDelimited":
+		return "|"
+	default:
+		return ","
+	}
+}
+
+// This tells whether values of the type are objects, which are sent in the
+// deepObject style, rather than as strings.
+func isFormObject(t reflect.Type) bool {
+	if t == fileType || t == reflect.TypeOf(time.Time{}) || t == reflect.TypeOf(types.Date{}) {
+		return false
+	}
+	if reflect.PtrTo(t).Implements(reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()) {
+		return false
+	}
+	return t.Kind() == reflect.Struct || t.Kind() == reflect.Map
+}
+
+func indirectType(t reflect.Type) reflect.Type {
+	if t.Kind() == reflect.Ptr {
+		return t.Elem()
+	}
+	return t
+}
+
+// This tells whether a content type of an encoding is JSON, such as
+// application/json, or application/problem+json. Encodings may list more
+// than one, in which case the first is used.
+func isJSONContentType(contentType string) bool {
+	mediaType := partContentType(contentType)
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// This returns the content type of a part from that of an encoding, which is
+// the first which it lists, unless it has a wildcard, such as image/*.
+func partContentType(contentType string) string {
+	mediaType := strings.TrimSpace(strings.Split(contentType, ",")[0])
+	if strings.Contains(mediaType, "*") {
+		return ""
+	}
+	return mediaType
+}
+
+func hasDeepObjectValues(form url.Values, name string) bool {
+	for key := range form {
+		if strings.HasPrefix(key, name+"[") {
+			return true
+		}
+	}
+	return false
+}
+
+// This returns the name of a struct field in a form, which is its json name,
+// and whether it's omitted when empty. Fields which aren't in the JSON of the
+// struct, such as additional properties, aren't in the form either, so they
+// have no name.
+func formFieldName(f reflect.StructField) (string, bool) {
+	if f.PkgPath != "" {
+		return "", false
+	}
+	tag := f.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+	parts := strings.Split(tag, ",")
+	name := parts[0]
+	if name == "" {
+		name = f.Name
+	}
+	omitEmpty := false
+	for _, option := range parts[1:] {
+		if option == "omitempty" {
+			omitEmpty = true
+		}
+	}
+	return name, omitEmpty
+}
+
+// A formValue is a name and value of a form, or a file, when file is set. In
+// multipart forms, contentType is the content type of its part.
+type formValue struct {
+	name        string
+	value       string
+	file        *types.File
+	contentType string
+}
+
+// MarshalForm returns the values of the fields of the struct v, by their
+// json names, for an application/x-www-form-urlencoded body. Slices become
+// repeated values, and objects are written in the deepObject style, the way
+// BindForm reads them.
+func MarshalForm(v interface{}) (url.Values, error) {
+	return MarshalFormWithEncoding(v, nil)
+}
+
+// MarshalFormWithEncoding is MarshalForm, with the properties which have an
+// encoding written in its style.
+func MarshalFormWithEncoding(v interface{}, encodings map[string]FormEncoding) (url.Values, error) {
+	formValues, err := marshalFormValues(v, encodings, false)
+	if err != nil {
+		return nil, err
+	}
+	values := make(url.Values)
+	for _, fv := range formValues {
+		if fv.file != nil {
+			return nil, fmt.Errorf("form field '%s' is a file, which needs a multipart form", fv.name)
+		}
+		values.Add(fv.name, fv.value)
+	}
+	return values, nil
+}
+
+// MarshalMultipart writes the fields of the struct v to a multipart/form-data
+// body, the way BindMultipart reads them, with the fields of type types.File
+// as files. Closing the writer is left to the caller.
+func MarshalMultipart(v interface{}, w *multipart.Writer) error {
+	return MarshalMultipartWithEncoding(v, w, nil)
+}
+
+// MarshalMultipartWithEncoding is MarshalMultipart, with the content types of
+// the parts of the properties taken from their encodings.
+func MarshalMultipartWithEncoding(v interface{}, w *multipart.Writer, encodings map[string]FormEncoding) error {
+	formValues, err := marshalFormValues(v, encodings, true)
+	if err != nil {
+		return err
+	}
+	for _, fv := range formValues {
+		part, err := createPart(w, fv)
+		if err != nil {
+			return err
+		}
+		if fv.file == nil {
+			_, err = io.WriteString(part, fv.value)
+		} else {
+			err = copyFile(part, *fv.file)
+		}
+		if err != nil {
+			return fmt.Errorf("error writing form field '%s': %s", fv.name, err)
+		}
+	}
+	return nil
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// This creates the part of a form value, as multipart.Writer does for fields
+// and files, but with the content type of the value, where it has one.
+func createPart(w *multipart.Writer, fv formValue) (io.Writer, error) {
+	header := make(textproto.MIMEHeader)
+	contentType := fv.contentType
+	if fv.file == nil {
+		header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"`, quoteEscaper.Replace(fv.name)))
+	} else {
+		filename := fv.file.Filename()
+		if filename == "" {
+			filename = fv.name
+		}
+		header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`,
+			quoteEscaper.Replace(fv.name), quoteEscaper.Replace(filename)))
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+	}
+	if contentType != "" {
+		header.Set("Content-Type", contentType)
+	}
+	return w.CreatePart(header)
+}
+
+func copyFile(w io.Writer, file types.File) error {
+	r, err := file.Reader()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	_, err = io.Copy(w, r)
+	return err
+}
+
+func marshalFormValues(v interface{}, encodings map[string]FormEncoding, multipart bool) ([]formValue, error) {
+	sv := reflect.Indirect(reflect.ValueOf(v))
+	if sv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("form bodies must be marshaled from structs, not %s", sv.Type())
+	}
+	var formValues []formValue
+	t := sv.Type()
+	for i := 0; i < t.NumField(); i++ {
+		name, omitEmpty := formFieldName(t.Field(i))
+		if name == "" {
+			continue
+		}
+		field := sv.Field(i)
+		if omitEmpty && isEmptyValue(field) {
+			continue
+		}
+		var fieldValues []formValue
+		var err error
+		encoding := encodings[name]
+		switch {
+		case multipart:
+			fieldValues, err = multipartValues(name, field, encoding)
+		case encoding.Style != "":
+			fieldValues, err = styledFormValues(name, field, encoding)
+		default:
+			fieldValues, err = appendFormValues(nil, name, field)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling form field '%s': %s", name, err)
+		}
+		formValues = append(formValues, fieldValues...)
+	}
+	return formValues, nil
+}
+
+// This returns the parts of a property of a multipart form. Objects, and the
+// properties with a JSON content type, are sent as JSON, and everything else
+// as it is in other forms.
+func multipartValues(name string, v reflect.Value, encoding FormEncoding) ([]formValue, error) {
+	v = indirectValue(v)
+	if !v.IsValid() {
+		return nil, nil
+	}
+	t := v.Type()
+	contentType := partContentType(encoding.ContentType)
+	jsonContentType := contentType
+	if jsonContentType == "" {
+		jsonContentType = "application/json"
+	}
+
+	switch {
+	case t != fileType && (isJSONContentType(contentType) || isFormObject(t)):
+		fv, err := jsonFormValue(name, jsonContentType, v)
+		if err != nil {
+			return nil, err
+		}
+		return []formValue{fv}, nil
+	case t.Kind() == reflect.Slice && isFormObject(indirectType(t.Elem())):
+		formValues := make([]formValue, v.Len())
+		for i := range formValues {
+			var err error
+			formValues[i], err = jsonFormValue(name, jsonContentType, v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+		}
+		return formValues, nil
+	default:
+		formValues, err := appendFormValues(nil, name, v)
+		for i := range formValues {
+			formValues[i].contentType = contentType
+		}
+		return formValues, err
+	}
+}
+
+func jsonFormValue(name string, contentType string, v reflect.Value) (formValue, error) {
+	data, err := json.Marshal(v.Interface())
+	if err != nil {
+		return formValue{}, err
+	}
+	return formValue{name: name, value: string(data), contentType: contentType}, nil
+}
+
+// This returns the values of a property of an urlencoded form in the style of
+// its encoding.
+func styledFormValues(name string, v reflect.Value, encoding FormEncoding) ([]formValue, error) {
+	v = indirectValue(v)
+	if !v.IsValid() {
+		return nil, nil
+	}
+	t := v.Type()
+	separator := styleSeparator(encoding.Style)
+
+	switch {
+	case t.Kind() == reflect.Slice && t.Elem().Kind() != reflect.Uint8 && !isFormObject(indirectType(t.Elem())):
+		if encoding.Explode {
+			return appendFormValues(nil, name, v)
+		}
+		parts := make([]string, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			elem := indirectValue(v.Index(i))
+			if !elem.IsValid() {
+				continue
+			}
+			part, err := formString(elem)
+			if err != nil {
+				return nil, err
+			}
+			parts = append(parts, part)
+		}
+		return []formValue{{name: name, value: strings.Join(parts, separator)}}, nil
+	case isFormObject(t) && encoding.Style != "deepObject":
+		fieldValues, err := appendFormValues(nil, "", v)
+		if err != nil {
+			return nil, err
+		}
+		var parts []string
+		for i, fv := range fieldValues {
+			fieldName := strings.TrimSuffix(strings.TrimPrefix(fv.name, "["), "]")
+			if strings.Contains(fieldName, "[") {
+				return nil, fmt.Errorf("objects in the %s style can't hold other objects", encoding.Style)
+			}
+			fieldValues[i].name = fieldName
+			parts = append(parts, fieldName, fv.value)
+		}
+		if encoding.Explode {
+			return fieldValues, nil
+		}
+		return []formValue{{name: name, value: strings.Join(parts, separator)}}, nil
+	default:
+		return appendFormValues(nil, name, v)
+	}
+}
+
+func indirectValue(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// This appends the form values of a value under the given name, and the
+// names of the fields and elements of objects within it.
+func appendFormValues(formValues []formValue, name string, v reflect.Value) ([]formValue, error) {
+	v = indirectValue(v)
+	if !v.IsValid() {
+		return formValues, nil
+	}
+
+	if v.Type() == fileType {
+		file := v.Interface().(types.File)
+		return append(formValues, formValue{name: name, file: &file}), nil
+	}
+	if !isFormObject(v.Type()) && (v.Kind() != reflect.Slice || v.Type().Elem().Kind() == reflect.Uint8) {
+		s, err := formString(v)
+		if err != nil {
+			return nil, err
+		}
+		return append(formValues, formValue{name: name, value: s}), nil
+	}
+
+	var err error
+	switch v.Kind() {
+	case reflect.Slice:
+		elemType := v.Type().Elem()
+		if elemType.Kind() == reflect.Ptr {
+			elemType = elemType.Elem()
+		}
+		for i := 0; i < v.Len(); i++ {
+			elemName := name
+			if isFormObject(elemType) {
+				elemName = fmt.Sprintf("%s[%d]", name, i)
+			}
+			formValues, err = appendFormValues(formValues, elemName, v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+		}
+	case reflect.Map:
+		keys := make([]string, 0, v.Len())
+		elems := make(map[string]reflect.Value)
+		for _, key := range v.MapKeys() {
+			keys = append(keys, fmt.Sprint(key.Interface()))
+			elems[fmt.Sprint(key.Interface())] = v.MapIndex(key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			formValues, err = appendFormValues(formValues, name+"["+key+"]", elems[key])
+			if err != nil {
+				return nil, err
+			}
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			fieldName, omitEmpty := formFieldName(t.Field(i))
+			if fieldName == "" || (omitEmpty && isEmptyValue(v.Field(i))) {
+				continue
+			}
+			formValues, err = appendFormValues(formValues, name+"["+fieldName+"]", v.Field(i))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return formValues, nil
+}
+
+// This formats a value which is sent as a single string, the same way as
+// parameters are styled, and bytes the same way as in JSON, in base64.
+func formString(v reflect.Value) (string, error) {
+	switch value := v.Interface().(type) {
+	case time.Time:
+		return value.Format(time.RFC3339Nano), nil
+	case types.Date:
+		return value.Format(types.DateFormat), nil
+	case []byte:
+		return base64.StdEncoding.EncodeToString(value), nil
+	case encoding.TextMarshaler:
+		text, err := value.MarshalText()
+		return string(text), err
+	}
+	if v.Kind() == reflect.Uint8 || v.Kind() == reflect.Uint16 || v.Kind() == reflect.Uint32 || v.Kind() == reflect.Uint64 || v.Kind() == reflect.Uint {
+		return strconv.FormatUint(v.Uint(), 10), nil
+	}
+	return primitiveToString(v.Interface())
+}
+
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Ptr, reflect.Interface:
+		return v.IsNil()
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	}
+	return false
+}
@@ -0,0 +1,203 @@
+// Copyright 2019 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+)
+
+type formAddress struct {
+	City string `json:"city"`
+	Zip  *int   `json:"zip,omitempty"`
+}
+
+type formBody struct {
+	Name     string                 `json:"name"`
+	Age      *int                   `json:"age,omitempty"`
+	Tags     []string               `json:"tags,omitempty"`
+	Born     *types.Date            `json:"born,omitempty"`
+	Seen     *time.Time             `json:"seen,omitempty"`
+	ID       *types.UUID            `json:"id,omitempty"`
+	Address  *formAddress           `json:"address,omitempty"`
+	Avatar   *types.File            `json:"avatar,omitempty"`
+	Photos   []types.File           `json:"photos,omitempty"`
+	Extra    map[string]interface{} `json:"-"`
+	internal string
+}
+
+func TestMarshalAndBindForm(t *testing.T) {
+	age := 7
+	zip := 75001
+	born := types.Date{Time: time.Date(2012, 3, 4, 0, 0, 0, 0, time.UTC)}
+	seen := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	id := types.UUID{0x12, 0x34}
+	in := formBody{
+		Name:    "Tom & Jerry",
+		Age:     &age,
+		Tags:    []string{"cat", "mouse"},
+		Born:    &born,
+		Seen:    &seen,
+		ID:      &id,
+		Address: &formAddress{City: "Paris", Zip: &zip},
+	}
+
+	values, err := MarshalForm(in)
+	require.NoError(t, err)
+	assert.Equal(t, url.Values{
+		"name":          {"Tom & Jerry"},
+		"age":           {"7"},
+		"tags":          {"cat", "mouse"},
+		"born":          {"2012-03-04"},
+		"seen":          {"2020-01-02T03:04:05Z"},
+		"id":            {"12340000-0000-0000-0000-000000000000"},
+		"address[city]": {"Paris"},
+		"address[zip]":  {"75001"},
+	}, values)
+
+	// The encoded form binds back to the same values
+	parsed, err := url.ParseQuery(values.Encode())
+	require.NoError(t, err)
+	var out formBody
+	require.NoError(t, BindForm(&out, parsed))
+	assert.Equal(t, in.Name, out.Name)
+	assert.Equal(t, in.Age, out.Age)
+	assert.Equal(t, in.Tags, out.Tags)
+	assert.Equal(t, in.Born.Time, out.Born.Time)
+	assert.True(t, in.Seen.Equal(*out.Seen))
+	assert.Equal(t, in.ID, out.ID)
+	assert.Equal(t, in.Address, out.Address)
+
+	// Fields which aren't in the form are left alone
+	out = formBody{Name: "unchanged"}
+	require.NoError(t, BindForm(&out, url.Values{"age": {"3"}}))
+	assert.Equal(t, "unchanged", out.Name)
+	assert.Equal(t, 3, *out.Age)
+	assert.Nil(t, out.Address)
+
+	err = BindForm(&out, url.Values{"age": {"old"}})
+	assert.Error(t, err)
+
+	var avatar types.File
+	avatar.InitFromBytes([]byte("png"), "avatar.png")
+	_, err = MarshalForm(formBody{Avatar: &avatar})
+	assert.Error(t, err)
+}
+
+func TestMarshalAndBindMultipart(t *testing.T) {
+	var avatar, photo1, photo2 types.File
+	avatar.InitFromBytes([]byte("avatar data"), "avatar.png")
+	photo1.InitFromBytes([]byte("photo 1"), "one.jpg")
+	photo2.InitFromBytes([]byte("photo 2"), "")
+	age := 3
+	in := formBody{
+		Name:    "Tom",
+		Age:     &age,
+		Address: &formAddress{City: "Paris"},
+		Avatar:  &avatar,
+		Photos:  []types.File{photo1, photo2},
+	}
+	encodings := map[string]FormEncoding{
+		"avatar": {ContentType: "image/png, image/jpeg"},
+		"photos": {ContentType: "image/*"},
+	}
+
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	require.NoError(t, MarshalMultipartWithEncoding(in, w, encodings))
+	require.NoError(t, w.Close())
+
+	form, err := multipart.NewReader(&buf, w.Boundary()).ReadForm(MultipartMemory)
+	require.NoError(t, err)
+	defer form.RemoveAll()
+
+	// Objects are sent as JSON, and files with the content type of their
+	// encoding, or as bytes, when it's a wildcard.
+	assert.Equal(t, []string{`{"city":"Paris"}`}, form.Value["address"])
+	assert.Equal(t, "image/png", form.File["avatar"][0].Header.Get("Content-Type"))
+	assert.Equal(t, "application/octet-stream", form.File["photos"][0].Header.Get("Content-Type"))
+
+	var out formBody
+	require.NoError(t, BindMultipartWithEncoding(&out, form, encodings))
+	assert.Equal(t, "Tom", out.Name)
+	assert.Equal(t, 3, *out.Age)
+	assert.Equal(t, in.Address, out.Address)
+
+	require.NotNil(t, out.Avatar)
+	assert.Equal(t, "avatar.png", out.Avatar.Filename())
+	data, err := out.Avatar.Bytes()
+	require.NoError(t, err)
+	assert.Equal(t, "avatar data", string(data))
+
+	require.Len(t, out.Photos, 2)
+	assert.Equal(t, "one.jpg", out.Photos[0].Filename())
+	// Files without a name are sent under the name of their field
+	assert.Equal(t, "photos", out.Photos[1].Filename())
+	data, err = out.Photos[1].Bytes()
+	require.NoError(t, err)
+	assert.Equal(t, "photo 2", string(data))
+}
+
+type styledBody struct {
+	Tags    []string     `json:"tags"`
+	Ids     []int        `json:"ids"`
+	Address *formAddress `json:"address,omitempty"`
+	Owner   *formAddress `json:"owner,omitempty"`
+	Meta    *formAddress `json:"meta,omitempty"`
+}
+
+func TestFormEncoding(t *testing.T) {
+	zip := 75001
+	in := styledBody{
+		Tags:    []string{"cat", "grey"},
+		Ids:     []int{1, 2},
+		Address: &formAddress{City: "Paris", Zip: &zip},
+		Owner:   &formAddress{City: "Lyon"},
+		Meta:    &formAddress{City: "Nice"},
+	}
+	encodings := map[string]FormEncoding{
+		"tags":    {Style: "pipeDelimited"},
+		"ids":     {Style: "form", Explode: true},
+		"address": {Style: "form"},
+		"owner":   {Style: "form", Explode: true},
+		"meta":    {ContentType: "application/json"},
+	}
+
+	values, err := MarshalFormWithEncoding(in, encodings)
+	require.NoError(t, err)
+	assert.Equal(t, url.Values{
+		"tags":       {"cat|grey"},
+		"ids":        {"1", "2"},
+		"address":    {"city,Paris,zip,75001"},
+		"city":       {"Lyon"},
+		"meta[city]": {"Nice"},
+	}, values)
+
+	var out styledBody
+	require.NoError(t, BindFormWithEncoding(&out, values, encodings))
+	assert.Equal(t, in, out)
+
+	_, err = MarshalFormWithEncoding(struct {
+		Nested struct{ Inner formAddress } `json:"nested"`
+	}{}, map[string]FormEncoding{"nested": {Style: "form"}})
+	assert.Error(t, err)
+}
@@ -14,6 +14,7 @@
 package runtime
 
 import (
+	"encoding"
 	"errors"
 	"fmt"
 	"reflect"
@@ -47,6 +48,21 @@ func BindStringToObject(src string, dst interface{}) error {
 		return errors.New("destination is not settable")
 	}
 
+	// Types which parse themselves, such as types.UUID, are handed the string
+	// as is. Times are the exception, since we accept more formats for them
+	// than their own unmarshaler does.
+	switch dst.(type) {
+	case *time.Time, *types.Date:
+	default:
+		if tu, ok := dst.(encoding.TextUnmarshaler); ok {
+			err = tu.UnmarshalText([]byte(src))
+			if err != nil {
+				return fmt.Errorf("error binding string parameter: %s", err)
+			}
+			return nil
+		}
+	}
+
 	switch t.Kind() {
 	case reflect.Int, reflect.Int32, reflect.Int64:
 		var val int64
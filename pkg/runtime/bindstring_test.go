@@ -17,6 +17,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/deepmap/oapi-codegen/pkg/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -89,4 +90,15 @@ func TestBindStringToObject(t *testing.T) {
 	assert.NoError(t, BindStringToObject(strTime, &parsedTime))
 	parsedTime = parsedTime.UTC()
 	assert.EqualValues(t, now, parsedTime)
+
+	// Types which parse themselves are validated as they're bound
+	var uuid types.UUID
+	assert.NoError(t, BindStringToObject("f81d4fae-7dec-11d0-a765-00a0c91e6bf6", &uuid))
+	assert.Equal(t, "f81d4fae-7dec-11d0-a765-00a0c91e6bf6", uuid.String())
+	assert.Error(t, BindStringToObject("f81d4fae", &uuid))
+
+	var email types.Email
+	assert.NoError(t, BindStringToObject("alex@example.com", &email))
+	assert.Equal(t, types.Email("alex@example.com"), email)
+	assert.Error(t, BindStringToObject("alex", &email))
 }
@@ -0,0 +1,178 @@
+// Copyright 2019 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"encoding"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"mime"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+)
+
+// ErrUnsupportedDestination is returned by a ResponseDecoder which can't
+// decode into the type of value it's given. The generated clients leave those
+// responses undecoded, in the Body, rather than failing.
+var ErrUnsupportedDestination = errors.New("unsupported destination for the content type")
+
+// ResponseDecoder decodes the body of a response into the value which v
+// points to.
+type ResponseDecoder func(body []byte, v interface{}) error
+
+// ResponseDecoders holds the ResponseDecoder of each media type, which the
+// generated clients decode the bodies of responses with. A media type with a
+// structured syntax suffix, such as application/problem+json, is decoded by
+// its own decoder, if it has one, and otherwise by that of its suffix, which
+// is application/json here. It's safe to use concurrently.
+type ResponseDecoders struct {
+	mutex    sync.RWMutex
+	decoders map[string]ResponseDecoder
+}
+
+// DefaultResponseDecoders are the decoders which the clients use, unless
+// they're given their own. Decoders registered here are used by every client.
+var DefaultResponseDecoders = NewResponseDecoders()
+
+// NewResponseDecoders returns ResponseDecoders for JSON, XML, YAML and plain
+// text.
+func NewResponseDecoders() *ResponseDecoders {
+	d := &ResponseDecoders{decoders: make(map[string]ResponseDecoder)}
+	for _, mediaType := range []string{"application/json", "text/x-json"} {
+		d.Register(mediaType, json.Unmarshal)
+	}
+	for _, mediaType := range []string{"application/xml", "text/xml"} {
+		d.Register(mediaType, xml.Unmarshal)
+	}
+	for _, mediaType := range []string{"application/yaml", "application/x-yaml", "text/yaml", "text/x-yaml"} {
+		d.Register(mediaType, yaml.Unmarshal)
+	}
+	d.Register("text/plain", DecodeText)
+	return d
+}
+
+// DecodeText is the ResponseDecoder of plain text, which decodes the body into
+// a string or a byte slice as it is, and into other scalars, such as numbers,
+// the same way as BindStringToObject does, ignoring the surrounding space. It
+// returns ErrUnsupportedDestination for anything else, such as a struct.
+func DecodeText(body []byte, v interface{}) error {
+	switch dest := v.(type) {
+	case *string:
+		*dest = string(body)
+		return nil
+	case *[]byte:
+		*dest = append([]byte(nil), body...)
+		return nil
+	}
+	if !isScalarDestination(v) {
+		return ErrUnsupportedDestination
+	}
+	return BindStringToObject(strings.TrimSpace(string(body)), v)
+}
+
+// This tells whether v points to a value which BindStringToObject binds from
+// a single string.
+func isScalarDestination(v interface{}) bool {
+	switch v.(type) {
+	case *time.Time, *types.Date, encoding.TextUnmarshaler:
+		return true
+	}
+	t := reflect.TypeOf(v)
+	if t == nil || t.Kind() != reflect.Ptr {
+		return false
+	}
+	switch t.Elem().Kind() {
+	case reflect.Int, reflect.Int32, reflect.Int64, reflect.Float32, reflect.Float64, reflect.Bool, reflect.String:
+		return true
+	}
+	return false
+}
+
+// Register sets the decoder of a media type, such as application/x-protobuf,
+// replacing the one it had. Registering the decoder of application/cbor
+// decodes the media types with a +cbor suffix as well.
+func (d *ResponseDecoders) Register(mediaType string, decoder ResponseDecoder) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.decoders[strings.ToLower(mediaType)] = decoder
+}
+
+// Lookup returns the decoder of the media type of a Content-Type header,
+// whose parameters, such as charset, are ignored.
+func (d *ResponseDecoders) Lookup(contentType string) (ResponseDecoder, bool) {
+	mediaType := parseMediaType(contentType)
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+	if decoder, found := d.decoders[mediaType]; found {
+		return decoder, true
+	}
+	if i := strings.LastIndex(mediaType, "+"); i >= 0 {
+		decoder, found := d.decoders["application/"+mediaType[i+1:]]
+		return decoder, found
+	}
+	return nil, false
+}
+
+// Clone returns a copy of the decoders, which decoders can be registered with
+// without changing these ones.
+func (d *ResponseDecoders) Clone() *ResponseDecoders {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+	clone := &ResponseDecoders{decoders: make(map[string]ResponseDecoder)}
+	for mediaType, decoder := range d.decoders {
+		clone.decoders[mediaType] = decoder
+	}
+	return clone
+}
+
+// MediaTypeMatches tells whether the media type of a Content-Type header is
+// one which a content type of a spec, which may be a range such as image/*,
+// covers. To be as lenient as clients have been, a media type with a
+// structured syntax suffix matches that of its suffix, so a spec's
+// application/json covers application/hal+json too.
+func MediaTypeMatches(contentType string, specMediaType string) bool {
+	mediaType := parseMediaType(contentType)
+	specMediaType = strings.ToLower(specMediaType)
+	if mediaType == "" {
+		return false
+	}
+	if mediaType == specMediaType || specMediaType == "*/*" {
+		return true
+	}
+	slash := strings.Index(mediaType, "/")
+	if strings.HasSuffix(specMediaType, "/*") {
+		return slash >= 0 && mediaType[:slash+1] == strings.TrimSuffix(specMediaType, "*")
+	}
+	if plus := strings.LastIndex(mediaType, "+"); plus > slash && slash >= 0 {
+		return mediaType[:slash+1]+mediaType[plus+1:] == specMediaType
+	}
+	return false
+}
+
+// This returns the lower case media type of a Content-Type header, without
+// its parameters.
+func parseMediaType(contentType string) string {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType = strings.TrimSpace(strings.Split(contentType, ";")[0])
+	}
+	return strings.ToLower(mediaType)
+}
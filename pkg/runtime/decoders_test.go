@@ -0,0 +1,112 @@
+// Copyright 2019 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponseDecoders(t *testing.T) {
+	type pet struct {
+		Name string `json:"name" xml:"name" yaml:"name"`
+	}
+	decoders := NewResponseDecoders()
+
+	tests := []struct {
+		contentType string
+		body        string
+	}{
+		{"application/json", `{"name": "Tom"}`},
+		{"Application/JSON; charset=utf-8", `{"name": "Tom"}`},
+		{"application/problem+json", `{"name": "Tom"}`},
+		{"application/vnd.api+json", `{"name": "Tom"}`},
+		{"text/xml", `<pet><name>Tom</name></pet>`},
+		{"application/atom+xml", `<pet><name>Tom</name></pet>`},
+		{"application/x-yaml", `name: Tom`},
+	}
+	for _, test := range tests {
+		decode, found := decoders.Lookup(test.contentType)
+		require.True(t, found, test.contentType)
+		var p pet
+		require.NoError(t, decode([]byte(test.body), &p), test.contentType)
+		assert.Equal(t, "Tom", p.Name, test.contentType)
+	}
+
+	decode, found := decoders.Lookup("text/plain; charset=utf-8")
+	require.True(t, found)
+	var text string
+	require.NoError(t, decode([]byte("Tom"), &text))
+	assert.Equal(t, "Tom", text)
+	var number int
+	require.NoError(t, decode([]byte("42\n"), &number))
+	assert.Equal(t, 42, number)
+	var flag bool
+	require.NoError(t, decode([]byte("true"), &flag))
+	assert.True(t, flag)
+	assert.Error(t, decode([]byte("many"), &number))
+	var object struct{ Name string }
+	assert.Equal(t, ErrUnsupportedDestination, decode([]byte("Tom"), &object))
+	var list []int
+	assert.Equal(t, ErrUnsupportedDestination, decode([]byte("1,2"), &list))
+
+	_, found = decoders.Lookup("application/x-protobuf")
+	assert.False(t, found)
+	_, found = decoders.Lookup("")
+	assert.False(t, found)
+
+	// Decoders registered with a clone don't change the original, and those
+	// of a media type with a suffix take precedence over the suffix's.
+	errProtobuf := errors.New("protobuf")
+	clone := decoders.Clone()
+	clone.Register("application/x-protobuf", func(body []byte, v interface{}) error {
+		return errProtobuf
+	})
+	clone.Register("application/problem+json", func(body []byte, v interface{}) error {
+		return errProtobuf
+	})
+	decode, found = clone.Lookup("application/x-protobuf")
+	require.True(t, found)
+	assert.Equal(t, errProtobuf, decode(nil, nil))
+	decode, found = clone.Lookup("application/problem+json")
+	require.True(t, found)
+	assert.Equal(t, errProtobuf, decode(nil, nil))
+	_, found = decoders.Lookup("application/x-protobuf")
+	assert.False(t, found)
+}
+
+func TestMediaTypeMatches(t *testing.T) {
+	tests := []struct {
+		contentType string
+		spec        string
+		matches     bool
+	}{
+		{"application/json", "application/json", true},
+		{"application/json; charset=utf-8", "application/json", true},
+		{"application/hal+json", "application/json", true},
+		{"application/hal+json", "application/hal+json", true},
+		{"application/json", "application/hal+json", false},
+		{"application/xml", "application/json", false},
+		{"image/png", "image/*", true},
+		{"text/plain", "image/*", false},
+		{"text/plain", "*/*", true},
+		{"", "*/*", false},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.matches, MediaTypeMatches(test.contentType, test.spec), "%s %s", test.contentType, test.spec)
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2019 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// JsonMerge merges the JSON document in patch into the one in data, following
+// the semantics of a JSON Merge Patch (RFC 7386). Objects are merged
+// recursively, null values in the patch remove the corresponding key, and
+// anything else in the patch replaces what is in data. The generated union
+// types use this to combine several of their possible values.
+func JsonMerge(data, patch json.RawMessage) (json.RawMessage, error) {
+	if len(data) == 0 {
+		return patch, nil
+	}
+
+	var dataValue, patchValue interface{}
+	err := json.Unmarshal(data, &dataValue)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling merge target: %s", err)
+	}
+	err = json.Unmarshal(patch, &patchValue)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling merge patch: %s", err)
+	}
+
+	merged, err := json.Marshal(mergeJsonValues(dataValue, patchValue))
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling merged value: %s", err)
+	}
+	return merged, nil
+}
+
+func mergeJsonValues(data, patch interface{}) interface{} {
+	patchObject, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	dataObject, ok := data.(map[string]interface{})
+	if !ok {
+		dataObject = make(map[string]interface{})
+	}
+	for k, v := range patchObject {
+		if v == nil {
+			delete(dataObject, k)
+			continue
+		}
+		dataObject[k] = mergeJsonValues(dataObject[k], v)
+	}
+	return dataObject
+}
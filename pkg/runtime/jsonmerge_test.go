@@ -0,0 +1,42 @@
+// Copyright 2019 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJsonMerge(t *testing.T) {
+	// Merging into nothing yields the patch
+	merged, err := JsonMerge(nil, []byte(`{"name":"fido"}`))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"name":"fido"}`, string(merged))
+
+	// Objects are merged recursively, and nulls remove keys
+	merged, err = JsonMerge(
+		[]byte(`{"name":"fido","owner":{"name":"bob","age":4},"tag":"dog"}`),
+		[]byte(`{"bark":true,"owner":{"age":5},"tag":null}`))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"name":"fido","bark":true,"owner":{"name":"bob","age":5}}`, string(merged))
+
+	// Non-objects replace the existing value
+	merged, err = JsonMerge([]byte(`{"name":"fido"}`), []byte(`[1,2]`))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[1,2]`, string(merged))
+
+	_, err = JsonMerge([]byte(`{"name":`), []byte(`{}`))
+	assert.Error(t, err)
+}
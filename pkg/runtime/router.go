@@ -0,0 +1,177 @@
+// Copyright 2019 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// Route is an operation of a spec, which a Router dispatches requests to. Its
+// Path is the path of the operation in the spec, such as "/pets/{id}", and
+// its Handler is given the values of the path parameters, by their names.
+type Route struct {
+	Method  string
+	Path    string
+	Handler func(w http.ResponseWriter, r *http.Request, pathParams map[string]string)
+}
+
+// Router dispatches requests to the Routes which match their method and path,
+// using nothing but net/http, so that the generated std-http server doesn't
+// need a router of its own.
+type Router struct {
+	routes []compiledRoute
+}
+
+type compiledRoute struct {
+	Route
+	segments []pathSegment
+}
+
+// pathSegment is the part of a path between two slashes. When it holds a
+// parameter, prefix and suffix are the literal text around it.
+type pathSegment struct {
+	prefix string
+	param  string
+	suffix string
+}
+
+// NewRouter returns a Router for the routes. When the paths of more than one
+// of them match a request, the one with a literal segment where the others
+// have a parameter wins, so "/pets/mine" takes precedence over "/pets/{id}".
+func NewRouter(routes ...Route) *Router {
+	router := &Router{}
+	for _, route := range routes {
+		router.routes = append(router.routes, compiledRoute{
+			Route:    route,
+			segments: parsePath(route.Path),
+		})
+	}
+	sort.SliceStable(router.routes, func(i, j int) bool {
+		return moreSpecific(router.routes[i].segments, router.routes[j].segments)
+	})
+	return router
+}
+
+// ServeHTTP calls the handler of the route which matches the request. It
+// responds with 404 Not Found when no path matches, and with 405 Method Not
+// Allowed when a path matches, but not with the method of the request.
+func (router *Router) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var allowed []string
+	for _, route := range router.routes {
+		pathParams, found := matchPath(route.segments, r.URL.EscapedPath())
+		if !found {
+			continue
+		}
+		if !strings.EqualFold(route.Method, r.Method) {
+			allowed = append(allowed, strings.ToUpper(route.Method))
+			continue
+		}
+		route.Handler(w, r, pathParams)
+		return
+	}
+	if len(allowed) != 0 {
+		w.Header().Set("Allow", strings.Join(allowed, ", "))
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	http.NotFound(w, r)
+}
+
+// Patterns returns the http.ServeMux patterns which cover the paths of the
+// routes, which are their literal prefixes, up to their first parameter.
+func (router *Router) Patterns() []string {
+	seen := make(map[string]bool)
+	var patterns []string
+	for _, route := range router.routes {
+		pattern := route.Path
+		if i := strings.Index(pattern, "{"); i >= 0 {
+			pattern = pattern[:strings.LastIndex(pattern[:i], "/")+1]
+		}
+		if !seen[pattern] {
+			seen[pattern] = true
+			patterns = append(patterns, pattern)
+		}
+	}
+	sort.Strings(patterns)
+	return patterns
+}
+
+func parsePath(path string) []pathSegment {
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	segments := make([]pathSegment, len(parts))
+	for i, part := range parts {
+		start := strings.Index(part, "{")
+		end := strings.LastIndex(part, "}")
+		if start < 0 || end < start {
+			segments[i] = pathSegment{prefix: part}
+			continue
+		}
+		segments[i] = pathSegment{
+			prefix: part[:start],
+			param:  part[start+1 : end],
+			suffix: part[end+1:],
+		}
+	}
+	return segments
+}
+
+// This tells whether a path should be tried before another, because at the
+// first segment where they differ, it has literal text where the other has a
+// parameter, or more literal text around its parameter.
+func moreSpecific(a []pathSegment, b []pathSegment) bool {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		aLiteral, bLiteral := a[i].param == "", b[i].param == ""
+		if aLiteral != bLiteral {
+			return aLiteral
+		}
+		aLength := len(a[i].prefix) + len(a[i].suffix)
+		bLength := len(b[i].prefix) + len(b[i].suffix)
+		if aLength != bLength {
+			return aLength > bLength
+		}
+	}
+	return false
+}
+
+// This matches an escaped request path against the segments of a route,
+// returning the unescaped values of its parameters.
+func matchPath(segments []pathSegment, path string) (map[string]string, bool) {
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	if len(parts) != len(segments) {
+		return nil, false
+	}
+	pathParams := make(map[string]string)
+	for i, part := range parts {
+		part, err := url.PathUnescape(part)
+		if err != nil {
+			return nil, false
+		}
+		segment := segments[i]
+		if segment.param == "" {
+			if part != segment.prefix {
+				return nil, false
+			}
+			continue
+		}
+		if len(part) <= len(segment.prefix)+len(segment.suffix) ||
+			!strings.HasPrefix(part, segment.prefix) || !strings.HasSuffix(part, segment.suffix) {
+			return nil, false
+		}
+		pathParams[segment.param] = part[len(segment.prefix) : len(part)-len(segment.suffix)]
+	}
+	return pathParams, true
+}
@@ -0,0 +1,73 @@
+// Copyright 2019 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRouter(t *testing.T) {
+	// Each handler writes its name, and the path parameters it was given
+	handler := func(name string) func(http.ResponseWriter, *http.Request, map[string]string) {
+		return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+			_, _ = fmt.Fprintf(w, "%s %v", name, pathParams)
+		}
+	}
+	router := NewRouter(
+		Route{Method: "GET", Path: "/pets/{id}", Handler: handler("findPet")},
+		Route{Method: "DELETE", Path: "/pets/{id}", Handler: handler("deletePet")},
+		Route{Method: "GET", Path: "/pets/mine", Handler: handler("myPets")},
+		Route{Method: "GET", Path: "/pets", Handler: handler("findPets")},
+		Route{Method: "GET", Path: "/files/{name}.json", Handler: handler("file")},
+		Route{Method: "GET", Path: "/owners/{owner}/pets/{pet}", Handler: handler("ownerPet")},
+	)
+
+	tests := []struct {
+		method string
+		path   string
+		code   int
+		body   string
+	}{
+		{"GET", "/pets", http.StatusOK, "findPets map[]"},
+		{"GET", "/pets/7", http.StatusOK, "findPet map[id:7]"},
+		{"DELETE", "/pets/7", http.StatusOK, "deletePet map[id:7]"},
+		{"GET", "/pets/mine", http.StatusOK, "myPets map[]"},
+		{"GET", "/pets/a%2Fb", http.StatusOK, "findPet map[id:a/b]"},
+		{"GET", "/files/report.json", http.StatusOK, "file map[name:report]"},
+		{"GET", "/owners/ann/pets/7", http.StatusOK, "ownerPet map[owner:ann pet:7]"},
+		{"GET", "/files/report.xml", http.StatusNotFound, ""},
+		{"GET", "/pets/7/toys", http.StatusNotFound, ""},
+		{"GET", "/pets/", http.StatusNotFound, ""},
+		{"PUT", "/pets/7", http.StatusMethodNotAllowed, ""},
+	}
+	for _, test := range tests {
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, httptest.NewRequest(test.method, test.path, nil))
+		assert.Equal(t, test.code, rr.Code, "%s %s", test.method, test.path)
+		if test.body != "" {
+			assert.Equal(t, test.body, rr.Body.String(), "%s %s", test.method, test.path)
+		}
+	}
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, httptest.NewRequest("PUT", "/pets/7", nil))
+	assert.Equal(t, "GET, DELETE", rr.Header().Get("Allow"))
+
+	assert.Equal(t, []string{"/files/", "/owners/", "/pets", "/pets/", "/pets/mine"}, router.Patterns())
+}
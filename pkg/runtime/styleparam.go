@@ -14,6 +14,7 @@
 package runtime
 
 import (
+	"encoding"
 	"errors"
 	"fmt"
 	"reflect"
@@ -21,6 +22,8 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
 )
 
 // Given an input value, such as a primitive type, array or object, turn it
@@ -39,6 +42,16 @@ func StyleParam(style string, explode bool, paramName string, value interface{})
 		t = v.Type()
 	}
 
+	// Types which format themselves, such as types.UUID, are styled as the
+	// string they produce. Times are handled along with other structs.
+	if tm, ok := v.Interface().(encoding.TextMarshaler); ok && !isTimeValue(v.Interface()) {
+		text, err := tm.MarshalText()
+		if err != nil {
+			return "", fmt.Errorf("error marshaling '%s' as text: %s", paramName, err)
+		}
+		return stylePrimitive(style, explode, paramName, string(text))
+	}
+
 	switch t.Kind() {
 	case reflect.Slice:
 		n := v.Len()
@@ -133,6 +146,16 @@ func sortedKeys(strMap map[string]string) []string {
 }
 
 
+// Times, and dates which embed them, have their own formatting rules rather
+// than that of their text marshaler.
+func isTimeValue(value interface{}) bool {
+	switch value.(type) {
+	case time.Time, types.Date:
+		return true
+	}
+	return false
+}
+
 // This is a special case. The struct may be a time, in which case, marshal
 // it in RFC3339 format.
 func marshalTimeValue(value interface{}) (string, bool) {
@@ -317,6 +340,16 @@ func primitiveToString(value interface{}) (string, error) {
 	t := v.Type()
 	kind := t.Kind()
 
+	// Elements of slices, fields of structs and values of maps which format
+	// themselves are formatted the same way as a value of their own.
+	if tm, ok := v.Interface().(encoding.TextMarshaler); ok && !isTimeValue(v.Interface()) {
+		text, err := tm.MarshalText()
+		if err != nil {
+			return "", err
+		}
+		return string(text), nil
+	}
+
 	switch kind {
 	case reflect.Int8, reflect.Int32, reflect.Int64, reflect.Int:
 		output = strconv.FormatInt(v.Int(), 10)
@@ -17,6 +17,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/deepmap/oapi-codegen/pkg/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -390,4 +391,46 @@ func TestStyleParam(t *testing.T) {
 	result, err = StyleParam("simple", false, "id", object2)
 	assert.NoError(t, err)
 	assert.EqualValues(t, "firstName,Alex", result)
+
+	// Types with text marshalers are styled as their text
+	uuid, err := types.ParseUUID("f81d4fae-7dec-11d0-a765-00a0c91e6bf6")
+	assert.NoError(t, err)
+	result, err = StyleParam("simple", false, "id", uuid)
+	assert.NoError(t, err)
+	assert.EqualValues(t, "f81d4fae-7dec-11d0-a765-00a0c91e6bf6", result)
+
+	uri, err := types.ParseURI("https://example.com")
+	assert.NoError(t, err)
+	result, err = StyleParam("form", true, "link", &uri)
+	assert.NoError(t, err)
+	assert.EqualValues(t, "link=https://example.com", result)
+
+	// As are the elements of slices, the fields of structs and the values of
+	// maps which have them
+	uuid2, err := types.ParseUUID("0e2a5b3c-1d4f-4a6b-8c9d-0e1f2a3b4c5d")
+	assert.NoError(t, err)
+	uuids := []types.UUID{uuid, uuid2}
+	result, err = StyleParam("form", true, "ids", uuids)
+	assert.NoError(t, err)
+	assert.EqualValues(t, "ids=f81d4fae-7dec-11d0-a765-00a0c91e6bf6&ids=0e2a5b3c-1d4f-4a6b-8c9d-0e1f2a3b4c5d", result)
+
+	result, err = StyleParam("simple", false, "ids", &uuids)
+	assert.NoError(t, err)
+	assert.EqualValues(t, "f81d4fae-7dec-11d0-a765-00a0c91e6bf6,0e2a5b3c-1d4f-4a6b-8c9d-0e1f2a3b4c5d", result)
+
+	result, err = StyleParam("pipeDelimited", false, "links", []*types.URI{&uri})
+	assert.NoError(t, err)
+	assert.EqualValues(t, "links=https://example.com", result)
+
+	object3 := struct {
+		ID   types.UUID `json:"id"`
+		Link *types.URI `json:"link,omitempty"`
+	}{ID: uuid, Link: &uri}
+	result, err = StyleParam("form", true, "object", object3)
+	assert.NoError(t, err)
+	assert.EqualValues(t, "id=f81d4fae-7dec-11d0-a765-00a0c91e6bf6&link=https://example.com", result)
+
+	result, err = StyleParam("simple", false, "object", map[string]interface{}{"id": uuid})
+	assert.NoError(t, err)
+	assert.EqualValues(t, "id,f81d4fae-7dec-11d0-a765-00a0c91e6bf6", result)
 }
@@ -0,0 +1,212 @@
+// Copyright 2019 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Validator is implemented by the generated types, whose Validate method
+// checks their values against the constraints of their schemas.
+type Validator interface {
+	Validate() error
+}
+
+// ValidateValue validates the given value, if it implements Validator, and
+// returns nil otherwise.
+func ValidateValue(value interface{}) error {
+	if v, ok := value.(Validator); ok {
+		return v.Validate()
+	}
+	return nil
+}
+
+// ValidationError describes a value which doesn't satisfy its schema.
+type ValidationError struct {
+	// The path to the value, eg, "pets[2].name", or "" for the validated
+	// value itself.
+	Field   string
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	if e.Field == "" {
+		return e.Message
+	}
+	return e.Field + ": " + e.Message
+}
+
+// ValidationErrors collects every ValidationError found while validating a
+// value, so that they can all be reported at once.
+type ValidationErrors []ValidationError
+
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// ErrorOrNil returns nil when there are no errors, so that an empty
+// ValidationErrors isn't returned as a non-nil error.
+func (e ValidationErrors) ErrorOrNil() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
+
+// Add records an error for the given field.
+func (e *ValidationErrors) Add(field, message string) {
+	*e = append(*e, ValidationError{Field: field, Message: message})
+}
+
+// Append records the errors from validating a nested value, with their fields
+// relative to the given field. Errors which aren't validation errors are
+// recorded against the field itself.
+func (e *ValidationErrors) Append(field string, err error) {
+	switch v := err.(type) {
+	case nil:
+	case ValidationErrors:
+		for _, nested := range v {
+			e.Add(JoinPath(field, nested.Field), nested.Message)
+		}
+	case ValidationError:
+		e.Add(JoinPath(field, v.Field), v.Message)
+	default:
+		e.Add(field, err.Error())
+	}
+}
+
+// Required records an error for a required field which is missing.
+func (e *ValidationErrors) Required(field string) {
+	e.Add(field, "is required")
+}
+
+// MinLength checks that a string has at least min characters.
+func (e *ValidationErrors) MinLength(field string, value string, min int) {
+	if utf8.RuneCountInString(value) < min {
+		e.Add(field, fmt.Sprintf("length must be at least %d", min))
+	}
+}
+
+// MaxLength checks that a string has at most max characters.
+func (e *ValidationErrors) MaxLength(field string, value string, max int) {
+	if utf8.RuneCountInString(value) > max {
+		e.Add(field, fmt.Sprintf("length must be at most %d", max))
+	}
+}
+
+var patterns sync.Map
+
+// Pattern checks that a string matches a regular expression.
+func (e *ValidationErrors) Pattern(field string, value string, pattern string) {
+	re, found := patterns.Load(pattern)
+	if !found {
+		re, _ = patterns.LoadOrStore(pattern, regexp.MustCompile(pattern))
+	}
+	if !re.(*regexp.Regexp).MatchString(value) {
+		e.Add(field, fmt.Sprintf("must match the pattern %s", pattern))
+	}
+}
+
+// Minimum checks that a number is at least min, or greater than it when the
+// minimum is exclusive.
+func (e *ValidationErrors) Minimum(field string, value float64, min float64, exclusive bool) {
+	if exclusive && value <= min {
+		e.Add(field, "must be greater than "+formatNumber(min))
+	} else if value < min {
+		e.Add(field, "must be greater than or equal to "+formatNumber(min))
+	}
+}
+
+// Maximum checks that a number is at most max, or less than it when the
+// maximum is exclusive.
+func (e *ValidationErrors) Maximum(field string, value float64, max float64, exclusive bool) {
+	if exclusive && value >= max {
+		e.Add(field, "must be less than "+formatNumber(max))
+	} else if value > max {
+		e.Add(field, "must be less than or equal to "+formatNumber(max))
+	}
+}
+
+// MultipleOf checks that a number is a multiple of the given number.
+func (e *ValidationErrors) MultipleOf(field string, value float64, multipleOf float64) {
+	quotient := value / multipleOf
+	if math.Abs(quotient-math.Round(quotient)) > 1e-9 {
+		e.Add(field, "must be a multiple of "+formatNumber(multipleOf))
+	}
+}
+
+// MinItems checks that an array has at least min items.
+func (e *ValidationErrors) MinItems(field string, length int, min int) {
+	if length < min {
+		e.Add(field, fmt.Sprintf("must have at least %d items", min))
+	}
+}
+
+// MaxItems checks that an array has at most max items.
+func (e *ValidationErrors) MaxItems(field string, length int, max int) {
+	if length > max {
+		e.Add(field, fmt.Sprintf("must have at most %d items", max))
+	}
+}
+
+// UniqueItems checks that no two items of an array, or slice, are equal.
+func (e *ValidationErrors) UniqueItems(field string, items interface{}) {
+	v := reflect.ValueOf(items)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return
+	}
+	for i := 0; i < v.Len(); i++ {
+		for j := i + 1; j < v.Len(); j++ {
+			if reflect.DeepEqual(v.Index(i).Interface(), v.Index(j).Interface()) {
+				e.Add(field, fmt.Sprintf("items must be unique, but items %d and %d are equal", i, j))
+				return
+			}
+		}
+	}
+}
+
+// JoinPath appends the name of a field, or an index, to the path of the
+// value which contains it.
+func JoinPath(path string, field string) string {
+	if path == "" {
+		return field
+	}
+	if field == "" {
+		return path
+	}
+	if strings.HasPrefix(field, "[") {
+		return path + field
+	}
+	return path + "." + field
+}
+
+// IndexPath returns the path of an item of the array at the given path.
+func IndexPath(path string, index int) string {
+	return path + "[" + strconv.Itoa(index) + "]"
+}
+
+func formatNumber(n float64) string {
+	return strconv.FormatFloat(n, 'g', -1, 64)
+}
@@ -0,0 +1,90 @@
+// Copyright 2019 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type validatedName string
+
+func (n validatedName) Validate() error {
+	var errs ValidationErrors
+	errs.MaxLength("", string(n), 3)
+	return errs.ErrorOrNil()
+}
+
+func TestValidationErrors(t *testing.T) {
+	var errs ValidationErrors
+	assert.NoError(t, errs.ErrorOrNil())
+
+	errs.MinLength("name", "ab", 3)
+	errs.MaxLength("name", "abc", 3)
+	errs.Pattern("code", "abc", "^[a-z]+$")
+	errs.Pattern("code", "ABC", "^[a-z]+$")
+	errs.Minimum("age", 1, 1, false)
+	errs.Minimum("age", 1, 1, true)
+	errs.Maximum("age", 10, 10, false)
+	errs.Maximum("age", 11, 10, false)
+	errs.MultipleOf("price", 0.3, 0.1)
+	errs.MultipleOf("price", 0.35, 0.1)
+	errs.MinItems("tags", 0, 1)
+	errs.MaxItems("tags", 3, 2)
+	errs.UniqueItems("tags", []string{"a", "b", "a"})
+	errs.UniqueItems("tags", []string{"a", "b"})
+
+	assert.Equal(t, ValidationErrors{
+		{Field: "name", Message: "length must be at least 3"},
+		{Field: "code", Message: "must match the pattern ^[a-z]+$"},
+		{Field: "age", Message: "must be greater than 1"},
+		{Field: "age", Message: "must be less than or equal to 10"},
+		{Field: "price", Message: "must be a multiple of 0.1"},
+		{Field: "tags", Message: "must have at least 1 items"},
+		{Field: "tags", Message: "must have at most 2 items"},
+		{Field: "tags", Message: "items must be unique, but items 0 and 2 are equal"},
+	}, errs)
+	assert.Equal(t, "name: length must be at least 3; code: must match the pattern ^[a-z]+$; "+
+		"age: must be greater than 1; age: must be less than or equal to 10; "+
+		"price: must be a multiple of 0.1; tags: must have at least 1 items; "+
+		"tags: must have at most 2 items; tags: items must be unique, but items 0 and 2 are equal",
+		errs.Error())
+}
+
+func TestValidationErrorsAppend(t *testing.T) {
+	var errs ValidationErrors
+	errs.Append("pets[0]", nil)
+	errs.Append(IndexPath("pets", 1), ValidationErrors{{Field: "name", Message: "is required"}})
+	errs.Append("owner", ValidationError{Message: "is invalid"})
+	errs.Append("tag", errors.New("failed"))
+	errs.Append("nick", ValidateValue(validatedName("fido")))
+	errs.Append("name", ValidateValue("fido"))
+
+	assert.Equal(t, ValidationErrors{
+		{Field: "pets[1].name", Message: "is required"},
+		{Field: "owner", Message: "is invalid"},
+		{Field: "tag", Message: "failed"},
+		{Field: "nick", Message: "length must be at most 3"},
+	}, errs)
+}
+
+func TestJoinPath(t *testing.T) {
+	assert.Equal(t, "name", JoinPath("", "name"))
+	assert.Equal(t, "pet", JoinPath("pet", ""))
+	assert.Equal(t, "pet.name", JoinPath("pet", "name"))
+	assert.Equal(t, "pets[2]", JoinPath("pets", "[2]"))
+	assert.Equal(t, "pets[2].tags[0]", JoinPath(IndexPath("pets", 2), IndexPath("tags", 0)))
+}
@@ -0,0 +1,25 @@
+package types
+
+import (
+	"fmt"
+	"net/mail"
+)
+
+// Email is a value of the "email" string format; a bare address, such as
+// "alex@example.com", without a display name.
+type Email string
+
+// MarshalText writes the address, which is also used for JSON.
+func (e Email) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+// UnmarshalText reads an address, and fails when it isn't valid.
+func (e *Email) UnmarshalText(data []byte) error {
+	address, err := mail.ParseAddress(string(data))
+	if err != nil || address.Name != "" || address.Address != string(data) {
+		return fmt.Errorf("invalid email address '%s'", string(data))
+	}
+	*e = Email(data)
+	return nil
+}
@@ -0,0 +1,26 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmail_JSON(t *testing.T) {
+	b := struct {
+		Email Email `json:"email"`
+	}{}
+	err := json.Unmarshal([]byte(`{"email":"alex@example.com"}`), &b)
+	assert.NoError(t, err)
+	assert.Equal(t, Email("alex@example.com"), b.Email)
+
+	jsonBytes, err := json.Marshal(b)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"email":"alex@example.com"}`, string(jsonBytes))
+
+	for _, invalid := range []string{`""`, `"alex"`, `"Alex <alex@example.com>"`} {
+		err = json.Unmarshal([]byte(`{"email":`+invalid+`}`), &b)
+		assert.Error(t, err, invalid)
+	}
+}
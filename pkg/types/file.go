@@ -0,0 +1,112 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+)
+
+// File is a value of the "binary" string format, which is usually a file
+// upload. In JSON, its contents are base64 encoded.
+type File struct {
+	multipart *multipart.FileHeader
+	reader    io.Reader
+	data      []byte
+	filename  string
+}
+
+// InitFromMultipart makes the File refer to an uploaded multipart file,
+// which is only read when needed.
+func (file *File) InitFromMultipart(header *multipart.FileHeader) {
+	file.multipart = header
+	file.reader = nil
+	file.data = nil
+	file.filename = header.Filename
+}
+
+// InitFromBytes sets the contents and name of the File.
+func (file *File) InitFromBytes(data []byte, filename string) {
+	file.data = data
+	file.filename = filename
+	file.multipart = nil
+	file.reader = nil
+}
+
+// InitFromReader makes the File read its contents from r, such as an
+// *os.File, so that a client can send them without holding them in memory.
+// The contents can only be read once, and r is closed after it's been read
+// through Reader, if it's an io.Closer. The size of the File isn't known.
+func (file *File) InitFromReader(r io.Reader, filename string) {
+	file.reader = r
+	file.filename = filename
+	file.multipart = nil
+	file.data = nil
+}
+
+// Bytes returns the contents of the File.
+func (file File) Bytes() ([]byte, error) {
+	if file.multipart != nil {
+		f, err := file.multipart.Open()
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		return ioutil.ReadAll(f)
+	}
+	if file.reader != nil {
+		return ioutil.ReadAll(file.reader)
+	}
+	return file.data, nil
+}
+
+// Reader returns a reader of the contents of the File, which must be closed.
+func (file File) Reader() (io.ReadCloser, error) {
+	if file.multipart != nil {
+		return file.multipart.Open()
+	}
+	if rc, ok := file.reader.(io.ReadCloser); ok {
+		return rc, nil
+	}
+	if file.reader != nil {
+		return ioutil.NopCloser(file.reader), nil
+	}
+	return ioutil.NopCloser(bytes.NewReader(file.data)), nil
+}
+
+// Filename returns the name of the File, if it has one.
+func (file File) Filename() string {
+	return file.filename
+}
+
+// FileSize returns the size of the File's contents in bytes, or -1 if it
+// reads them from a reader.
+func (file File) FileSize() int64 {
+	if file.multipart != nil {
+		return file.multipart.Size
+	}
+	if file.reader != nil {
+		return -1
+	}
+	return int64(len(file.data))
+}
+
+// MarshalJSON writes the contents of the File as a base64 string.
+func (file File) MarshalJSON() ([]byte, error) {
+	data, err := file.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(data)
+}
+
+// UnmarshalJSON reads the contents of the File from a base64 string.
+func (file *File) UnmarshalJSON(data []byte) error {
+	var contents []byte
+	if err := json.Unmarshal(data, &contents); err != nil {
+		return err
+	}
+	file.InitFromBytes(contents, "")
+	return nil
+}
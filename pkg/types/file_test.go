@@ -0,0 +1,63 @@
+package types
+
+import (
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFile(t *testing.T) {
+	var file File
+	file.InitFromBytes([]byte("hello"), "hello.txt")
+	assert.Equal(t, "hello.txt", file.Filename())
+	assert.Equal(t, int64(5), file.FileSize())
+
+	reader, err := file.Reader()
+	assert.NoError(t, err)
+	contents, err := ioutil.ReadAll(reader)
+	assert.NoError(t, err)
+	assert.NoError(t, reader.Close())
+	assert.Equal(t, "hello", string(contents))
+
+	// JSON contents are base64 encoded
+	jsonBytes, err := json.Marshal(file)
+	assert.NoError(t, err)
+	assert.Equal(t, `"aGVsbG8="`, string(jsonBytes))
+
+	var decoded File
+	err = json.Unmarshal(jsonBytes, &decoded)
+	assert.NoError(t, err)
+	data, err := decoded.Bytes()
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", string(data))
+}
+
+type closeRecorder struct {
+	io.Reader
+	closed bool
+}
+
+func (r *closeRecorder) Close() error {
+	r.closed = true
+	return nil
+}
+
+func TestFileFromReader(t *testing.T) {
+	source := &closeRecorder{Reader: strings.NewReader("hello")}
+	var file File
+	file.InitFromReader(source, "hello.txt")
+	assert.Equal(t, "hello.txt", file.Filename())
+	assert.Equal(t, int64(-1), file.FileSize())
+
+	reader, err := file.Reader()
+	assert.NoError(t, err)
+	contents, err := ioutil.ReadAll(reader)
+	assert.NoError(t, err)
+	assert.NoError(t, reader.Close())
+	assert.Equal(t, "hello", string(contents))
+	assert.True(t, source.closed)
+}
@@ -0,0 +1,58 @@
+//go:build ignore
+// +build ignore
+
+// This generates nullable.gen.go, the Nullable types of the basic types, from
+// the template of the Nullable types which the generated code defines for its
+// own types, so that they stay the same.
+package main
+
+import (
+	"go/format"
+	"io/ioutil"
+	"log"
+	"text/template"
+
+	"github.com/deepmap/oapi-codegen/pkg/codegen"
+	"github.com/deepmap/oapi-codegen/pkg/codegen/templates"
+)
+
+// The Nullable types, mapped to the types they wrap
+var nullableTypes = map[string]string{
+	"NullableString":  "string",
+	"NullableInt":     "int",
+	"NullableInt32":   "int32",
+	"NullableInt64":   "int64",
+	"NullableFloat32": "float32",
+	"NullableFloat64": "float64",
+	"NullableBool":    "bool",
+	"NullableTime":    "time.Time",
+	"NullableDate":    "Date",
+}
+
+const header = `// Code generated by gen_nullable.go DO NOT EDIT.
+
+package types
+
+import (
+	"encoding/json"
+	"time"
+)
+`
+
+func main() {
+	t, err := templates.Parse(template.New("oapi-codegen").Funcs(codegen.TemplateFunctions))
+	if err != nil {
+		log.Fatal(err)
+	}
+	code, err := codegen.GenerateNullableTypes(t, nullableTypes, "")
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := format.Source([]byte(header + code))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("nullable.gen.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"fmt"
+	"net"
+)
+
+// IP is a value of the "ipv4" or "ipv6" string formats.
+type IP struct {
+	net.IP
+}
+
+// ParseIP parses an IPv4 or IPv6 address.
+func ParseIP(s string) (IP, error) {
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return IP{}, fmt.Errorf("invalid IP address '%s'", s)
+	}
+	return IP{IP: ip}, nil
+}
+
+// MarshalText writes the address, which is also used for JSON.
+func (ip IP) MarshalText() ([]byte, error) {
+	return []byte(ip.IP.String()), nil
+}
+
+// UnmarshalText parses an address, and fails when it isn't valid.
+func (ip *IP) UnmarshalText(data []byte) error {
+	parsed, err := ParseIP(string(data))
+	if err != nil {
+		return err
+	}
+	*ip = parsed
+	return nil
+}
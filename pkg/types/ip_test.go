@@ -0,0 +1,27 @@
+package types
+
+import (
+	"encoding/json"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIP_JSON(t *testing.T) {
+	b := struct {
+		V4 IP `json:"v4"`
+		V6 IP `json:"v6"`
+	}{}
+	err := json.Unmarshal([]byte(`{"v4":"192.168.0.1","v6":"::1"}`), &b)
+	assert.NoError(t, err)
+	assert.True(t, b.V4.Equal(net.IPv4(192, 168, 0, 1)))
+	assert.True(t, b.V6.IsLoopback())
+
+	jsonBytes, err := json.Marshal(b)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"v4":"192.168.0.1","v6":"::1"}`, string(jsonBytes))
+
+	err = json.Unmarshal([]byte(`{"v4":"192.168.0"}`), &b)
+	assert.Error(t, err)
+}
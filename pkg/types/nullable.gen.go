@@ -0,0 +1,764 @@
+// Code generated by gen_nullable.go DO NOT EDIT.
+
+package types
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// NullableBool is a Nullable bool, which may also be null or unspecified.
+type NullableBool map[bool]bool
+
+// NewNullableBool returns a NullableBool which is set to the given value.
+func NewNullableBool(v bool) NullableBool {
+	return NullableBool{true: v}
+}
+
+// NewNullNullableBool returns a NullableBool which is explicitly null.
+func NewNullNullableBool() NullableBool {
+	var zero bool
+	return NullableBool{false: zero}
+}
+
+// Get returns the value, or an error when it's null or unspecified.
+func (t NullableBool) Get() (bool, error) {
+	var zero bool
+	if t.IsNull() {
+		return zero, ErrNullableIsNull
+	}
+	if !t.IsSpecified() {
+		return zero, ErrNullableNotSpecified
+	}
+	return t[true], nil
+}
+
+// MustGet returns the value, and panics when it's null or unspecified.
+func (t NullableBool) MustGet() bool {
+	v, err := t.Get()
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Set sets the value.
+func (t *NullableBool) Set(v bool) {
+	*t = NullableBool{true: v}
+}
+
+// IsNull returns whether the value is explicitly null.
+func (t NullableBool) IsNull() bool {
+	_, found := t[false]
+	return found
+}
+
+// SetNull makes the value explicitly null.
+func (t *NullableBool) SetNull() {
+	var zero bool
+	*t = NullableBool{false: zero}
+}
+
+// IsSpecified returns whether the value is either null or set.
+func (t NullableBool) IsSpecified() bool {
+	return len(t) != 0
+}
+
+// SetUnspecified clears the value, so that it's omitted from JSON.
+func (t *NullableBool) SetUnspecified() {
+	*t = nil
+}
+
+// MarshalJSON writes the value, or null when it's null or unspecified.
+func (t NullableBool) MarshalJSON() ([]byte, error) {
+	if t.IsNull() || !t.IsSpecified() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t[true])
+}
+
+// UnmarshalJSON reads the value, recording an explicit null.
+func (t *NullableBool) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		t.SetNull()
+		return nil
+	}
+	var v bool
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	t.Set(v)
+	return nil
+}
+
+// NullableDate is a Nullable Date, which may also be null or unspecified.
+type NullableDate map[bool]Date
+
+// NewNullableDate returns a NullableDate which is set to the given value.
+func NewNullableDate(v Date) NullableDate {
+	return NullableDate{true: v}
+}
+
+// NewNullNullableDate returns a NullableDate which is explicitly null.
+func NewNullNullableDate() NullableDate {
+	var zero Date
+	return NullableDate{false: zero}
+}
+
+// Get returns the value, or an error when it's null or unspecified.
+func (t NullableDate) Get() (Date, error) {
+	var zero Date
+	if t.IsNull() {
+		return zero, ErrNullableIsNull
+	}
+	if !t.IsSpecified() {
+		return zero, ErrNullableNotSpecified
+	}
+	return t[true], nil
+}
+
+// MustGet returns the value, and panics when it's null or unspecified.
+func (t NullableDate) MustGet() Date {
+	v, err := t.Get()
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Set sets the value.
+func (t *NullableDate) Set(v Date) {
+	*t = NullableDate{true: v}
+}
+
+// IsNull returns whether the value is explicitly null.
+func (t NullableDate) IsNull() bool {
+	_, found := t[false]
+	return found
+}
+
+// SetNull makes the value explicitly null.
+func (t *NullableDate) SetNull() {
+	var zero Date
+	*t = NullableDate{false: zero}
+}
+
+// IsSpecified returns whether the value is either null or set.
+func (t NullableDate) IsSpecified() bool {
+	return len(t) != 0
+}
+
+// SetUnspecified clears the value, so that it's omitted from JSON.
+func (t *NullableDate) SetUnspecified() {
+	*t = nil
+}
+
+// MarshalJSON writes the value, or null when it's null or unspecified.
+func (t NullableDate) MarshalJSON() ([]byte, error) {
+	if t.IsNull() || !t.IsSpecified() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t[true])
+}
+
+// UnmarshalJSON reads the value, recording an explicit null.
+func (t *NullableDate) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		t.SetNull()
+		return nil
+	}
+	var v Date
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	t.Set(v)
+	return nil
+}
+
+// NullableFloat32 is a Nullable float32, which may also be null or unspecified.
+type NullableFloat32 map[bool]float32
+
+// NewNullableFloat32 returns a NullableFloat32 which is set to the given value.
+func NewNullableFloat32(v float32) NullableFloat32 {
+	return NullableFloat32{true: v}
+}
+
+// NewNullNullableFloat32 returns a NullableFloat32 which is explicitly null.
+func NewNullNullableFloat32() NullableFloat32 {
+	var zero float32
+	return NullableFloat32{false: zero}
+}
+
+// Get returns the value, or an error when it's null or unspecified.
+func (t NullableFloat32) Get() (float32, error) {
+	var zero float32
+	if t.IsNull() {
+		return zero, ErrNullableIsNull
+	}
+	if !t.IsSpecified() {
+		return zero, ErrNullableNotSpecified
+	}
+	return t[true], nil
+}
+
+// MustGet returns the value, and panics when it's null or unspecified.
+func (t NullableFloat32) MustGet() float32 {
+	v, err := t.Get()
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Set sets the value.
+func (t *NullableFloat32) Set(v float32) {
+	*t = NullableFloat32{true: v}
+}
+
+// IsNull returns whether the value is explicitly null.
+func (t NullableFloat32) IsNull() bool {
+	_, found := t[false]
+	return found
+}
+
+// SetNull makes the value explicitly null.
+func (t *NullableFloat32) SetNull() {
+	var zero float32
+	*t = NullableFloat32{false: zero}
+}
+
+// IsSpecified returns whether the value is either null or set.
+func (t NullableFloat32) IsSpecified() bool {
+	return len(t) != 0
+}
+
+// SetUnspecified clears the value, so that it's omitted from JSON.
+func (t *NullableFloat32) SetUnspecified() {
+	*t = nil
+}
+
+// MarshalJSON writes the value, or null when it's null or unspecified.
+func (t NullableFloat32) MarshalJSON() ([]byte, error) {
+	if t.IsNull() || !t.IsSpecified() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t[true])
+}
+
+// UnmarshalJSON reads the value, recording an explicit null.
+func (t *NullableFloat32) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		t.SetNull()
+		return nil
+	}
+	var v float32
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	t.Set(v)
+	return nil
+}
+
+// NullableFloat64 is a Nullable float64, which may also be null or unspecified.
+type NullableFloat64 map[bool]float64
+
+// NewNullableFloat64 returns a NullableFloat64 which is set to the given value.
+func NewNullableFloat64(v float64) NullableFloat64 {
+	return NullableFloat64{true: v}
+}
+
+// NewNullNullableFloat64 returns a NullableFloat64 which is explicitly null.
+func NewNullNullableFloat64() NullableFloat64 {
+	var zero float64
+	return NullableFloat64{false: zero}
+}
+
+// Get returns the value, or an error when it's null or unspecified.
+func (t NullableFloat64) Get() (float64, error) {
+	var zero float64
+	if t.IsNull() {
+		return zero, ErrNullableIsNull
+	}
+	if !t.IsSpecified() {
+		return zero, ErrNullableNotSpecified
+	}
+	return t[true], nil
+}
+
+// MustGet returns the value, and panics when it's null or unspecified.
+func (t NullableFloat64) MustGet() float64 {
+	v, err := t.Get()
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Set sets the value.
+func (t *NullableFloat64) Set(v float64) {
+	*t = NullableFloat64{true: v}
+}
+
+// IsNull returns whether the value is explicitly null.
+func (t NullableFloat64) IsNull() bool {
+	_, found := t[false]
+	return found
+}
+
+// SetNull makes the value explicitly null.
+func (t *NullableFloat64) SetNull() {
+	var zero float64
+	*t = NullableFloat64{false: zero}
+}
+
+// IsSpecified returns whether the value is either null or set.
+func (t NullableFloat64) IsSpecified() bool {
+	return len(t) != 0
+}
+
+// SetUnspecified clears the value, so that it's omitted from JSON.
+func (t *NullableFloat64) SetUnspecified() {
+	*t = nil
+}
+
+// MarshalJSON writes the value, or null when it's null or unspecified.
+func (t NullableFloat64) MarshalJSON() ([]byte, error) {
+	if t.IsNull() || !t.IsSpecified() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t[true])
+}
+
+// UnmarshalJSON reads the value, recording an explicit null.
+func (t *NullableFloat64) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		t.SetNull()
+		return nil
+	}
+	var v float64
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	t.Set(v)
+	return nil
+}
+
+// NullableInt is a Nullable int, which may also be null or unspecified.
+type NullableInt map[bool]int
+
+// NewNullableInt returns a NullableInt which is set to the given value.
+func NewNullableInt(v int) NullableInt {
+	return NullableInt{true: v}
+}
+
+// NewNullNullableInt returns a NullableInt which is explicitly null.
+func NewNullNullableInt() NullableInt {
+	var zero int
+	return NullableInt{false: zero}
+}
+
+// Get returns the value, or an error when it's null or unspecified.
+func (t NullableInt) Get() (int, error) {
+	var zero int
+	if t.IsNull() {
+		return zero, ErrNullableIsNull
+	}
+	if !t.IsSpecified() {
+		return zero, ErrNullableNotSpecified
+	}
+	return t[true], nil
+}
+
+// MustGet returns the value, and panics when it's null or unspecified.
+func (t NullableInt) MustGet() int {
+	v, err := t.Get()
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Set sets the value.
+func (t *NullableInt) Set(v int) {
+	*t = NullableInt{true: v}
+}
+
+// IsNull returns whether the value is explicitly null.
+func (t NullableInt) IsNull() bool {
+	_, found := t[false]
+	return found
+}
+
+// SetNull makes the value explicitly null.
+func (t *NullableInt) SetNull() {
+	var zero int
+	*t = NullableInt{false: zero}
+}
+
+// IsSpecified returns whether the value is either null or set.
+func (t NullableInt) IsSpecified() bool {
+	return len(t) != 0
+}
+
+// SetUnspecified clears the value, so that it's omitted from JSON.
+func (t *NullableInt) SetUnspecified() {
+	*t = nil
+}
+
+// MarshalJSON writes the value, or null when it's null or unspecified.
+func (t NullableInt) MarshalJSON() ([]byte, error) {
+	if t.IsNull() || !t.IsSpecified() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t[true])
+}
+
+// UnmarshalJSON reads the value, recording an explicit null.
+func (t *NullableInt) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		t.SetNull()
+		return nil
+	}
+	var v int
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	t.Set(v)
+	return nil
+}
+
+// NullableInt32 is a Nullable int32, which may also be null or unspecified.
+type NullableInt32 map[bool]int32
+
+// NewNullableInt32 returns a NullableInt32 which is set to the given value.
+func NewNullableInt32(v int32) NullableInt32 {
+	return NullableInt32{true: v}
+}
+
+// NewNullNullableInt32 returns a NullableInt32 which is explicitly null.
+func NewNullNullableInt32() NullableInt32 {
+	var zero int32
+	return NullableInt32{false: zero}
+}
+
+// Get returns the value, or an error when it's null or unspecified.
+func (t NullableInt32) Get() (int32, error) {
+	var zero int32
+	if t.IsNull() {
+		return zero, ErrNullableIsNull
+	}
+	if !t.IsSpecified() {
+		return zero, ErrNullableNotSpecified
+	}
+	return t[true], nil
+}
+
+// MustGet returns the value, and panics when it's null or unspecified.
+func (t NullableInt32) MustGet() int32 {
+	v, err := t.Get()
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Set sets the value.
+func (t *NullableInt32) Set(v int32) {
+	*t = NullableInt32{true: v}
+}
+
+// IsNull returns whether the value is explicitly null.
+func (t NullableInt32) IsNull() bool {
+	_, found := t[false]
+	return found
+}
+
+// SetNull makes the value explicitly null.
+func (t *NullableInt32) SetNull() {
+	var zero int32
+	*t = NullableInt32{false: zero}
+}
+
+// IsSpecified returns whether the value is either null or set.
+func (t NullableInt32) IsSpecified() bool {
+	return len(t) != 0
+}
+
+// SetUnspecified clears the value, so that it's omitted from JSON.
+func (t *NullableInt32) SetUnspecified() {
+	*t = nil
+}
+
+// MarshalJSON writes the value, or null when it's null or unspecified.
+func (t NullableInt32) MarshalJSON() ([]byte, error) {
+	if t.IsNull() || !t.IsSpecified() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t[true])
+}
+
+// UnmarshalJSON reads the value, recording an explicit null.
+func (t *NullableInt32) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		t.SetNull()
+		return nil
+	}
+	var v int32
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	t.Set(v)
+	return nil
+}
+
+// NullableInt64 is a Nullable int64, which may also be null or unspecified.
+type NullableInt64 map[bool]int64
+
+// NewNullableInt64 returns a NullableInt64 which is set to the given value.
+func NewNullableInt64(v int64) NullableInt64 {
+	return NullableInt64{true: v}
+}
+
+// NewNullNullableInt64 returns a NullableInt64 which is explicitly null.
+func NewNullNullableInt64() NullableInt64 {
+	var zero int64
+	return NullableInt64{false: zero}
+}
+
+// Get returns the value, or an error when it's null or unspecified.
+func (t NullableInt64) Get() (int64, error) {
+	var zero int64
+	if t.IsNull() {
+		return zero, ErrNullableIsNull
+	}
+	if !t.IsSpecified() {
+		return zero, ErrNullableNotSpecified
+	}
+	return t[true], nil
+}
+
+// MustGet returns the value, and panics when it's null or unspecified.
+func (t NullableInt64) MustGet() int64 {
+	v, err := t.Get()
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Set sets the value.
+func (t *NullableInt64) Set(v int64) {
+	*t = NullableInt64{true: v}
+}
+
+// IsNull returns whether the value is explicitly null.
+func (t NullableInt64) IsNull() bool {
+	_, found := t[false]
+	return found
+}
+
+// SetNull makes the value explicitly null.
+func (t *NullableInt64) SetNull() {
+	var zero int64
+	*t = NullableInt64{false: zero}
+}
+
+// IsSpecified returns whether the value is either null or set.
+func (t NullableInt64) IsSpecified() bool {
+	return len(t) != 0
+}
+
+// SetUnspecified clears the value, so that it's omitted from JSON.
+func (t *NullableInt64) SetUnspecified() {
+	*t = nil
+}
+
+// MarshalJSON writes the value, or null when it's null or unspecified.
+func (t NullableInt64) MarshalJSON() ([]byte, error) {
+	if t.IsNull() || !t.IsSpecified() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t[true])
+}
+
+// UnmarshalJSON reads the value, recording an explicit null.
+func (t *NullableInt64) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		t.SetNull()
+		return nil
+	}
+	var v int64
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	t.Set(v)
+	return nil
+}
+
+// NullableString is a Nullable string, which may also be null or unspecified.
+type NullableString map[bool]string
+
+// NewNullableString returns a NullableString which is set to the given value.
+func NewNullableString(v string) NullableString {
+	return NullableString{true: v}
+}
+
+// NewNullNullableString returns a NullableString which is explicitly null.
+func NewNullNullableString() NullableString {
+	var zero string
+	return NullableString{false: zero}
+}
+
+// Get returns the value, or an error when it's null or unspecified.
+func (t NullableString) Get() (string, error) {
+	var zero string
+	if t.IsNull() {
+		return zero, ErrNullableIsNull
+	}
+	if !t.IsSpecified() {
+		return zero, ErrNullableNotSpecified
+	}
+	return t[true], nil
+}
+
+// MustGet returns the value, and panics when it's null or unspecified.
+func (t NullableString) MustGet() string {
+	v, err := t.Get()
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Set sets the value.
+func (t *NullableString) Set(v string) {
+	*t = NullableString{true: v}
+}
+
+// IsNull returns whether the value is explicitly null.
+func (t NullableString) IsNull() bool {
+	_, found := t[false]
+	return found
+}
+
+// SetNull makes the value explicitly null.
+func (t *NullableString) SetNull() {
+	var zero string
+	*t = NullableString{false: zero}
+}
+
+// IsSpecified returns whether the value is either null or set.
+func (t NullableString) IsSpecified() bool {
+	return len(t) != 0
+}
+
+// SetUnspecified clears the value, so that it's omitted from JSON.
+func (t *NullableString) SetUnspecified() {
+	*t = nil
+}
+
+// MarshalJSON writes the value, or null when it's null or unspecified.
+func (t NullableString) MarshalJSON() ([]byte, error) {
+	if t.IsNull() || !t.IsSpecified() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t[true])
+}
+
+// UnmarshalJSON reads the value, recording an explicit null.
+func (t *NullableString) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		t.SetNull()
+		return nil
+	}
+	var v string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	t.Set(v)
+	return nil
+}
+
+// NullableTime is a Nullable time.Time, which may also be null or unspecified.
+type NullableTime map[bool]time.Time
+
+// NewNullableTime returns a NullableTime which is set to the given value.
+func NewNullableTime(v time.Time) NullableTime {
+	return NullableTime{true: v}
+}
+
+// NewNullNullableTime returns a NullableTime which is explicitly null.
+func NewNullNullableTime() NullableTime {
+	var zero time.Time
+	return NullableTime{false: zero}
+}
+
+// Get returns the value, or an error when it's null or unspecified.
+func (t NullableTime) Get() (time.Time, error) {
+	var zero time.Time
+	if t.IsNull() {
+		return zero, ErrNullableIsNull
+	}
+	if !t.IsSpecified() {
+		return zero, ErrNullableNotSpecified
+	}
+	return t[true], nil
+}
+
+// MustGet returns the value, and panics when it's null or unspecified.
+func (t NullableTime) MustGet() time.Time {
+	v, err := t.Get()
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Set sets the value.
+func (t *NullableTime) Set(v time.Time) {
+	*t = NullableTime{true: v}
+}
+
+// IsNull returns whether the value is explicitly null.
+func (t NullableTime) IsNull() bool {
+	_, found := t[false]
+	return found
+}
+
+// SetNull makes the value explicitly null.
+func (t *NullableTime) SetNull() {
+	var zero time.Time
+	*t = NullableTime{false: zero}
+}
+
+// IsSpecified returns whether the value is either null or set.
+func (t NullableTime) IsSpecified() bool {
+	return len(t) != 0
+}
+
+// SetUnspecified clears the value, so that it's omitted from JSON.
+func (t *NullableTime) SetUnspecified() {
+	*t = nil
+}
+
+// MarshalJSON writes the value, or null when it's null or unspecified.
+func (t NullableTime) MarshalJSON() ([]byte, error) {
+	if t.IsNull() || !t.IsSpecified() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t[true])
+}
+
+// UnmarshalJSON reads the value, recording an explicit null.
+func (t *NullableTime) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		t.SetNull()
+		return nil
+	}
+	var v time.Time
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	t.Set(v)
+	return nil
+}
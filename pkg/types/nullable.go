@@ -0,0 +1,24 @@
+package types
+
+//go:generate go run gen_nullable.go
+
+import "errors"
+
+// The Nullable types distinguish between three states of a JSON value, which
+// matters for nullable properties, eg, in a JSON merge patch:
+//  - unspecified, when the value is absent from the JSON
+//  - null, when the value is explicitly null
+//  - specified, when the value is present and not null
+//
+// Each is a map rather than a struct, so that an unspecified value is a nil
+// map, which is left out by the "omitempty" JSON tag. The map is keyed by
+// whether the value is specified; a null value is stored under false.
+
+var (
+	// ErrNullableIsNull is returned when getting the value of a Nullable which
+	// is explicitly null.
+	ErrNullableIsNull = errors.New("value is null")
+	// ErrNullableNotSpecified is returned when getting the value of a Nullable
+	// which was never specified.
+	ErrNullableNotSpecified = errors.New("value is not specified")
+)
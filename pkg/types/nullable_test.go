@@ -0,0 +1,59 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNullableString(t *testing.T) {
+	var s NullableString
+	assert.False(t, s.IsSpecified())
+	assert.False(t, s.IsNull())
+	_, err := s.Get()
+	assert.Equal(t, ErrNullableNotSpecified, err)
+
+	s.SetNull()
+	assert.True(t, s.IsSpecified())
+	assert.True(t, s.IsNull())
+	_, err = s.Get()
+	assert.Equal(t, ErrNullableIsNull, err)
+	assert.Panics(t, func() { s.MustGet() })
+
+	s.Set("")
+	assert.True(t, s.IsSpecified())
+	assert.False(t, s.IsNull())
+	assert.Equal(t, "", s.MustGet())
+
+	s.SetUnspecified()
+	assert.False(t, s.IsSpecified())
+}
+
+func TestNullable_JSON(t *testing.T) {
+	type object struct {
+		Name     NullableString `json:"name,omitempty"`
+		Count    NullableInt    `json:"count,omitempty"`
+		Birthday NullableDate   `json:"birthday,omitempty"`
+	}
+
+	var obj object
+	err := json.Unmarshal([]byte(`{"name":null,"birthday":"2019-04-01"}`), &obj)
+	assert.NoError(t, err)
+	assert.True(t, obj.Name.IsNull())
+	assert.False(t, obj.Count.IsSpecified())
+	assert.Equal(t, time.Date(2019, 4, 1, 0, 0, 0, 0, time.UTC), obj.Birthday.MustGet().Time)
+
+	// Unspecified values are omitted, and null ones written
+	obj.Count = NewNullableInt(0)
+	jsonBytes, err := json.Marshal(obj)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"name":null,"count":0,"birthday":"2019-04-01"}`, string(jsonBytes))
+
+	obj.Name.SetUnspecified()
+	obj.Birthday = NewNullNullableDate()
+	jsonBytes, err = json.Marshal(obj)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"count":0,"birthday":null}`, string(jsonBytes))
+}
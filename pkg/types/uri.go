@@ -0,0 +1,39 @@
+package types
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// URI is a value of the "uri" string format, which must be absolute; that is,
+// it has a scheme.
+type URI struct {
+	url.URL
+}
+
+// ParseURI parses an absolute URI.
+func ParseURI(s string) (URI, error) {
+	parsed, err := url.Parse(s)
+	if err != nil {
+		return URI{}, fmt.Errorf("invalid URI '%s': %s", s, err)
+	}
+	if !parsed.IsAbs() {
+		return URI{}, fmt.Errorf("invalid URI '%s': it has no scheme", s)
+	}
+	return URI{URL: *parsed}, nil
+}
+
+// MarshalText writes the URI, which is also used for JSON.
+func (u URI) MarshalText() ([]byte, error) {
+	return []byte(u.URL.String()), nil
+}
+
+// UnmarshalText parses a URI, and fails when it isn't valid.
+func (u *URI) UnmarshalText(data []byte) error {
+	parsed, err := ParseURI(string(data))
+	if err != nil {
+		return err
+	}
+	*u = parsed
+	return nil
+}
@@ -0,0 +1,25 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestURI_JSON(t *testing.T) {
+	b := struct {
+		Link URI `json:"link"`
+	}{}
+	err := json.Unmarshal([]byte(`{"link":"https://example.com/pets?limit=10"}`), &b)
+	assert.NoError(t, err)
+	assert.Equal(t, "example.com", b.Link.Host)
+	assert.Equal(t, "/pets", b.Link.Path)
+
+	jsonBytes, err := json.Marshal(b)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"link":"https://example.com/pets?limit=10"}`, string(jsonBytes))
+
+	err = json.Unmarshal([]byte(`{"link":"/relative/path"}`), &b)
+	assert.Error(t, err)
+}
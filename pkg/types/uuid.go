@@ -0,0 +1,55 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+)
+
+// UUID is a value of the "uuid" string format, as described by RFC 4122.
+type UUID [16]byte
+
+// ParseUUID parses a UUID in its canonical, hyphenated form, such as
+// "f81d4fae-7dec-11d0-a765-00a0c91e6bf6". Hex digits may be of either case.
+func ParseUUID(s string) (UUID, error) {
+	var u UUID
+	if len(s) != 36 || s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+		return u, fmt.Errorf("invalid UUID '%s'", s)
+	}
+	digits := s[0:8] + s[9:13] + s[14:18] + s[19:23] + s[24:36]
+	_, err := hex.Decode(u[:], []byte(digits))
+	if err != nil {
+		return u, fmt.Errorf("invalid UUID '%s': %s", s, err)
+	}
+	return u, nil
+}
+
+// String returns the canonical, lower case form of the UUID.
+func (u UUID) String() string {
+	buf := make([]byte, 36)
+	hex.Encode(buf[0:8], u[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], u[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], u[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], u[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:36], u[10:16])
+	return string(buf)
+}
+
+// MarshalText writes the canonical form of the UUID, which is also used for
+// JSON.
+func (u UUID) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+// UnmarshalText parses a UUID, and fails when it isn't valid.
+func (u *UUID) UnmarshalText(data []byte) error {
+	parsed, err := ParseUUID(string(data))
+	if err != nil {
+		return err
+	}
+	*u = parsed
+	return nil
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUUID_JSON(t *testing.T) {
+	b := struct {
+		ID UUID `json:"id"`
+	}{}
+	err := json.Unmarshal([]byte(`{"id":"F81D4FAE-7DEC-11D0-A765-00A0C91E6BF6"}`), &b)
+	assert.NoError(t, err)
+	assert.Equal(t, byte(0xf8), b.ID[0])
+	assert.Equal(t, byte(0xf6), b.ID[15])
+
+	jsonBytes, err := json.Marshal(b)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"id":"f81d4fae-7dec-11d0-a765-00a0c91e6bf6"}`, string(jsonBytes))
+
+	for _, invalid := range []string{"", "f81d4fae7dec11d0a76500a0c91e6bf6", "f81d4fae-7dec-11d0-a765-00a0c91e6bfg"} {
+		_, err = ParseUUID(invalid)
+		assert.Error(t, err, invalid)
+	}
+	err = json.Unmarshal([]byte(`{"id":"nope"}`), &b)
+	assert.Error(t, err)
+}
@@ -1,32 +1,322 @@
 package util
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
 )
 
+// The location of a spec which is read from the standard input.
+const StdinLocation = "-"
+
+// How long fetching a document from a URL may take, unless LoadOptions has an
+// HTTPClient of its own.
+const fetchTimeout = 30 * time.Second
+
+// LoadOptions holds the settings for loading specs from other places than
+// local files.
+type LoadOptions struct {
+	// Headers are sent with the requests for specs at http(s) URLs, and for
+	// the documents they refer to on the same host, or on one of the
+	// HeaderHosts, eg, {"Authorization": "Bearer ..."}.
+	Headers map[string]string
+	// HeaderHosts are the other hosts, as in example.com or example.com:8080,
+	// which the Headers are sent to. Documents on any other host are fetched
+	// without them, so that credentials aren't given away to the hosts which
+	// a spec refers to.
+	HeaderHosts []string
+	// HTTPClient fetches specs from URLs. When nil, a client which gives up
+	// after 30 seconds is used. A copy of it is used, whose CheckRedirect
+	// withholds the Headers from other hosts before calling the client's own.
+	HTTPClient *http.Client
+	// Stdin is read for the spec at StdinLocation. It's os.Stdin when nil.
+	Stdin io.Reader
+}
+
+// LoadSwagger loads a spec from a file. References to other files are
+// resolved relative to the directory of the spec. Swagger 2.0 specs are
+// converted to OpenAPI 3.0, as described by ConvertSwagger2, and OpenAPI 3.1
+// specs are converted to 3.0, as described by ConvertOpenAPI31.
 func LoadSwagger(filePath string) (*openapi3.Swagger, error) {
-	data, err := ioutil.ReadFile(filePath)
-	if err != nil {
-		return nil, err
+	swagger, _, err := LoadSwaggerWithWarnings(filePath)
+	return swagger, err
+}
+
+// LoadSwaggerWithWarnings loads a spec like LoadSwagger, and also returns
+// warnings about anything which couldn't be converted exactly, when it's a
+// Swagger 2.0 spec.
+func LoadSwaggerWithWarnings(filePath string) (*openapi3.Swagger, []string, error) {
+	return LoadSwaggerWithOptions(filePath, LoadOptions{})
+}
+
+// LoadSwaggerWithOptions loads a spec like LoadSwaggerWithWarnings, from a
+// location which is a file path, an http(s) URL, or StdinLocation. Whether
+// a spec is JSON or YAML is told from its content, rather than its name.
+// References to other documents are resolved relative to the location, so
+// they're fetched from the same server as a spec at a URL, and relative to
+// the working directory for a spec read from the standard input.
+func LoadSwaggerWithOptions(location string, opts LoadOptions) (*openapi3.Swagger, []string, error) {
+	l := &specLoader{
+		opts:   opts,
+		loaded: make(map[string]*openapi3.Swagger),
 	}
+	if opts.HTTPClient != nil {
+		client := *opts.HTTPClient
+		l.nextCheckRedirect = client.CheckRedirect
+		client.CheckRedirect = l.checkRedirect
+		l.client = &client
+	} else {
+		l.client = &http.Client{
+			Timeout:       fetchTimeout,
+			CheckRedirect: l.checkRedirect,
+		}
+	}
+	return l.load(location)
+}
+
+// specLoader loads a spec, and the documents it refers to, with the same
+// options.
+type specLoader struct {
+	opts     LoadOptions
+	client   *http.Client
+	origin   *url.URL                     // The URL of the spec, when it's at one
+	loaded   map[string]*openapi3.Swagger // Documents which are referred to, by their URLs
+	warnings []string
+
+	// The CheckRedirect of LoadOptions.HTTPClient, if any
+	nextCheckRedirect func(req *http.Request, via []*http.Request) error
+}
 
-	var swagger *openapi3.Swagger
-	ext := filepath.Ext(filePath)
-	ext = strings.ToLower(ext)
-	switch ext {
-	// The YAML handler can parse both YAML and JSON
-	case ".yaml", ".yml", ".json":
-		swagger, err = openapi3.NewSwaggerLoader().LoadSwaggerFromData(data)
+func (l *specLoader) load(location string) (*openapi3.Swagger, []string, error) {
+	var uri *url.URL
+	var data []byte
+	var err error
+	switch {
+	case location == StdinLocation:
+		uri = &url.URL{Path: StdinLocation}
+		stdin := l.opts.Stdin
+		if stdin == nil {
+			stdin = os.Stdin
+		}
+		data, err = ioutil.ReadAll(stdin)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error reading spec from standard input")
+		}
+	case isHTTPURL(location):
+		uri, err = url.Parse(location)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error parsing spec URL")
+		}
+		l.origin = uri
+		data, err = l.read(uri)
 	default:
-		return nil, fmt.Errorf("%s is not a supported extension, use .yaml, .yml or .json", ext)
+		uri = &url.URL{Path: filepath.ToSlash(location)}
+		data, err = ioutil.ReadFile(location)
 	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, warnings, err := convertSpec(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	l.warnings = warnings
+
+	loader := openapi3.NewSwaggerLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.LoadSwaggerFromURIFunc = l.loadReferenced
+	swagger, err := loader.LoadSwaggerFromDataWithPath(data, uri)
+	if err != nil {
+		return nil, nil, err
+	}
+	return swagger, l.warnings, nil
+}
+
+// This loads a document which the spec refers to, in the same way as the
+// spec. Each is loaded once, however many references there are to it.
+func (l *specLoader) loadReferenced(loader *openapi3.SwaggerLoader, uri *url.URL) (*openapi3.Swagger, error) {
+	uri = repairJoinedURL(uri)
+	if swagger, found := l.loaded[uri.String()]; found {
+		return swagger, nil
+	}
+	data, err := l.read(uri)
 	if err != nil {
 		return nil, err
 	}
+	data, warnings, err := convertSpec(data)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("error loading %s", uri))
+	}
+	for _, warning := range warnings {
+		l.warnings = append(l.warnings, fmt.Sprintf("%s: %s", uri, warning))
+	}
+	swagger, err := loader.LoadSwaggerFromDataWithPath(data, uri)
+	if err != nil {
+		return nil, err
+	}
+	l.loaded[uri.String()] = swagger
 	return swagger, nil
 }
+
+// This reads the document at a URL, which is either http(s), or a file path.
+func (l *specLoader) read(uri *url.URL) ([]byte, error) {
+	if uri.Scheme == "" && uri.Host == "" {
+		return ioutil.ReadFile(filepath.FromSlash(uri.Path))
+	}
+	if uri.Scheme != "http" && uri.Scheme != "https" {
+		return nil, fmt.Errorf("unsupported URL %s, only http and https URLs are", uri)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, uri.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if l.sendsHeaders(uri) {
+		for name, value := range l.opts.Headers {
+			req.Header.Set(name, value)
+		}
+	}
+	resp, err := l.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("error fetching %s", uri))
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error fetching %s: %s", uri, resp.Status)
+	}
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("error reading %s", uri))
+	}
+	return data, nil
+}
+
+// This tells whether the headers are sent to a URL, which they are when it's
+// on the scheme and host of the spec, or on one of the HeaderHosts.
+func (l *specLoader) sendsHeaders(uri *url.URL) bool {
+	if l.origin != nil && strings.EqualFold(uri.Scheme, l.origin.Scheme) && strings.EqualFold(uri.Host, l.origin.Host) {
+		return true
+	}
+	for _, host := range l.opts.HeaderHosts {
+		if strings.EqualFold(uri.Host, host) || strings.EqualFold(uri.Hostname(), host) {
+			return true
+		}
+	}
+	return false
+}
+
+// This follows redirects like the client's own CheckRedirect, or the default
+// client, does, except that the headers aren't sent on to the hosts which
+// they aren't for.
+func (l *specLoader) checkRedirect(req *http.Request, via []*http.Request) error {
+	if !l.sendsHeaders(req.URL) {
+		for name := range l.opts.Headers {
+			req.Header.Del(name)
+		}
+	}
+	if l.nextCheckRedirect != nil {
+		return l.nextCheckRedirect(req, via)
+	}
+	if len(via) >= 10 {
+		return errors.New("stopped after 10 redirects")
+	}
+	return nil
+}
+
+// kin-openapi joins the locations of the documents which are referred to
+// with path.Join, which turns the "//" of a URL into "/", so the host ends up
+// at the start of the path. This moves it back.
+func repairJoinedURL(uri *url.URL) *url.URL {
+	if uri.Host != "" || (uri.Scheme != "http" && uri.Scheme != "https") {
+		return uri
+	}
+	parts := strings.SplitN(strings.TrimPrefix(uri.Path, "/"), "/", 2)
+	repaired := *uri
+	repaired.Host = parts[0]
+	repaired.Path = "/"
+	if len(parts) == 2 {
+		repaired.Path += parts[1]
+	}
+	return &repaired
+}
+
+// This checks that a document is a spec, in YAML or JSON, and converts it to
+// OpenAPI 3.0 when it's Swagger 2.0 or OpenAPI 3.1.
+func convertSpec(data []byte) ([]byte, []string, error) {
+	root, err := decodeDocument(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	if root == nil {
+		return nil, nil, errors.New("the spec isn't a YAML or JSON object")
+	}
+	data, warnings, err := ConvertSwagger2(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	data, err = ConvertOpenAPI31(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	return data, warnings, nil
+}
+
+func isHTTPURL(location string) bool {
+	lower := strings.ToLower(location)
+	return strings.HasPrefix(lower, "http://") || strings.HasPrefix(lower, "https://")
+}
+
+// This decodes a spec, in YAML or JSON, into the values the JSON package
+// decodes, so that specs in either format can be converted the same way.
+// It returns nil when the spec isn't an object.
+func decodeDocument(data []byte) (map[string]interface{}, error) {
+	var doc interface{}
+	var err error
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) != 0 && trimmed[0] == '{' {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.UseNumber()
+		err = decoder.Decode(&doc)
+	} else {
+		err = yaml.Unmarshal(data, &doc)
+		doc = jsonValue(doc)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "error parsing spec")
+	}
+	root, _ := doc.(map[string]interface{})
+	return root, nil
+}
+
+// This converts the values decoded by the YAML package into the ones the JSON
+// package decodes, so that they can be encoded as JSON again. Object keys may
+// be any type in YAML, such as the integer status codes of responses.
+func jsonValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		object := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			object[fmt.Sprint(key)] = jsonValue(item)
+		}
+		return object
+	case []interface{}:
+		for i, item := range v {
+			v[i] = jsonValue(item)
+		}
+		return v
+	}
+	return value
+}
@@ -0,0 +1,191 @@
+package util
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const loaderSpec = `
+openapi: 3.0.1
+info:
+  title: Loader
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      responses:
+        200:
+          description: Pets
+          content:
+            application/json:
+              schema:
+                $ref: 'common/schemas.json#/components/schemas/Pet'
+`
+
+const loaderSchemas = `{
+  "openapi": "3.0.1",
+  "info": {"title": "Schemas", "version": "1.0.0"},
+  "paths": {},
+  "components": {
+    "schemas": {
+      "Pet": {"type": "object", "properties": {"name": {"type": "string"}}}
+    }
+  }
+}`
+
+// This serves the spec, and the document it refers to, to requests which
+// have the token, under names which don't say whether they're YAML or JSON.
+func newSpecServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/specs/pets":
+			_, _ = w.Write([]byte(loaderSpec))
+		case "/specs/common/schemas.json":
+			_, _ = w.Write([]byte(loaderSchemas))
+		case "/specs/page":
+			_, _ = w.Write([]byte("<html><body>Not a spec</body></html>"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestLoadSwaggerFromURL(t *testing.T) {
+	server := newSpecServer()
+	defer server.Close()
+	opts := LoadOptions{Headers: map[string]string{"Authorization": "Bearer secret"}}
+
+	swagger, warnings, err := LoadSwaggerWithOptions(server.URL+"/specs/pets", opts)
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+	schema := swagger.Paths["/pets"].Get.Responses["200"].Value.Content["application/json"].Schema
+	assert.Equal(t, "common/schemas.json#/components/schemas/Pet", schema.Ref)
+	assert.Equal(t, "string", schema.Value.Properties["name"].Value.Type)
+
+	_, _, err = LoadSwaggerWithOptions(server.URL+"/specs/pets", LoadOptions{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "401 Unauthorized")
+
+	_, _, err = LoadSwaggerWithOptions(server.URL+"/specs/missing", opts)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "404 Not Found")
+
+	_, _, err = LoadSwaggerWithOptions(server.URL+"/specs/page", opts)
+	require.Error(t, err)
+}
+
+func TestLoadSwaggerHeaderHosts(t *testing.T) {
+	// The schemas are on another host, which records the tokens it's sent.
+	var received []string
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = append(received, r.Header.Get("Authorization")+"|"+r.Header.Get("X-Token"))
+		_, _ = w.Write([]byte(loaderSchemas))
+	}))
+	defer other.Close()
+	spec := strings.Replace(loaderSpec, "common/schemas.json", other.URL+"/schemas.json", 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(spec))
+	}))
+	defer server.Close()
+	headers := map[string]string{"Authorization": "Bearer secret", "X-Token": "token"}
+
+	swagger, _, err := LoadSwaggerWithOptions(server.URL+"/pets", LoadOptions{Headers: headers})
+	require.NoError(t, err)
+	schema := swagger.Paths["/pets"].Get.Responses["200"].Value.Content["application/json"].Schema
+	assert.Equal(t, "string", schema.Value.Properties["name"].Value.Type)
+	assert.Equal(t, []string{"|"}, received)
+
+	// Unless the other host is allowed to have them
+	otherURL, err := url.Parse(other.URL)
+	require.NoError(t, err)
+	received = nil
+	_, _, err = LoadSwaggerWithOptions(server.URL+"/pets", LoadOptions{Headers: headers, HeaderHosts: []string{otherURL.Host}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Bearer secret|token"}, received)
+
+	// Nor are they sent on when a request is redirected to another host
+	redirect := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, other.URL+"/schemas.json", http.StatusFound)
+	}))
+	defer redirect.Close()
+	received = nil
+	_, _, err = LoadSwaggerWithOptions(redirect.URL+"/schemas.json", LoadOptions{Headers: headers})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"|"}, received)
+
+	// by the client in the options either, whose own CheckRedirect is still
+	// called, without the client being changed.
+	var redirected []string
+	client := &http.Client{CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		redirected = append(redirected, req.URL.String())
+		return nil
+	}}
+	received = nil
+	_, _, err = LoadSwaggerWithOptions(redirect.URL+"/schemas.json", LoadOptions{Headers: headers, HTTPClient: client})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"|"}, received)
+	assert.Equal(t, []string{other.URL + "/schemas.json"}, redirected)
+
+	received = nil
+	req, err := http.NewRequest("GET", redirect.URL+"/schemas.json", nil)
+	require.NoError(t, err)
+	req.Header.Set("X-Token", "token")
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, []string{"|token"}, received)
+	assert.Len(t, redirected, 2)
+}
+
+func TestLoadSwaggerFromStdin(t *testing.T) {
+	// References from a spec on the standard input are relative to the
+	// working directory.
+	dir, err := ioutil.TempDir("", "loader")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "common"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "common", "schemas.json"), []byte(loaderSchemas), 0644))
+
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(dir))
+	defer func() {
+		_ = os.Chdir(wd)
+	}()
+
+	swagger, _, err := LoadSwaggerWithOptions(StdinLocation, LoadOptions{Stdin: strings.NewReader(loaderSpec)})
+	require.NoError(t, err)
+	schema := swagger.Paths["/pets"].Get.Responses["200"].Value.Content["application/json"].Schema
+	assert.Equal(t, "string", schema.Value.Properties["name"].Value.Type)
+
+	// The content tells whether it's JSON, not the name
+	swagger, _, err = LoadSwaggerWithOptions(StdinLocation, LoadOptions{Stdin: strings.NewReader(loaderSchemas)})
+	require.NoError(t, err)
+	assert.Contains(t, swagger.Components.Schemas, "Pet")
+
+	require.NoError(t, ioutil.WriteFile("schemas.txt", []byte(loaderSchemas), 0644))
+	swagger, err = LoadSwagger("schemas.txt")
+	require.NoError(t, err)
+	assert.Contains(t, swagger.Components.Schemas, "Pet")
+
+	_, _, err = LoadSwaggerWithOptions(StdinLocation, LoadOptions{Stdin: strings.NewReader("just some text")})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "isn't a YAML or JSON object")
+}
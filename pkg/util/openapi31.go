@@ -0,0 +1,366 @@
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// The version which OpenAPI 3.1 documents are converted to.
+const convertedOpenAPIVersion = "3.0.3"
+
+// The keywords of a JSON schema whose values are lists of schemas, single
+// schemas, and objects of schemas, respectively.
+var (
+	schemaListKeywords   = []string{"allOf", "anyOf", "oneOf", "prefixItems"}
+	schemaKeywords       = []string{"items", "additionalProperties", "not", "contains", "if", "then", "else"}
+	schemaObjectKeywords = []string{"properties", "patternProperties", "dependentSchemas"}
+)
+
+// ConvertOpenAPI31 converts an OpenAPI 3.1 document, in YAML or JSON, into
+// the OpenAPI 3.0 document which describes the same types as closely as it
+// can, since kin-openapi, and so the code generator, only understands 3.0.
+// Other documents are returned unchanged. The converted document is JSON.
+//
+// The JSON Schema 2020-12 keywords which 3.0 lacks are converted as follows:
+//   - type: [string, "null"] becomes type: string, with nullable: true, and a
+//     oneOf or anyOf with a {type: "null"} member becomes nullable too.
+//     Types listing more than one other type are left out, allowing any value.
+//   - const becomes an enum of the one value.
+//   - prefixItems becomes the items of the array, when all of the items have
+//     the same schema, and an array of any values otherwise.
+//   - $defs become component schemas, keeping their names where they're free,
+//     and references to them are updated.
+//   - examples becomes an example, of the first of them.
+//   - numeric exclusiveMinimum and exclusiveMaximum become a minimum or
+//     maximum, along with the boolean keyword.
+func ConvertOpenAPI31(data []byte) ([]byte, error) {
+	root, err := decodeDocument(data)
+	if err != nil {
+		return nil, err
+	}
+	version, _ := root["openapi"].(string)
+	if !strings.HasPrefix(version, "3.1") {
+		return data, nil
+	}
+
+	c := converter{
+		root:    root,
+		defRefs: make(map[string]string),
+		defs:    make(map[string]interface{}),
+	}
+	c.convertDocument(root, "#")
+	c.addDefs()
+	rewriteRefs(root, c.defRefs)
+
+	root["openapi"] = convertedOpenAPIVersion
+	delete(root, "jsonSchemaDialect")
+
+	converted, err := json.Marshal(root)
+	if err != nil {
+		return nil, errors.Wrap(err, "error encoding converted spec")
+	}
+	return converted, nil
+}
+
+type converter struct {
+	root    map[string]interface{}
+	defRefs map[string]string      // Pointers to $defs, mapped to the references to the schemas they become
+	defs    map[string]interface{} // Component schemas made from $defs
+}
+
+// This converts the schemas in the part of the document at the given JSON
+// pointer. Schemas are found under "schema" keys, such as in parameters and
+// media types, and in the schemas of the components. Examples and extensions
+// hold arbitrary values, so we leave them alone.
+func (c *converter) convertDocument(value interface{}, pointer string) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		// Keys are sorted, so that the names given to $defs don't change
+		for _, key := range sortedKeys(v) {
+			item := v[key]
+			itemPointer := pointer + "/" + escapePointer(key)
+			switch {
+			case key == "schema":
+				c.convertSchema(item, itemPointer)
+			case key == "schemas" && pointer == "#/components":
+				if schemas, ok := item.(map[string]interface{}); ok {
+					for _, name := range sortedKeys(schemas) {
+						c.convertSchema(schemas[name], itemPointer+"/"+escapePointer(name))
+					}
+				}
+			case key == "example" || key == "examples" || strings.HasPrefix(key, "x-"):
+			default:
+				c.convertDocument(item, itemPointer)
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			c.convertDocument(item, fmt.Sprintf("%s/%d", pointer, i))
+		}
+	}
+}
+
+// This converts a schema, and the schemas within it, in place.
+func (c *converter) convertSchema(value interface{}, pointer string) {
+	schema, ok := value.(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	if defs, ok := schema["$defs"].(map[string]interface{}); ok {
+		for _, name := range sortedKeys(defs) {
+			defPointer := pointer + "/$defs/" + escapePointer(name)
+			c.convertSchema(defs[name], defPointer)
+			defName := c.defName(name, pointer)
+			c.defs[defName] = defs[name]
+			c.defRefs[defPointer] = "#/components/schemas/" + escapePointer(defName)
+		}
+		delete(schema, "$defs")
+	}
+
+	for _, keyword := range schemaListKeywords {
+		if list, ok := schema[keyword].([]interface{}); ok {
+			for i, item := range list {
+				c.convertSchema(item, fmt.Sprintf("%s/%s/%d", pointer, keyword, i))
+			}
+		}
+	}
+	for _, keyword := range schemaKeywords {
+		c.convertSchema(schema[keyword], pointer+"/"+keyword)
+	}
+	for _, keyword := range schemaObjectKeywords {
+		if object, ok := schema[keyword].(map[string]interface{}); ok {
+			for _, name := range sortedKeys(object) {
+				c.convertSchema(object[name], pointer+"/"+keyword+"/"+escapePointer(name))
+			}
+		}
+	}
+
+	convertType(schema)
+	convertNullMembers(schema, "oneOf")
+	convertNullMembers(schema, "anyOf")
+	convertPrefixItems(schema)
+	convertExclusiveBound(schema, "exclusiveMinimum", "minimum")
+	convertExclusiveBound(schema, "exclusiveMaximum", "maximum")
+	if value, found := schema["const"]; found {
+		if _, hasEnum := schema["enum"]; !hasEnum {
+			schema["enum"] = []interface{}{value}
+		}
+		delete(schema, "const")
+	}
+	if examples, ok := schema["examples"].([]interface{}); ok {
+		if _, hasExample := schema["example"]; !hasExample && len(examples) != 0 {
+			schema["example"] = examples[0]
+		}
+		delete(schema, "examples")
+	}
+}
+
+// Returns the name of the component schema for one of the $defs of the schema
+// at the given pointer. It's the name of the definition, unless that's taken,
+// in which case it's prefixed with the name of the component it's in.
+func (c *converter) defName(name string, pointer string) string {
+	var schemas map[string]interface{}
+	if components, ok := c.root["components"].(map[string]interface{}); ok {
+		schemas, _ = components["schemas"].(map[string]interface{})
+	}
+	taken := func(name string) bool {
+		_, component := schemas[name]
+		_, def := c.defs[name]
+		return component || def
+	}
+
+	if !taken(name) {
+		return name
+	}
+	if strings.HasPrefix(pointer, "#/components/schemas/") {
+		owner := strings.SplitN(strings.TrimPrefix(pointer, "#/components/schemas/"), "/", 2)[0]
+		name = unescapePointer(owner) + name
+	}
+	candidate := name
+	for i := 2; taken(candidate); i++ {
+		candidate = fmt.Sprintf("%s%d", name, i)
+	}
+	return candidate
+}
+
+// This adds the schemas made from $defs to the components.
+func (c *converter) addDefs() {
+	if len(c.defs) == 0 {
+		return
+	}
+	components, ok := c.root["components"].(map[string]interface{})
+	if !ok {
+		components = make(map[string]interface{})
+		c.root["components"] = components
+	}
+	schemas, ok := components["schemas"].(map[string]interface{})
+	if !ok {
+		schemas = make(map[string]interface{})
+		components["schemas"] = schemas
+	}
+	for name, schema := range c.defs {
+		schemas[name] = schema
+	}
+}
+
+// This converts a list of types into a single type, and nullable.
+func convertType(schema map[string]interface{}) {
+	switch t := schema["type"].(type) {
+	case string:
+		if t == "null" {
+			delete(schema, "type")
+			schema["nullable"] = true
+		}
+	case []interface{}:
+		var types []interface{}
+		for _, item := range t {
+			if item == "null" {
+				schema["nullable"] = true
+			} else {
+				types = append(types, item)
+			}
+		}
+		if len(types) == 1 {
+			schema["type"] = types[0]
+		} else {
+			delete(schema, "type")
+		}
+	}
+}
+
+// This removes {type: "null"} from a oneOf or anyOf, making the schema
+// nullable instead. When a single schema is left, it becomes an allOf, which
+// is how a nullable reference to another schema is written in OpenAPI 3.0.
+func convertNullMembers(schema map[string]interface{}, keyword string) {
+	list, ok := schema[keyword].([]interface{})
+	if !ok {
+		return
+	}
+	var members []interface{}
+	for _, item := range list {
+		// This was {type: "null"}, which convertType has already converted
+		if member, ok := item.(map[string]interface{}); ok && len(member) == 1 && member["nullable"] == true {
+			continue
+		}
+		members = append(members, item)
+	}
+	if len(members) == len(list) {
+		return
+	}
+	schema["nullable"] = true
+	switch len(members) {
+	case 0:
+		delete(schema, keyword)
+	case 1:
+		delete(schema, keyword)
+		allOf, _ := schema["allOf"].([]interface{})
+		schema["allOf"] = append(allOf, members[0])
+	default:
+		schema[keyword] = members
+	}
+}
+
+// This replaces prefixItems with the schema of all of the items of the array,
+// which is only known when they're all the same.
+func convertPrefixItems(schema map[string]interface{}) {
+	prefixItems, ok := schema["prefixItems"].([]interface{})
+	if !ok {
+		if schema["items"] == false {
+			delete(schema, "items")
+		}
+		return
+	}
+	delete(schema, "prefixItems")
+
+	items, hasItems := schema["items"]
+	if items == false || !hasItems {
+		if len(prefixItems) == 0 {
+			delete(schema, "items")
+			return
+		}
+		items = prefixItems[0]
+	}
+	for _, item := range prefixItems {
+		if !reflect.DeepEqual(item, items) {
+			items = map[string]interface{}{}
+			break
+		}
+	}
+	schema["items"] = items
+	if _, typed := schema["type"]; !typed {
+		schema["type"] = "array"
+	}
+}
+
+// In JSON Schema 2020-12, exclusiveMinimum and exclusiveMaximum are numbers,
+// rather than modifying the minimum or maximum.
+func convertExclusiveBound(schema map[string]interface{}, keyword string, boundKeyword string) {
+	bound, found := schema[keyword]
+	if !found {
+		return
+	}
+	if _, ok := bound.(bool); ok {
+		return
+	}
+	schema[boundKeyword] = bound
+	schema[keyword] = true
+}
+
+// This updates the references to the $defs, which are now component schemas.
+// A reference may also point into one of them.
+func rewriteRefs(value interface{}, refs map[string]string) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if ref, ok := item.(string); ok && key == "$ref" {
+				v[key] = rewriteRef(ref, refs)
+				continue
+			}
+			rewriteRefs(item, refs)
+		}
+	case []interface{}:
+		for _, item := range v {
+			rewriteRefs(item, refs)
+		}
+	}
+}
+
+func rewriteRef(ref string, refs map[string]string) string {
+	// The longest pointer wins, for $defs within $defs
+	var pointers []string
+	for pointer := range refs {
+		pointers = append(pointers, pointer)
+	}
+	sort.Slice(pointers, func(i, j int) bool {
+		return len(pointers[i]) > len(pointers[j])
+	})
+	for _, pointer := range pointers {
+		if ref == pointer || strings.HasPrefix(ref, pointer+"/") {
+			return refs[pointer] + strings.TrimPrefix(ref, pointer)
+		}
+	}
+	return ref
+}
+
+func escapePointer(s string) string {
+	return strings.Replace(strings.Replace(s, "~", "~0", -1), "/", "~1", -1)
+}
+
+func unescapePointer(s string) string {
+	return strings.Replace(strings.Replace(s, "~1", "/", -1), "~0", "~", -1)
+}
+
+func sortedKeys(object map[string]interface{}) []string {
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,138 @@
+package util
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const openAPI31Spec = `
+openapi: 3.1.0
+info:
+  title: Conversion
+  version: 1.0.0
+paths:
+  /things:
+    get:
+      parameters:
+        - name: limit
+          in: query
+          schema:
+            type: integer
+            exclusiveMinimum: 0
+            exclusiveMaximum: 100
+      responses:
+        200:
+          description: Things
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Thing/$defs/Part'
+              examples:
+                thing:
+                  value:
+                    type: [not, a, schema]
+components:
+  schemas:
+    Part:
+      type: string
+    Thing:
+      type: object
+      properties:
+        part:
+          $ref: '#/components/schemas/Thing/$defs/Part'
+        name:
+          $ref: '#/components/schemas/Thing/$defs/Name'
+        type:
+          type: string
+      $defs:
+        Part:
+          type: object
+          properties:
+            color:
+              $ref: '#/components/schemas/Thing/$defs/Part/$defs/Color'
+          $defs:
+            Color:
+              type: [string, 'null']
+              examples: [red]
+        Name:
+          const: thing
+`
+
+func TestConvertOpenAPI31(t *testing.T) {
+	data, err := ConvertOpenAPI31([]byte(openAPI31Spec))
+	require.NoError(t, err)
+
+	var doc map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &doc))
+	assert.Equal(t, "3.0.3", doc["openapi"])
+
+	schemas := doc["components"].(map[string]interface{})["schemas"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"part": map[string]interface{}{"$ref": "#/components/schemas/ThingPart"},
+			"name": map[string]interface{}{"$ref": "#/components/schemas/Name"},
+			"type": map[string]interface{}{"type": "string"},
+		},
+	}, schemas["Thing"])
+	// Part is taken, so the definition is named after its owner
+	assert.Equal(t, map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"color": map[string]interface{}{"$ref": "#/components/schemas/Color"},
+		},
+	}, schemas["ThingPart"])
+	assert.Equal(t, map[string]interface{}{"type": "string", "nullable": true, "example": "red"}, schemas["Color"])
+	assert.Equal(t, map[string]interface{}{"enum": []interface{}{"thing"}}, schemas["Name"])
+	assert.Equal(t, map[string]interface{}{"type": "string"}, schemas["Part"])
+
+	get := doc["paths"].(map[string]interface{})["/things"].(map[string]interface{})["get"].(map[string]interface{})
+	limit := get["parameters"].([]interface{})[0].(map[string]interface{})["schema"]
+	assert.Equal(t, map[string]interface{}{
+		"type":             "integer",
+		"minimum":          float64(0),
+		"exclusiveMinimum": true,
+		"maximum":          float64(100),
+		"exclusiveMaximum": true,
+	}, limit)
+
+	media := get["responses"].(map[string]interface{})["200"].(map[string]interface{})["content"].(map[string]interface{})["application/json"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"$ref": "#/components/schemas/ThingPart"}, media["schema"])
+	// Examples aren't schemas
+	assert.Equal(t, []interface{}{"not", "a", "schema"},
+		media["examples"].(map[string]interface{})["thing"].(map[string]interface{})["value"].(map[string]interface{})["type"])
+}
+
+func TestConvertOpenAPI31Schemas(t *testing.T) {
+	convert := func(schema string) interface{} {
+		data, err := ConvertOpenAPI31([]byte(`{"openapi": "3.1.0", "info": {"title": "Schemas", "version": "1"}, "paths": {}, "components": {"schemas": {"S": ` + schema + `}}}`))
+		require.NoError(t, err)
+		var doc map[string]interface{}
+		require.NoError(t, json.Unmarshal(data, &doc))
+		return doc["components"].(map[string]interface{})["schemas"].(map[string]interface{})["S"]
+	}
+
+	assert.Equal(t, map[string]interface{}{"nullable": true}, convert(`{"type": ["string", "integer", "null"]}`))
+	assert.Equal(t, map[string]interface{}{
+		"nullable": true,
+		"allOf":    []interface{}{map[string]interface{}{"$ref": "#/components/schemas/T"}},
+	}, convert(`{"oneOf": [{"$ref": "#/components/schemas/T"}, {"type": "null"}]}`))
+	assert.Equal(t, map[string]interface{}{
+		"type":  "array",
+		"items": map[string]interface{}{"type": "number"},
+	}, convert(`{"type": "array", "prefixItems": [{"type": "number"}, {"type": "number"}], "items": false}`))
+	assert.Equal(t, map[string]interface{}{
+		"type":  "array",
+		"items": map[string]interface{}{},
+	}, convert(`{"type": "array", "prefixItems": [{"type": "number"}, {"type": "string"}]}`))
+}
+
+func TestConvertOpenAPI30(t *testing.T) {
+	spec := []byte("openapi: 3.0.1\ninfo:\n  title: Unchanged\n  version: 1.0.0\npaths: {}\n")
+	data, err := ConvertOpenAPI31(spec)
+	require.NoError(t, err)
+	assert.Equal(t, spec, data)
+}
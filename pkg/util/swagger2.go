@@ -0,0 +1,526 @@
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// The media type of bodies and responses when a Swagger 2.0 spec doesn't say.
+const defaultSwagger2MediaType = "application/json"
+
+// The keywords which Swagger 2.0 parameters and headers share with schemas.
+var swagger2SchemaKeywords = []string{
+	"type", "format", "items", "enum", "default", "pattern", "uniqueItems",
+	"minimum", "maximum", "exclusiveMinimum", "exclusiveMaximum", "multipleOf",
+	"minLength", "maxLength", "minItems", "maxItems",
+}
+
+// ConvertSwagger2 converts a Swagger 2.0 document, in YAML or JSON, into the
+// equivalent OpenAPI 3.0 document. Other documents are returned unchanged.
+// The converted document is JSON.
+//
+// Definitions become component schemas, body parameters become request
+// bodies, with a content type for each that the operation consumes, and
+// formData parameters become the properties of a form request body. Security
+// definitions become security schemes. Anything which can't be converted
+// exactly is described by the returned warnings.
+func ConvertSwagger2(data []byte) ([]byte, []string, error) {
+	root, err := decodeDocument(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	// An unquoted 2.0 is decoded as a number, which is printed as 2 from YAML.
+	if version := fmt.Sprint(root["swagger"]); version != "2.0" && version != "2" {
+		return data, nil, nil
+	}
+
+	c := swagger2Converter{
+		root:       root,
+		parameters: mapValue(root["parameters"]),
+	}
+	converted := c.convert()
+
+	encoded, err := json.Marshal(converted)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "error encoding converted spec")
+	}
+	return encoded, c.warnings, nil
+}
+
+type swagger2Converter struct {
+	root       map[string]interface{}
+	parameters map[string]interface{} // The shared parameters of the spec
+	warnings   []string
+}
+
+func (c *swagger2Converter) warn(format string, args ...interface{}) {
+	c.warnings = append(c.warnings, fmt.Sprintf(format, args...))
+}
+
+func (c *swagger2Converter) convert() map[string]interface{} {
+	result := map[string]interface{}{
+		"openapi": "3.0.3",
+		"info":    c.root["info"],
+		"paths":   map[string]interface{}{},
+	}
+	copyKeys(result, c.root, "security", "tags", "externalDocs")
+	copyExtensions(result, c.root)
+
+	if servers := c.servers(); len(servers) != 0 {
+		result["servers"] = servers
+	}
+
+	components := make(map[string]interface{})
+	if definitions := mapValue(c.root["definitions"]); len(definitions) != 0 {
+		schemas := make(map[string]interface{})
+		for _, name := range sortedKeys(definitions) {
+			schemas[name] = c.schema(definitions[name])
+		}
+		components["schemas"] = schemas
+	}
+
+	consumes := stringList(c.root["consumes"])
+	produces := stringList(c.root["produces"])
+	if len(c.parameters) != 0 {
+		parameters := make(map[string]interface{})
+		requestBodies := make(map[string]interface{})
+		for _, name := range sortedKeys(c.parameters) {
+			parameter := mapValue(c.parameters[name])
+			switch parameter["in"] {
+			case "body":
+				requestBodies[name] = c.bodyParameter(parameter, consumes)
+			case "formData":
+				// These are added to the form bodies of the operations
+				// which refer to them.
+			default:
+				parameters[name] = c.parameter(parameter, fmt.Sprintf("parameter %s", name))
+			}
+		}
+		if len(parameters) != 0 {
+			components["parameters"] = parameters
+		}
+		if len(requestBodies) != 0 {
+			components["requestBodies"] = requestBodies
+		}
+	}
+	if responses := mapValue(c.root["responses"]); len(responses) != 0 {
+		converted := make(map[string]interface{})
+		for _, name := range sortedKeys(responses) {
+			converted[name] = c.response(mapValue(responses[name]), produces)
+		}
+		components["responses"] = converted
+	}
+	if definitions := mapValue(c.root["securityDefinitions"]); len(definitions) != 0 {
+		schemes := make(map[string]interface{})
+		for _, name := range sortedKeys(definitions) {
+			if scheme := c.securityScheme(name, mapValue(definitions[name])); scheme != nil {
+				schemes[name] = scheme
+			}
+		}
+		components["securitySchemes"] = schemes
+	}
+	if len(components) != 0 {
+		result["components"] = components
+	}
+
+	paths := mapValue(c.root["paths"])
+	for _, path := range sortedKeys(paths) {
+		result["paths"].(map[string]interface{})[path] = c.pathItem(path, mapValue(paths[path]), consumes, produces)
+	}
+	return result
+}
+
+// Swagger 2.0 gives the parts of the URL of the API separately, and a server
+// for each of its schemes.
+func (c *swagger2Converter) servers() []interface{} {
+	host, _ := c.root["host"].(string)
+	basePath, _ := c.root["basePath"].(string)
+	if host == "" {
+		if basePath == "" {
+			return nil
+		}
+		return []interface{}{map[string]interface{}{"url": basePath}}
+	}
+
+	schemes := stringList(c.root["schemes"])
+	if len(schemes) == 0 {
+		schemes = []string{"https"}
+	}
+	var servers []interface{}
+	for _, scheme := range schemes {
+		servers = append(servers, map[string]interface{}{"url": scheme + "://" + host + basePath})
+	}
+	return servers
+}
+
+func (c *swagger2Converter) pathItem(path string, item map[string]interface{}, consumes, produces []string) map[string]interface{} {
+	result := make(map[string]interface{})
+	copyKeys(result, item, "$ref")
+	copyExtensions(result, item)
+
+	// Parameters of the path which belong in a request body are added to
+	// each of its operations.
+	var bodyParameters []interface{}
+	var parameters []interface{}
+	for _, p := range listValue(item["parameters"]) {
+		if in := c.resolveParameter(p)["in"]; in == "body" || in == "formData" {
+			bodyParameters = append(bodyParameters, p)
+			continue
+		}
+		parameters = append(parameters, c.parameter(mapValue(p), fmt.Sprintf("path %s", path)))
+	}
+	if len(parameters) != 0 {
+		result["parameters"] = parameters
+	}
+
+	for _, method := range []string{"get", "put", "post", "delete", "options", "head", "patch"} {
+		if operation, ok := item[method].(map[string]interface{}); ok {
+			result[method] = c.operation(fmt.Sprintf("%s %s", strings.ToUpper(method), path), operation, bodyParameters, consumes, produces)
+		}
+	}
+	return result
+}
+
+func (c *swagger2Converter) operation(location string, operation map[string]interface{}, bodyParameters []interface{}, consumes, produces []string) map[string]interface{} {
+	result := make(map[string]interface{})
+	copyKeys(result, operation, "operationId", "summary", "description", "tags", "deprecated", "security", "externalDocs")
+	copyExtensions(result, operation)
+	if _, found := operation["schemes"]; found {
+		c.warn("%s: the schemes of an operation can't be converted, so it uses the servers of the spec", location)
+	}
+	if list, found := operation["consumes"]; found {
+		consumes = stringList(list)
+	}
+	if list, found := operation["produces"]; found {
+		produces = stringList(list)
+	}
+
+	var parameters []interface{}
+	form := map[string]interface{}{
+		"type":       "object",
+		"properties": map[string]interface{}{},
+	}
+	var formRequired []interface{}
+	var hasForm, hasFile bool
+	for _, p := range append(bodyParameters, listValue(operation["parameters"])...) {
+		parameter := c.resolveParameter(p)
+		switch parameter["in"] {
+		case "body":
+			if ref, ok := mapValue(p)["$ref"].(string); ok {
+				result["requestBody"] = map[string]interface{}{"$ref": "#/components/requestBodies/" + strings.TrimPrefix(ref, "#/parameters/")}
+			} else {
+				result["requestBody"] = c.bodyParameter(parameter, consumes)
+			}
+		case "formData":
+			hasForm = true
+			name, _ := parameter["name"].(string)
+			property := c.parameterSchema(parameter, location)
+			if property["type"] == "file" {
+				hasFile = true
+				property["type"] = "string"
+				property["format"] = "binary"
+			}
+			if description, found := parameter["description"]; found {
+				property["description"] = description
+			}
+			form["properties"].(map[string]interface{})[name] = property
+			if parameter["required"] == true {
+				formRequired = append(formRequired, name)
+			}
+		default:
+			parameters = append(parameters, c.parameter(mapValue(p), location))
+		}
+	}
+	if len(parameters) != 0 {
+		result["parameters"] = parameters
+	}
+	if hasForm {
+		if len(formRequired) != 0 {
+			form["required"] = formRequired
+		}
+		result["requestBody"] = c.formBody(location, form, formRequired, hasFile, consumes)
+	}
+
+	responses := make(map[string]interface{})
+	operationResponses := mapValue(operation["responses"])
+	for _, code := range sortedKeys(operationResponses) {
+		responses[code] = c.response(mapValue(operationResponses[code]), produces)
+	}
+	result["responses"] = responses
+	return result
+}
+
+// This returns a parameter, following its reference to one of the shared
+// parameters, if it's one.
+func (c *swagger2Converter) resolveParameter(value interface{}) map[string]interface{} {
+	parameter := mapValue(value)
+	if ref, ok := parameter["$ref"].(string); ok {
+		if strings.HasPrefix(ref, "#/parameters/") {
+			return mapValue(c.parameters[strings.TrimPrefix(ref, "#/parameters/")])
+		}
+		c.warn("parameter %s: references to other documents can't be converted", ref)
+	}
+	return parameter
+}
+
+// This converts a query, path or header parameter, or a reference to one.
+func (c *swagger2Converter) parameter(parameter map[string]interface{}, location string) map[string]interface{} {
+	if ref, ok := parameter["$ref"].(string); ok {
+		return map[string]interface{}{"$ref": convertSwagger2Ref(ref)}
+	}
+
+	result := make(map[string]interface{})
+	copyKeys(result, parameter, "name", "in", "description", "required", "allowEmptyValue")
+	copyExtensions(result, parameter)
+	result["schema"] = c.parameterSchema(parameter, location)
+
+	format, _ := parameter["collectionFormat"].(string)
+	if parameter["type"] != "array" {
+		return result
+	}
+	switch format {
+	case "", "csv":
+		if parameter["in"] == "query" {
+			result["style"] = "form"
+			result["explode"] = false
+		}
+	case "multi":
+		result["style"] = "form"
+		result["explode"] = true
+	case "ssv":
+		result["style"] = "spaceDelimited"
+	case "pipes":
+		result["style"] = "pipeDelimited"
+	default:
+		c.warn("%s: parameter %v has collectionFormat %s, which OpenAPI 3 doesn't support, so it's treated as csv", location, parameter["name"], format)
+	}
+	return result
+}
+
+// Returns the schema of a parameter, or a header, which are given by the
+// keywords of the parameter itself.
+func (c *swagger2Converter) parameterSchema(parameter map[string]interface{}, location string) map[string]interface{} {
+	schema := make(map[string]interface{})
+	copyKeys(schema, parameter, swagger2SchemaKeywords...)
+	if items, ok := schema["items"].(map[string]interface{}); ok {
+		if _, nested := items["collectionFormat"]; nested {
+			c.warn("%s: parameter %v has nested collectionFormats, which OpenAPI 3 doesn't support", location, parameter["name"])
+		}
+		schema["items"] = c.parameterSchema(items, location)
+	}
+	return schema
+}
+
+func (c *swagger2Converter) bodyParameter(parameter map[string]interface{}, consumes []string) map[string]interface{} {
+	result := make(map[string]interface{})
+	copyKeys(result, parameter, "description", "required")
+	copyExtensions(result, parameter)
+	result["content"] = mediaTypes(consumes, c.schema(parameter["schema"]))
+	return result
+}
+
+// This makes a request body of the formData parameters. Files can only be sent
+// in a multipart body.
+func (c *swagger2Converter) formBody(location string, form map[string]interface{}, required []interface{}, hasFile bool, consumes []string) map[string]interface{} {
+	var formTypes []string
+	for _, mediaType := range consumes {
+		if mediaType == "multipart/form-data" || mediaType == "application/x-www-form-urlencoded" {
+			formTypes = append(formTypes, mediaType)
+		}
+	}
+	if len(formTypes) == 0 {
+		if hasFile {
+			formTypes = []string{"multipart/form-data"}
+		} else {
+			formTypes = []string{"application/x-www-form-urlencoded"}
+		}
+		if len(consumes) != 0 {
+			c.warn("%s: formData parameters are sent as %s, since the operation doesn't consume a form", location, formTypes[0])
+		}
+	}
+
+	content := make(map[string]interface{})
+	for _, mediaType := range formTypes {
+		content[mediaType] = map[string]interface{}{"schema": form}
+	}
+	result := map[string]interface{}{"content": content}
+	if len(required) != 0 {
+		result["required"] = true
+	}
+	return result
+}
+
+func (c *swagger2Converter) response(response map[string]interface{}, produces []string) map[string]interface{} {
+	if ref, ok := response["$ref"].(string); ok {
+		return map[string]interface{}{"$ref": convertSwagger2Ref(ref)}
+	}
+
+	result := make(map[string]interface{})
+	copyKeys(result, response, "description")
+	copyExtensions(result, response)
+	if schema, found := response["schema"]; found {
+		content := mediaTypes(produces, c.schema(schema))
+		examples := mapValue(response["examples"])
+		for _, mediaType := range sortedKeys(examples) {
+			if mediaTypeObject, ok := content[mediaType].(map[string]interface{}); ok {
+				mediaTypeObject["example"] = examples[mediaType]
+			}
+		}
+		result["content"] = content
+	}
+	if headers := mapValue(response["headers"]); len(headers) != 0 {
+		converted := make(map[string]interface{})
+		for _, name := range sortedKeys(headers) {
+			header := mapValue(headers[name])
+			convertedHeader := map[string]interface{}{
+				"schema": c.parameterSchema(header, fmt.Sprintf("header %s", name)),
+			}
+			copyKeys(convertedHeader, header, "description")
+			converted[name] = convertedHeader
+		}
+		result["headers"] = converted
+	}
+	return result
+}
+
+// This converts a schema in place, updating its references, and the few
+// keywords which differ between Swagger 2.0 and OpenAPI 3.
+func (c *swagger2Converter) schema(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			switch key {
+			case "$ref":
+				if ref, ok := item.(string); ok {
+					v[key] = convertSwagger2Ref(ref)
+				}
+			case "example", "default", "enum":
+			case "properties":
+				for _, property := range mapValue(item) {
+					c.schema(property)
+				}
+			default:
+				if !strings.HasPrefix(key, "x-") {
+					c.schema(item)
+				}
+			}
+		}
+		if v["type"] == "file" {
+			v["type"] = "string"
+			v["format"] = "binary"
+		}
+		if discriminator, ok := v["discriminator"].(string); ok {
+			v["discriminator"] = map[string]interface{}{"propertyName": discriminator}
+		}
+		if nullable, found := v["x-nullable"]; found {
+			v["nullable"] = nullable
+			delete(v, "x-nullable")
+		}
+	case []interface{}:
+		for _, item := range v {
+			c.schema(item)
+		}
+	}
+	return value
+}
+
+func (c *swagger2Converter) securityScheme(name string, definition map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	copyKeys(result, definition, "description")
+	copyExtensions(result, definition)
+	switch definition["type"] {
+	case "basic":
+		result["type"] = "http"
+		result["scheme"] = "basic"
+	case "apiKey":
+		copyKeys(result, definition, "type", "name", "in")
+	case "oauth2":
+		flow := make(map[string]interface{})
+		copyKeys(flow, definition, "authorizationUrl", "tokenUrl")
+		flow["scopes"] = mapValue(definition["scopes"])
+		flows := map[string]string{
+			"implicit":    "implicit",
+			"password":    "password",
+			"application": "clientCredentials",
+			"accessCode":  "authorizationCode",
+		}
+		flowName, found := flows[fmt.Sprint(definition["flow"])]
+		if !found {
+			c.warn("security definition %s: OAuth2 flow %v isn't supported, so it's left out", name, definition["flow"])
+			return nil
+		}
+		result["type"] = "oauth2"
+		result["flows"] = map[string]interface{}{flowName: flow}
+	default:
+		c.warn("security definition %s: type %v isn't supported, so it's left out", name, definition["type"])
+		return nil
+	}
+	return result
+}
+
+// Swagger 2.0 references to definitions and responses have the same names in
+// the components of OpenAPI 3.
+func convertSwagger2Ref(ref string) string {
+	for prefix, components := range map[string]string{
+		"#/definitions/": "#/components/schemas/",
+		"#/responses/":   "#/components/responses/",
+		"#/parameters/":  "#/components/parameters/",
+	} {
+		if strings.HasPrefix(ref, prefix) {
+			return components + strings.TrimPrefix(ref, prefix)
+		}
+	}
+	return ref
+}
+
+// Returns the content of a body, or a response, with the same schema for each
+// of its media types.
+func mediaTypes(mediaTypes []string, schema interface{}) map[string]interface{} {
+	if len(mediaTypes) == 0 {
+		mediaTypes = []string{defaultSwagger2MediaType}
+	}
+	content := make(map[string]interface{})
+	for _, mediaType := range mediaTypes {
+		content[mediaType] = map[string]interface{}{"schema": schema}
+	}
+	return content
+}
+
+func copyKeys(dst, src map[string]interface{}, keys ...string) {
+	for _, key := range keys {
+		if value, found := src[key]; found {
+			dst[key] = value
+		}
+	}
+}
+
+func copyExtensions(dst, src map[string]interface{}) {
+	for key, value := range src {
+		if strings.HasPrefix(key, "x-") {
+			dst[key] = value
+		}
+	}
+}
+
+func mapValue(value interface{}) map[string]interface{} {
+	m, _ := value.(map[string]interface{})
+	return m
+}
+
+func listValue(value interface{}) []interface{} {
+	l, _ := value.([]interface{})
+	return l
+}
+
+func stringList(value interface{}) []string {
+	var result []string
+	for _, item := range listValue(value) {
+		if s, ok := item.(string); ok {
+			result = append(result, s)
+		}
+	}
+	return result
+}
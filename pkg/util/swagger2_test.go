@@ -0,0 +1,187 @@
+package util
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const swagger2Spec = `
+swagger: '2.0'
+info:
+  title: Conversion
+  version: 1.0.0
+host: example.com
+basePath: /api
+schemes: [http, https]
+consumes: [application/json, application/xml]
+securityDefinitions:
+  basic:
+    type: basic
+  oauth:
+    type: oauth2
+    flow: accessCode
+    authorizationUrl: https://example.com/authorize
+    tokenUrl: https://example.com/token
+    scopes: {}
+  other:
+    type: oauth2
+    flow: unknown
+parameters:
+  Body:
+    name: body
+    in: body
+    schema:
+      $ref: '#/definitions/Thing'
+  Name:
+    name: name
+    in: formData
+    required: true
+    type: string
+paths:
+  /things:
+    post:
+      parameters:
+        - $ref: '#/parameters/Body'
+        - name: ids
+          in: query
+          type: array
+          items:
+            type: integer
+      responses:
+        201:
+          description: Created
+          schema:
+            $ref: '#/definitions/Thing'
+          examples:
+            application/json: {kind: a}
+  /things/form:
+    post:
+      consumes: [application/json]
+      parameters:
+        - $ref: '#/parameters/Name'
+        - name: count
+          in: formData
+          type: integer
+      responses:
+        204:
+          description: Done
+definitions:
+  Thing:
+    type: object
+    discriminator: kind
+    properties:
+      kind:
+        type: string
+      note:
+        type: string
+        x-nullable: true
+`
+
+func TestConvertSwagger2(t *testing.T) {
+	data, warnings, err := ConvertSwagger2([]byte(swagger2Spec))
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"security definition other: OAuth2 flow unknown isn't supported, so it's left out",
+		"POST /things/form: formData parameters are sent as application/x-www-form-urlencoded, since the operation doesn't consume a form",
+	}, warnings)
+
+	var doc map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &doc))
+	assert.Equal(t, "3.0.3", doc["openapi"])
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"url": "http://example.com/api"},
+		map[string]interface{}{"url": "https://example.com/api"},
+	}, doc["servers"])
+
+	components := doc["components"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{
+		"type":          "object",
+		"discriminator": map[string]interface{}{"propertyName": "kind"},
+		"properties": map[string]interface{}{
+			"kind": map[string]interface{}{"type": "string"},
+			"note": map[string]interface{}{"type": "string", "nullable": true},
+		},
+	}, components["schemas"].(map[string]interface{})["Thing"])
+	thingRef := map[string]interface{}{"$ref": "#/components/schemas/Thing"}
+	assert.Equal(t, map[string]interface{}{
+		"Body": map[string]interface{}{
+			"content": map[string]interface{}{
+				"application/json": map[string]interface{}{"schema": thingRef},
+				"application/xml":  map[string]interface{}{"schema": thingRef},
+			},
+		},
+	}, components["requestBodies"])
+	assert.Nil(t, components["parameters"], "formData parameters aren't components")
+	assert.Equal(t, map[string]interface{}{
+		"basic": map[string]interface{}{"type": "http", "scheme": "basic"},
+		"oauth": map[string]interface{}{
+			"type": "oauth2",
+			"flows": map[string]interface{}{
+				"authorizationCode": map[string]interface{}{
+					"authorizationUrl": "https://example.com/authorize",
+					"tokenUrl":         "https://example.com/token",
+					"scopes":           map[string]interface{}{},
+				},
+			},
+		},
+	}, components["securitySchemes"])
+
+	paths := doc["paths"].(map[string]interface{})
+	post := paths["/things"].(map[string]interface{})["post"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"$ref": "#/components/requestBodies/Body"}, post["requestBody"])
+	assert.Equal(t, []interface{}{map[string]interface{}{
+		"name":    "ids",
+		"in":      "query",
+		"style":   "form",
+		"explode": false,
+		"schema": map[string]interface{}{
+			"type":  "array",
+			"items": map[string]interface{}{"type": "integer"},
+		},
+	}}, post["parameters"])
+	assert.Equal(t, map[string]interface{}{
+		"description": "Created",
+		"content": map[string]interface{}{
+			"application/json": map[string]interface{}{
+				"schema":  thingRef,
+				"example": map[string]interface{}{"kind": "a"},
+			},
+		},
+	}, post["responses"].(map[string]interface{})["201"])
+
+	form := paths["/things/form"].(map[string]interface{})["post"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{
+		"required": true,
+		"content": map[string]interface{}{
+			"application/x-www-form-urlencoded": map[string]interface{}{
+				"schema": map[string]interface{}{
+					"type":     "object",
+					"required": []interface{}{"name"},
+					"properties": map[string]interface{}{
+						"name":  map[string]interface{}{"type": "string"},
+						"count": map[string]interface{}{"type": "integer"},
+					},
+				},
+			},
+		},
+	}, form["requestBody"])
+}
+
+func TestConvertSwagger2UnquotedVersion(t *testing.T) {
+	// An unquoted version is a number in YAML and JSON rather than a string.
+	specs := []string{
+		"swagger: 2.0\ninfo:\n  title: Unquoted\n  version: 1.0.0\npaths: {}\n",
+		`{"swagger": 2.0, "info": {"title": "Unquoted", "version": "1.0.0"}, "paths": {}}`,
+	}
+	for _, spec := range specs {
+		data, _, err := ConvertSwagger2([]byte(spec))
+		require.NoError(t, err)
+		var doc map[string]interface{}
+		require.NoError(t, json.Unmarshal(data, &doc), spec)
+		assert.Equal(t, "3.0.3", doc["openapi"], spec)
+		assert.Nil(t, doc["swagger"], spec)
+	}
+}